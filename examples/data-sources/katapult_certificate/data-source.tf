@@ -0,0 +1,13 @@
+# Get a certificate by ID.
+data "katapult_certificate" "web" {
+  id = "cert_Zgp5hBSQhV7Vw3oi"
+}
+
+# Use the certificate on a HTTPS load balancer rule.
+resource "katapult_load_balancer_rule" "https" {
+  load_balancer_id = katapult_load_balancer.web.id
+  destination_port = 8080
+  listen_port      = 443
+  protocol         = "HTTPS"
+  certificate_ids  = [data.katapult_certificate.web.id]
+}
@@ -0,0 +1,10 @@
+# Get all certificates.
+data "katapult_certificates" "all" {}
+
+# IDs of all issued certificates.
+output "issued_certificate_ids" {
+  value = [
+    for cert in data.katapult_certificates.all.certificates : cert.id
+    if cert.state == "issued"
+  ]
+}
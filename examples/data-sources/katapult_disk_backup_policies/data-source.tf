@@ -0,0 +1,9 @@
+# Get the backup policies for a disk.
+data "katapult_disk_backup_policies" "data" {
+  disk_id = "disk_kGnYfHfs2vqVcjSz"
+}
+
+# Get the backup policies for a virtual machine and its disks.
+data "katapult_disk_backup_policies" "web" {
+  virtual_machine_id = "vm_uJeh3EC3CJe7Vbtd"
+}
@@ -1,2 +1,8 @@
 # Get all disk templates
 data "katapult_disk_templates" "all" {}
+
+# Get the organization's own Debian templates
+data "katapult_disk_templates" "debian" {
+  include_universal = false
+  os_family         = "Debian"
+}
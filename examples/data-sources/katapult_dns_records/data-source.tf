@@ -0,0 +1,4 @@
+# Get all records in a DNS zone.
+data "katapult_dns_records" "example" {
+  zone_id = "dnszone_kGnYfHfs2vqVcjSz"
+}
@@ -0,0 +1,2 @@
+# Get all DNS zones.
+data "katapult_dns_zones" "all" {}
@@ -0,0 +1,14 @@
+# Find everything associated with the "web" tag.
+data "katapult_tagged_objects" "web" {
+  tag_name = "web"
+}
+
+# Only the Virtual Machines, e.g. to generate monitoring targets.
+data "katapult_tagged_objects" "web_virtual_machines" {
+  tag_name = "web"
+
+  filter {
+    name   = "object_type"
+    values = ["virtual_machine"]
+  }
+}
@@ -1,2 +1,8 @@
 # Get all virtual machine packages
 data "katapult_virtual_machine_packages" "all" {}
+
+# Get packages with at least 4 CPU cores and 8 GB of memory
+data "katapult_virtual_machine_packages" "large" {
+  min_cpu_cores    = 4
+  min_memory_in_gb = 8
+}
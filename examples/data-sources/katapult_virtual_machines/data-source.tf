@@ -8,3 +8,17 @@ locals {
     virtual_machine.id => virtual_machine
   }
 }
+
+# Only return web servers.
+data "katapult_virtual_machines" "web" {
+  filter {
+    name     = "hostname"
+    values   = ["^web-"]
+    match_by = "regex"
+  }
+}
+
+# Only return Virtual Machines tagged "web".
+data "katapult_virtual_machines" "tagged_web" {
+  tags = ["web"]
+}
@@ -0,0 +1,24 @@
+# Temporary key, created when Terraform opens the ephemeral resource and
+# deleted again once the run no longer needs it.
+ephemeral "katapult_object_storage_access_key" "uploader" {
+  name   = "terraform-uploader"
+  region = "uk-lon-1"
+
+  all_objects_write = true
+}
+
+provider "aws" {
+  alias      = "katapult"
+  region     = "uk-lon-1"
+  access_key = ephemeral.katapult_object_storage_access_key.uploader.access_key_id
+  secret_key = ephemeral.katapult_object_storage_access_key.uploader.secret_access_key
+
+  endpoints {
+    s3 = ephemeral.katapult_object_storage_access_key.uploader.server_url
+  }
+
+  s3_use_path_style           = true
+  skip_credentials_validation = true
+  skip_requesting_account_id  = true
+  skip_region_validation      = true
+}
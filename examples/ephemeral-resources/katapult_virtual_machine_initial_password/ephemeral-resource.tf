@@ -0,0 +1,20 @@
+resource "katapult_virtual_machine" "web" {
+  package       = "rock-3"
+  disk_template = "templates/ubuntu-20-04"
+}
+
+ephemeral "katapult_virtual_machine_initial_password" "web" {
+  id = katapult_virtual_machine.web.id
+}
+
+# Store the password in Vault using a write-only argument, so it never lands
+# in Terraform state.
+resource "vault_kv_secret_v2" "web_root_password" {
+  mount = "secret"
+  name  = "katapult/web/root"
+
+  data_json_wo = jsonencode({
+    password = ephemeral.katapult_virtual_machine_initial_password.web.initial_root_password
+  })
+  data_json_wo_version = 1
+}
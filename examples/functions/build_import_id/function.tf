@@ -0,0 +1,7 @@
+import {
+  to = katapult_object_storage_bucket.backups
+  id = provider::katapult::build_import_id("katapult_object_storage_bucket", {
+    name   = "my-org-backups"
+    region = "uk-lon-1"
+  })
+}
@@ -0,0 +1,11 @@
+resource "katapult_ip" "web" {}
+
+resource "katapult_security_group_rule" "from_web_network" {
+  security_group_id = katapult_security_group.db.id
+  direction         = "inbound"
+  protocol          = "tcp"
+  ports             = "5432"
+  targets = [
+    provider::katapult::cidr_for_ip(katapult_ip.web.address_with_mask),
+  ]
+}
@@ -0,0 +1,3 @@
+data "katapult_virtual_machine" "web" {
+  fqdn = provider::katapult::hostname_to_fqdn("web-1", "acme-labs")
+}
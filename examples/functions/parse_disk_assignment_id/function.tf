@@ -0,0 +1,9 @@
+locals {
+  assignment = provider::katapult::parse_disk_assignment_id(
+    katapult_disk_assignment.data.id
+  )
+}
+
+output "data_disk_id" {
+  value = local.assignment.disk_id
+}
@@ -0,0 +1,3 @@
+list "katapult_disk" "all" {
+  provider = katapult
+}
@@ -0,0 +1,3 @@
+list "katapult_ip" "all" {
+  provider = katapult
+}
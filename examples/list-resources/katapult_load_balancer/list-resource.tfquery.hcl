@@ -0,0 +1,3 @@
+list "katapult_load_balancer" "all" {
+  provider = katapult
+}
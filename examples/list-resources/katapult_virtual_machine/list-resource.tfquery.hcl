@@ -0,0 +1,3 @@
+list "katapult_virtual_machine" "all" {
+  provider = katapult
+}
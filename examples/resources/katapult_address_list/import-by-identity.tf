@@ -0,0 +1,6 @@
+import {
+  to = katapult_address_list.web-1
+  identity = {
+    id = "adlst_xxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_address_list_entry.goog
+  identity = {
+    id = "adlste_xxxxxxxxxxx"
+  }
+}
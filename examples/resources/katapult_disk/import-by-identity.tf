@@ -0,0 +1,6 @@
+import {
+  to = katapult_disk.data
+  identity = {
+    id = "disk_xxxxxxxxxxx"
+  }
+}
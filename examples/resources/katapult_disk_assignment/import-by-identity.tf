@@ -0,0 +1,7 @@
+import {
+  to = katapult_disk_assignment.data
+  identity = {
+    virtual_machine_id = "vm_abc123"
+    disk_id            = "disk_abc123"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_disk_backup_policy.data
+  identity = {
+    id = "dbp_xxxxxxxxxxx"
+  }
+}
@@ -0,0 +1 @@
+terraform import katapult_disk_backup_policy.data dbp_xxxxxxxxxxx
@@ -0,0 +1,21 @@
+# Back up a single disk every day at 03:00, keeping a week of backups.
+resource "katapult_disk_backup_policy" "data" {
+  disk_id   = katapult_disk.data.id
+  retention = 7
+
+  schedule = {
+    interval = "daily"
+    time     = 3
+  }
+}
+
+# Back up every disk attached to a virtual machine each hour.
+resource "katapult_disk_backup_policy" "web" {
+  virtual_machine_id = katapult_virtual_machine.web.id
+  retention          = 24
+
+  schedule = {
+    interval = "hourly"
+    minute   = 30
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_dns_record.www
+  identity = {
+    id = "dnsrec_xxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,58 @@
+resource "katapult_dns_zone" "example" {
+  name = "example.com"
+}
+
+resource "katapult_ip" "web" {}
+
+resource "katapult_virtual_machine" "web" {
+  package       = "rock-3"
+  disk_template = "templates/ubuntu-20-04"
+  disk_template_options = {
+    install_agent = true # required by some disk templates
+  }
+  ip_address_ids = [katapult_ip.web.id]
+}
+
+# Point www at the IP address of a virtual machine.
+resource "katapult_dns_record" "www" {
+  zone_id = katapult_dns_zone.example.id
+  name    = "www"
+  type    = "A"
+  content = one(katapult_virtual_machine.web.ip_addresses)
+}
+
+resource "katapult_dns_record" "mail" {
+  zone_id  = katapult_dns_zone.example.id
+  type     = "MX"
+  priority = 10
+  content  = "mail.example.com"
+  ttl      = 3600
+}
+
+resource "katapult_dns_record" "spf" {
+  zone_id = katapult_dns_zone.example.id
+  type    = "TXT"
+  content = "v=spf1 mx -all"
+}
+
+resource "katapult_dns_record" "sip" {
+  zone_id  = katapult_dns_zone.example.id
+  name     = "_sip._tcp"
+  type     = "SRV"
+  priority = 10
+  srv = {
+    weight = 5
+    port   = 5060
+    target = "sip.example.com"
+  }
+}
+
+resource "katapult_dns_record" "caa" {
+  zone_id = katapult_dns_zone.example.id
+  type    = "CAA"
+  caa = {
+    flag  = 0
+    tag   = "issue"
+    value = "letsencrypt.org"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_dns_zone.example
+  identity = {
+    id = "dnszone_xxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,9 @@
+resource "katapult_dns_zone" "example" {
+  name = "example.com"
+}
+
+# Zone with a custom default TTL for its records.
+resource "katapult_dns_zone" "internal" {
+  name        = "internal.example.com"
+  default_ttl = 300
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_file_storage_volume.cache
+  identity = {
+    id = "fsv_xxxxxxxxxxx"
+  }
+}
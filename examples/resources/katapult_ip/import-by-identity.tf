@@ -0,0 +1,6 @@
+import {
+  to = katapult_ip.web
+  identity = {
+    id = "ip_xxxxxxxxxxx"
+  }
+}
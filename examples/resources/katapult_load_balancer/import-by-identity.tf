@@ -0,0 +1,6 @@
+import {
+  to = katapult_load_balancer.web
+  identity = {
+    id = "lbaas_xxxxxxxxxxx"
+  }
+}
@@ -31,3 +31,28 @@ resource "katapult_load_balancer" "by-tag" {
     "tag_SAMo9t0eHM1SuNwX"
   ]
 }
+
+# Manage every rule of the load balancer from `rule` blocks. This can't be
+# combined with `katapult_load_balancer_rule` resources for the same load
+# balancer: the plan fails while it has rules not listed here.
+resource "katapult_load_balancer" "with-rules" {
+  name = "with-rules"
+
+  virtual_machine_ids = [
+    "vm_3HmtE9zPthxuAI6j"
+  ]
+
+  rule {
+    listen_port      = 80
+    destination_port = 8080
+    protocol         = "HTTP"
+  }
+
+  rule {
+    listen_port      = 443
+    destination_port = 8443
+    protocol         = "TCP"
+    check_enabled    = true
+    check_protocol   = "TCP"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_load_balancer_rule.my_rule
+  identity = {
+    id = "lbrule_xxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_object_storage_access_key.example
+  identity = {
+    id = "objkey_aBcDeFg1234567"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = katapult_object_storage_account.example
+  identity = {
+    organization = "my-org"
+    region       = "uk-lon-1"
+  }
+}
@@ -1 +1,2 @@
 terraform import katapult_object_storage_account.example uk-lon-1
+terraform import katapult_object_storage_account.example my-org/uk-lon-1
@@ -0,0 +1,7 @@
+import {
+  to = katapult_object_storage_bucket.example
+  identity = {
+    name   = "my-org-assets"
+    region = "uk-lon-1"
+  }
+}
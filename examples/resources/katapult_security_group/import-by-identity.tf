@@ -0,0 +1,6 @@
+import {
+  to = katapult_security_group.practical
+  identity = {
+    id = "sg_xxxxxxxxxxx"
+  }
+}
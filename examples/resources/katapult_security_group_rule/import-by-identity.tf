@@ -0,0 +1,6 @@
+import {
+  to = katapult_security_group_rule.http
+  identity = {
+    id = "sgr_xxxxxxxxxxx"
+  }
+}
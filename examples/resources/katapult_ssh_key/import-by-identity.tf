@@ -0,0 +1,6 @@
+import {
+  to = katapult_ssh_key.deploy
+  identity = {
+    id = "key_xxxxxxxxxxx"
+  }
+}
@@ -0,0 +1 @@
+terraform import katapult_ssh_key.deploy key_xxxxxxxxxxx
@@ -0,0 +1,4 @@
+resource "katapult_ssh_key" "deploy" {
+  name = "deploy"
+  key  = file("~/.ssh/id_ed25519.pub")
+}
@@ -0,0 +1,6 @@
+import {
+  to = katapult_tag.production
+  identity = {
+    id = "tag_xxxxxxxxxxx"
+  }
+}
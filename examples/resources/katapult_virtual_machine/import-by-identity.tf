@@ -0,0 +1,6 @@
+import {
+  to = katapult_virtual_machine.base
+  identity = {
+    id = "vm_xxxxxxxxxxx"
+  }
+}
@@ -18,6 +18,11 @@ resource "katapult_virtual_machine_group" "web" {
   name = "web-servers"
 }
 
+resource "katapult_ssh_key" "deploy" {
+  name = "deploy"
+  key  = file("~/.ssh/id_ed25519.pub")
+}
+
 resource "katapult_virtual_machine" "base" {
   name        = "Web 2"
   hostname    = "web-2"
@@ -36,6 +41,9 @@ resource "katapult_virtual_machine" "base" {
     install_agent = true
   }
 
+  # Only authorize the deploy key rather than every key in the organization.
+  authorized_key_ids = [katapult_ssh_key.deploy.id]
+
   system_disk = {
     name       = "System Disk"
     size_in_gb = 20
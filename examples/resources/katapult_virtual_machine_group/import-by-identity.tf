@@ -0,0 +1,6 @@
+import {
+  to = katapult_virtual_machine_group.web
+  identity = {
+    id = "vmgrp_xxxxxxxxxxx"
+  }
+}
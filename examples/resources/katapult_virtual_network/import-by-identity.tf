@@ -0,0 +1,6 @@
+import {
+  to = katapult_virtual_network.backbone
+  identity = {
+    id = "vnet_xxxxxxxxxxx"
+  }
+}
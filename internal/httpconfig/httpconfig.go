@@ -0,0 +1,150 @@
+// Package httpconfig builds the HTTP client and API base URL shared by the
+// SDKv2 and plugin framework halves of the provider, so both talk to the same
+// endpoint through the same proxy and trust the same certificates.
+package httpconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// DefaultAPIURL is the base URL of the public Katapult API.
+const DefaultAPIURL = "https://api.katapult.io"
+
+// Config holds the resolved connection settings of the provider. Zero values
+// keep the defaults.
+type Config struct {
+	// APIURL is the base URL of the Katapult API, without the /core/v1 path.
+	APIURL string
+
+	// CACertFile is a PEM file of certificate authorities trusted in addition
+	// to the system roots.
+	CACertFile string
+
+	// ProxyURL is the proxy every API request is sent through. When empty,
+	// the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY variables apply.
+	ProxyURL string
+
+	// InsecureSkipVerify disables verification of the API's TLS certificate.
+	// Only intended for development against local APIs.
+	InsecureSkipVerify bool
+}
+
+// BaseURL returns the parsed API base URL, falling back to DefaultAPIURL.
+// URLs with a path are rejected, as the API clients address /core/v1 from
+// the root of the host and would otherwise disagree about the endpoint.
+func (c Config) BaseURL() (*url.URL, error) {
+	raw := c.APIURL
+	if raw == "" {
+		raw = DefaultAPIURL
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid api_url: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf(
+			"invalid api_url %q: must be an absolute http or https URL", raw,
+		)
+	}
+	if u.Path != "" && u.Path != "/" {
+		return nil, fmt.Errorf(
+			"invalid api_url %q: must not include a path, "+
+				"requests are always sent to /core/v1", raw,
+		)
+	}
+
+	return u, nil
+}
+
+// HTTPClient returns client with the proxy and TLS settings applied to a copy
+// of its transport. client is returned unchanged when no setting is given, so
+// transports injected by tests are left alone. A nil client is treated as an
+// empty one.
+func (c Config) HTTPClient(client *http.Client) (*http.Client, error) {
+	if c.CACertFile == "" && c.ProxyURL == "" && !c.InsecureSkipVerify {
+		return client, nil
+	}
+	if client == nil {
+		client = &http.Client{}
+	}
+
+	var transport *http.Transport
+	switch t := client.Transport.(type) {
+	case nil:
+		base, ok := http.DefaultTransport.(*http.Transport)
+		if !ok {
+			return nil, errors.New(
+				"http.DefaultTransport is not an *http.Transport",
+			)
+		}
+		transport = base.Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, fmt.Errorf(
+			"cannot apply proxy and TLS settings to transport %T", t,
+		)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if transport.TLSClientConfig != nil {
+		tlsConfig = transport.TLSClientConfig.Clone()
+	}
+
+	if c.CACertFile != "" {
+		pool, err := certPool(c.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if c.InsecureSkipVerify {
+		tlsConfig.InsecureSkipVerify = true //nolint:gosec // Opt-in for dev.
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if c.ProxyURL != "" {
+		proxy, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url: %w", err)
+		}
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf(
+				"invalid proxy_url %q: must be an absolute URL", c.ProxyURL,
+			)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	out := *client
+	out.Transport = transport
+
+	return &out, nil
+}
+
+// certPool returns the system roots with the certificates in file added.
+func certPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading ca_cert_file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf(
+			"ca_cert_file %q contains no PEM encoded certificates", file,
+		)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,174 @@
+package httpconfig
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigBaseURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		apiURL  string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "default",
+			want: DefaultAPIURL,
+		},
+		{
+			name:   "custom",
+			apiURL: "http://localhost:3000",
+			want:   "http://localhost:3000",
+		},
+		{
+			name:   "trailing slash",
+			apiURL: "http://localhost:3000/",
+			want:   "http://localhost:3000/",
+		},
+		{
+			name:    "path",
+			apiURL:  "https://katapult.example.com/api",
+			wantErr: "must not include a path",
+		},
+		{
+			name:    "relative",
+			apiURL:  "api.katapult.io",
+			wantErr: "must be an absolute http or https URL",
+		},
+		{
+			name:    "unsupported scheme",
+			apiURL:  "ftp://api.katapult.io",
+			wantErr: "must be an absolute http or https URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			u, err := Config{APIURL: tt.apiURL}.BaseURL()
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, u.String())
+		})
+	}
+}
+
+func TestConfigHTTPClientUnchanged(t *testing.T) {
+	t.Parallel()
+
+	client := &http.Client{}
+
+	got, err := Config{APIURL: "http://localhost"}.HTTPClient(client)
+
+	require.NoError(t, err)
+	assert.Same(t, client, got)
+}
+
+func TestConfigHTTPClientProxy(t *testing.T) {
+	t.Parallel()
+
+	client := &http.Client{}
+
+	got, err := Config{ProxyURL: "http://proxy:3128"}.HTTPClient(client)
+	require.NoError(t, err)
+	assert.Nil(t, client.Transport)
+
+	transport, ok := got.Transport.(*http.Transport)
+	require.True(t, ok)
+	req := httptest.NewRequest(http.MethodGet, DefaultAPIURL, nil)
+	proxy, err := transport.Proxy(req)
+	require.NoError(t, err)
+	assert.Equal(t, "http://proxy:3128", proxy.String())
+
+	_, err = Config{ProxyURL: "proxy"}.HTTPClient(client)
+	assert.ErrorContains(t, err, "invalid proxy_url")
+}
+
+func TestConfigHTTPClientCACertFile(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: server.Certificate().Raw,
+	}), 0o600))
+
+	_, err := http.Get(server.URL) //nolint:noctx
+	require.Error(t, err)
+
+	client, err := Config{CACertFile: caFile}.HTTPClient(nil)
+	require.NoError(t, err)
+
+	resp, err := client.Get(server.URL) //nolint:noctx
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	emptyFile := filepath.Join(dir, "empty.pem")
+	require.NoError(t, os.WriteFile(emptyFile, []byte("nope"), 0o600))
+
+	_, err = Config{CACertFile: emptyFile}.HTTPClient(nil)
+	assert.ErrorContains(t, err, "contains no PEM encoded certificates")
+
+	_, err = Config{
+		CACertFile: filepath.Join(dir, "missing.pem"),
+	}.HTTPClient(nil)
+	assert.ErrorContains(t, err, "reading ca_cert_file")
+}
+
+func TestConfigHTTPClientInsecureSkipVerify(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	client, err := Config{InsecureSkipVerify: true}.HTTPClient(nil)
+	require.NoError(t, err)
+
+	resp, err := client.Get(server.URL) //nolint:noctx
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestConfigHTTPClientCustomTransport(t *testing.T) {
+	t.Parallel()
+
+	client := &http.Client{Transport: roundTripperFunc(nil)}
+
+	_, err := Config{InsecureSkipVerify: true}.HTTPClient(client)
+
+	assert.ErrorContains(t, err, "cannot apply proxy and TLS settings")
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
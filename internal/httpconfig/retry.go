@@ -0,0 +1,320 @@
+package httpconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a failed request is retried
+	// when max_retries is not set.
+	DefaultMaxRetries = 10
+
+	// DefaultRetryWaitMin is the shortest wait between retries when
+	// retry_wait_min is not set.
+	DefaultRetryWaitMin = 1 * time.Second
+
+	// DefaultRetryWaitMax is the longest wait between retries when
+	// retry_wait_max is not set.
+	DefaultRetryWaitMax = 2 * time.Minute
+)
+
+// RetrySettings holds the raw retry and rate limit provider attributes. Unset
+// values fall back to their environment variables, and then to the defaults.
+type RetrySettings struct {
+	MaxRetries        *int64
+	RetryWaitMin      string
+	RetryWaitMax      string
+	RequestsPerSecond *float64
+	RetryErrorCodes   []string
+}
+
+// Retry holds the resolved retry and rate limit settings of the provider.
+type Retry struct {
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+
+	// WaitMin and WaitMax bound the exponential backoff between retries,
+	// and WaitMax also caps waits requested through Retry-After headers.
+	WaitMin time.Duration
+	WaitMax time.Duration
+
+	// RequestsPerSecond limits the rate of API requests made across all
+	// concurrent resource operations. Zero disables the limit.
+	RequestsPerSecond float64
+
+	// ErrorCodes are API error codes retried in addition to rate limited,
+	// server error and connection failures.
+	ErrorCodes []string
+}
+
+// Resolve returns the settings with environment variables and defaults
+// applied.
+func (s RetrySettings) Resolve() (Retry, error) {
+	r := Retry{
+		MaxRetries: DefaultMaxRetries,
+		WaitMin:    DefaultRetryWaitMin,
+		WaitMax:    DefaultRetryWaitMax,
+		ErrorCodes: s.RetryErrorCodes,
+	}
+
+	switch {
+	case s.MaxRetries != nil:
+		r.MaxRetries = int(*s.MaxRetries)
+	case os.Getenv("KATAPULT_MAX_RETRIES") != "":
+		n, err := strconv.Atoi(os.Getenv("KATAPULT_MAX_RETRIES"))
+		if err != nil {
+			return Retry{}, fmt.Errorf("invalid KATAPULT_MAX_RETRIES: %w", err)
+		}
+		r.MaxRetries = n
+	}
+	if r.MaxRetries < 0 {
+		return Retry{}, fmt.Errorf(
+			"invalid max_retries %d: must not be negative", r.MaxRetries,
+		)
+	}
+
+	var err error
+	r.WaitMin, err = duration(
+		"retry_wait_min", s.RetryWaitMin, "KATAPULT_RETRY_WAIT_MIN", r.WaitMin,
+	)
+	if err != nil {
+		return Retry{}, err
+	}
+	r.WaitMax, err = duration(
+		"retry_wait_max", s.RetryWaitMax, "KATAPULT_RETRY_WAIT_MAX", r.WaitMax,
+	)
+	if err != nil {
+		return Retry{}, err
+	}
+	if r.WaitMin > r.WaitMax {
+		return Retry{}, fmt.Errorf(
+			"retry_wait_min (%s) must not be greater than retry_wait_max (%s)",
+			r.WaitMin, r.WaitMax,
+		)
+	}
+
+	switch {
+	case s.RequestsPerSecond != nil:
+		r.RequestsPerSecond = *s.RequestsPerSecond
+	case os.Getenv("KATAPULT_REQUESTS_PER_SECOND") != "":
+		r.RequestsPerSecond, err = strconv.ParseFloat(
+			os.Getenv("KATAPULT_REQUESTS_PER_SECOND"), 64,
+		)
+		if err != nil {
+			return Retry{}, fmt.Errorf(
+				"invalid KATAPULT_REQUESTS_PER_SECOND: %w", err,
+			)
+		}
+	}
+	if r.RequestsPerSecond < 0 {
+		return Retry{}, fmt.Errorf(
+			"invalid requests_per_second %v: must not be negative",
+			r.RequestsPerSecond,
+		)
+	}
+
+	if s.RetryErrorCodes == nil {
+		for _, code := range strings.Split(
+			os.Getenv("KATAPULT_RETRY_ERROR_CODES"), ",",
+		) {
+			if code = strings.TrimSpace(code); code != "" {
+				r.ErrorCodes = append(r.ErrorCodes, code)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+func duration(
+	name string,
+	in string,
+	env string,
+	fallback time.Duration,
+) (time.Duration, error) {
+	if in == "" {
+		in = os.Getenv(env)
+	}
+	if in == "" {
+		return fallback, nil
+	}
+
+	d, err := time.ParseDuration(in)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", name, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid %s %q: must not be negative", name, in)
+	}
+
+	return d, nil
+}
+
+// RetryableErrorCode reports whether code is one of the configured ErrorCodes.
+func (r Retry) RetryableErrorCode(code string) bool {
+	for _, c := range r.ErrorCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Apply sets the retry bounds and backoff of client, and rate limits its
+// requests, retries included, through the limiter shared by every client
+// configured with the same RequestsPerSecond.
+func (r Retry) Apply(client *retryablehttp.Client) {
+	client.RetryMax = r.MaxRetries
+	client.RetryWaitMin = r.WaitMin
+	client.RetryWaitMax = r.WaitMax
+	client.Backoff = Backoff
+
+	if r.RequestsPerSecond > 0 {
+		hc := &http.Client{}
+		if client.HTTPClient != nil {
+			c := *client.HTTPClient
+			hc = &c
+		}
+		hc.Transport = &rateLimitedTransport{
+			base:    hc.Transport,
+			limiter: SharedLimiter(r.RequestsPerSecond),
+		}
+		client.HTTPClient = hc
+	}
+}
+
+// Backoff is a retryablehttp.Backoff which waits as long as a response's
+// Retry-After header asks for, up to max, regardless of the status code.
+// Other responses use retryablehttp.DefaultBackoff.
+func Backoff(
+	min, max time.Duration,
+	attemptNum int,
+	resp *http.Response,
+) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > max {
+				wait = max
+			}
+
+			return wait
+		}
+	}
+
+	return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func retryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+
+		return time.Duration(secs) * time.Second, true
+	}
+
+	at, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+	if wait := time.Until(at); wait > 0 {
+		return wait, true
+	}
+
+	return 0, true
+}
+
+// Limiter spaces out events evenly to a maximum rate.
+type Limiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewLimiter returns a Limiter allowing perSecond events each second.
+func NewLimiter(perSecond float64) *Limiter {
+	return &Limiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// Wait blocks until the next event is allowed, or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	wait := time.Until(at)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+var (
+	sharedLimitersMu sync.Mutex
+	sharedLimiters   = map[float64]*Limiter{}
+)
+
+// SharedLimiter returns the process wide Limiter for perSecond, so the SDKv2
+// and plugin framework halves of the provider draw from the same budget.
+func SharedLimiter(perSecond float64) *Limiter {
+	sharedLimitersMu.Lock()
+	defer sharedLimitersMu.Unlock()
+
+	l, ok := sharedLimiters[perSecond]
+	if !ok {
+		l = NewLimiter(perSecond)
+		sharedLimiters[perSecond] = l
+	}
+
+	return l
+}
+
+type rateLimitedTransport struct {
+	base    http.RoundTripper
+	limiter *Limiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,184 @@
+package httpconfig
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetrySettingsResolve(t *testing.T) {
+	maxRetries := int64(0)
+	rps := 2.5
+
+	tests := []struct {
+		name     string
+		settings RetrySettings
+		env      map[string]string
+		want     Retry
+		wantErr  string
+	}{
+		{
+			name: "defaults",
+			want: Retry{
+				MaxRetries: DefaultMaxRetries,
+				WaitMin:    DefaultRetryWaitMin,
+				WaitMax:    DefaultRetryWaitMax,
+			},
+		},
+		{
+			name: "attributes",
+			settings: RetrySettings{
+				MaxRetries:        &maxRetries,
+				RetryWaitMin:      "500ms",
+				RetryWaitMax:      "30s",
+				RequestsPerSecond: &rps,
+				RetryErrorCodes:   []string{"temporary_failure"},
+			},
+			env: map[string]string{
+				"KATAPULT_MAX_RETRIES":       "3",
+				"KATAPULT_RETRY_ERROR_CODES": "ignored",
+			},
+			want: Retry{
+				MaxRetries:        0,
+				WaitMin:           500 * time.Millisecond,
+				WaitMax:           30 * time.Second,
+				RequestsPerSecond: 2.5,
+				ErrorCodes:        []string{"temporary_failure"},
+			},
+		},
+		{
+			name: "environment",
+			env: map[string]string{
+				"KATAPULT_MAX_RETRIES":         "3",
+				"KATAPULT_RETRY_WAIT_MIN":      "2s",
+				"KATAPULT_RETRY_WAIT_MAX":      "1m",
+				"KATAPULT_REQUESTS_PER_SECOND": "5",
+				"KATAPULT_RETRY_ERROR_CODES":   "temporary_failure, broken",
+			},
+			want: Retry{
+				MaxRetries:        3,
+				WaitMin:           2 * time.Second,
+				WaitMax:           time.Minute,
+				RequestsPerSecond: 5,
+				ErrorCodes:        []string{"temporary_failure", "broken"},
+			},
+		},
+		{
+			name:     "invalid duration",
+			settings: RetrySettings{RetryWaitMin: "soon"},
+			wantErr:  "invalid retry_wait_min",
+		},
+		{
+			name:     "min above max",
+			settings: RetrySettings{RetryWaitMin: "5m"},
+			wantErr:  "must not be greater than retry_wait_max",
+		},
+		{
+			name:    "invalid environment",
+			env:     map[string]string{"KATAPULT_MAX_RETRIES": "many"},
+			wantErr: "invalid KATAPULT_MAX_RETRIES",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, env := range []string{
+				"KATAPULT_MAX_RETRIES",
+				"KATAPULT_RETRY_WAIT_MIN",
+				"KATAPULT_RETRY_WAIT_MAX",
+				"KATAPULT_REQUESTS_PER_SECOND",
+				"KATAPULT_RETRY_ERROR_CODES",
+			} {
+				t.Setenv(env, tt.env[env])
+			}
+
+			got, err := tt.settings.Resolve()
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	retryAfter := func(v string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusBadGateway,
+			Header:     http.Header{"Retry-After": []string{v}},
+		}
+	}
+
+	assert.Equal(t,
+		7*time.Second,
+		Backoff(time.Second, time.Minute, 0, retryAfter("7")),
+	)
+	assert.Equal(t,
+		time.Minute,
+		Backoff(time.Second, time.Minute, 0, retryAfter("3600")),
+	)
+	assert.Equal(t,
+		time.Duration(0),
+		Backoff(time.Second, time.Minute, 0, retryAfter(
+			time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+		)),
+	)
+	assert.Equal(t,
+		4*time.Second,
+		Backoff(time.Second, time.Minute, 2, &http.Response{
+			StatusCode: http.StatusBadGateway,
+		}),
+	)
+}
+
+func TestRetryApply(t *testing.T) {
+	t.Parallel()
+
+	client := retryablehttp.NewClient()
+	hc := &http.Client{Timeout: time.Minute}
+	client.HTTPClient = hc
+
+	Retry{
+		MaxRetries:        2,
+		WaitMin:           time.Second,
+		WaitMax:           time.Minute,
+		RequestsPerSecond: 1000,
+	}.Apply(client)
+
+	assert.Equal(t, 2, client.RetryMax)
+	assert.Equal(t, time.Second, client.RetryWaitMin)
+	assert.Equal(t, time.Minute, client.RetryWaitMax)
+	assert.NotSame(t, hc, client.HTTPClient)
+	assert.Equal(t, time.Minute, client.HTTPClient.Timeout)
+	assert.Nil(t, hc.Transport)
+
+	transport, ok := client.HTTPClient.Transport.(*rateLimitedTransport)
+	require.True(t, ok)
+	assert.Same(t, SharedLimiter(1000), transport.limiter)
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := NewLimiter(100)
+	start := time.Now()
+	for range 5 {
+		require.NoError(t, l.Wait(context.Background()))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	l = NewLimiter(0.001)
+	require.NoError(t, l.Wait(ctx))
+	assert.ErrorIs(t, l.Wait(ctx), context.Canceled)
+}
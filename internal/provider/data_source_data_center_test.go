@@ -23,13 +23,13 @@ func TestAccKatapultDataSourceDataCenter_default(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_data_center" "main" {}`,
+				Config: `data "katapult_legacy_data_center" "main" {}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDataCenterExists(
-						tt, "data.katapult_data_center.main",
+						tt, "data.katapult_legacy_data_center.main",
 					),
 					testAccCheckKatapultDataCenterAttrs(
-						"data.katapult_data_center.main", dc, "",
+						"data.katapult_legacy_data_center.main", dc, "",
 					),
 				),
 			},
@@ -49,17 +49,17 @@ func TestAccKatapultDataSourceDataCenter_by_id(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_data_center" "main" {
+					data "katapult_legacy_data_center" "main" {
 					  id = "%s"
 					}`,
 					dc.ID,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDataCenterExists(
-						tt, "data.katapult_data_center.main",
+						tt, "data.katapult_legacy_data_center.main",
 					),
 					testAccCheckKatapultDataCenterAttrs(
-						"data.katapult_data_center.main", dc, "",
+						"data.katapult_legacy_data_center.main", dc, "",
 					),
 				),
 			},
@@ -79,17 +79,17 @@ func TestAccKatapultDataSourceDataCenter_by_permalink(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_data_center" "main" {
+					data "katapult_legacy_data_center" "main" {
 					  permalink = "%s"
 					}`,
 					dc.Permalink,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDataCenterExists(
-						tt, "data.katapult_data_center.main",
+						tt, "data.katapult_legacy_data_center.main",
 					),
 					testAccCheckKatapultDataCenterAttrs(
-						"data.katapult_data_center.main", dc, "",
+						"data.katapult_legacy_data_center.main", dc, "",
 					),
 				),
 			},
@@ -109,7 +109,7 @@ func TestAccKatapultDataSourceDataCenter_invalid(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_data_center" "main" {
+					data "katapult_legacy_data_center" "main" {
 					  name = "%s"
 					}`,
 					dc.Name,
@@ -25,14 +25,14 @@ func TestAccKatapultDataSourceDiskTemplate_by_id(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_disk_template" "main" {
+					data "katapult_legacy_disk_template" "main" {
 					  id = "%s"
 					}`,
 					tpl.ID,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDiskTemplateAttrs(
-						"data.katapult_disk_template.main", tpl, "",
+						"data.katapult_legacy_disk_template.main", tpl, "",
 					),
 				),
 			},
@@ -54,14 +54,14 @@ func TestAccKatapultDataSourceDiskTemplate_by_permalink(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_disk_template" "main" {
+					data "katapult_legacy_disk_template" "main" {
 					  permalink = "%s"
 					}`,
 					tpl.Permalink,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDiskTemplateAttrs(
-						"data.katapult_disk_template.main", tpl, "",
+						"data.katapult_legacy_disk_template.main", tpl, "",
 					),
 				),
 			},
@@ -77,7 +77,7 @@ func TestAccKatapultDataSourceDiskTemplate_blank(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_disk_template" "main" {}`,
+				Config: `data "katapult_legacy_disk_template" "main" {}`,
 				ExpectError: regexp.MustCompile(
 					regexp.QuoteMeta("one of `id,permalink` must be specified"),
 				),
@@ -95,7 +95,7 @@ func TestAccKatapultDataSourceDiskTemplate_invalid(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
-					data "katapult_disk_template" "main" {
+					data "katapult_legacy_disk_template" "main" {
 					  name = "Ubuntu 20.04"
 					}`,
 				),
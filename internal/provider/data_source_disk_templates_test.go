@@ -22,10 +22,10 @@ func TestAccKatapultDataSourceDiskTemplates_default(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_disk_templates" "main" {}`,
+				Config: `data "katapult_legacy_disk_templates" "main" {}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDiskTemplates(
-						"data.katapult_disk_templates.main", tpls,
+						"data.katapult_legacy_disk_templates.main", tpls,
 					),
 				),
 			},
@@ -45,13 +45,13 @@ func TestAccKatapultDataSourceDiskTemplates_include_universal(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
-					data "katapult_disk_templates" "main" {
+					data "katapult_legacy_disk_templates" "main" {
 						include_universal = true
 					}`,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDiskTemplates(
-						"data.katapult_disk_templates.main", tpls,
+						"data.katapult_legacy_disk_templates.main", tpls,
 					),
 				),
 			},
@@ -78,13 +78,13 @@ func TestAccKatapultDataSourceDiskTemplates_exclude_universal(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
-					data "katapult_disk_templates" "main" {
+					data "katapult_legacy_disk_templates" "main" {
 						include_universal = false
 					}`,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultDiskTemplates(
-						"data.katapult_disk_templates.main", tpls,
+						"data.katapult_legacy_disk_templates.main", tpls,
 					),
 				),
 			},
@@ -25,30 +25,30 @@ func TestAccKatapultDataSourceNetworkSpeedProfile_by_id(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_network_speed_profile" "main" {
+					data "katapult_legacy_network_speed_profile" "main" {
 					  id = "%s"
 					}`,
 					profile.ID,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"id", profile.ID,
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"name", profile.Name,
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"permalink", profile.Permalink,
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"upload_speed", strconv.Itoa(profile.UploadSpeedInMbit),
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"download_speed",
 						strconv.Itoa(profile.DownloadSpeedInMbit),
 					),
@@ -73,30 +73,30 @@ func TestAccKatapultDataSourceNetworkSpeedProfile_by_permalink(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_network_speed_profile" "main" {
+					data "katapult_legacy_network_speed_profile" "main" {
 					  permalink = "%s"
 					}`,
 					profile.Permalink,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"id", profile.ID,
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"name", profile.Name,
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"permalink", profile.Permalink,
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"upload_speed", strconv.Itoa(profile.UploadSpeedInMbit),
 					),
 					resource.TestCheckResourceAttr(
-						"data.katapult_network_speed_profile.main",
+						"data.katapult_legacy_network_speed_profile.main",
 						"download_speed",
 						strconv.Itoa(profile.DownloadSpeedInMbit),
 					),
@@ -114,7 +114,7 @@ func TestAccKatapultDataSourceNetworkSpeedProfile_blank(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_network_speed_profile" "main" {}`,
+				Config: `data "katapult_legacy_network_speed_profile" "main" {}`,
 				ExpectError: regexp.MustCompile(
 					regexp.QuoteMeta("one of `id,permalink` must be specified"),
 				),
@@ -132,7 +132,7 @@ func TestAccKatapultDataSourceNetworkSpeedProfile_invalid(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
-					data "katapult_network_speed_profile" "main" {
+					data "katapult_legacy_network_speed_profile" "main" {
 					  name = "Ubuntu 20.04"
 					}`,
 				),
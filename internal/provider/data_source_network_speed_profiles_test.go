@@ -22,10 +22,10 @@ func TestAccKatapultDataSourceNetworkSpeedProfiles_all(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_network_speed_profiles" "main" {}`,
+				Config: `data "katapult_legacy_network_speed_profiles" "main" {}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultNetworkSpeedProfiles(
-						"data.katapult_network_speed_profiles.main",
+						"data.katapult_legacy_network_speed_profiles.main",
 						profiles,
 					),
 				),
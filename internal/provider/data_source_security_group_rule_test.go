@@ -9,14 +9,14 @@ import (
 )
 
 func TestAccKatapultDataSourceSecurityGroupRule_by_id(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultIPDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultIPDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -72,12 +72,12 @@ func TestAccKatapultDataSourceSecurityGroupRule_by_id(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroupRule_not_found(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
@@ -98,12 +98,12 @@ func TestAccKatapultDataSourceSecurityGroupRule_not_found(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroupRule_blank(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
@@ -9,14 +9,14 @@ import (
 )
 
 func TestAccKatapultDataSourceSecurityGroupRules_no_rules(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -52,14 +52,14 @@ func TestAccKatapultDataSourceSecurityGroupRules_no_rules(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroupRules_rules(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -218,12 +218,12 @@ func TestAccKatapultDataSourceSecurityGroupRules_rules(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroupRules_not_found(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
@@ -244,12 +244,12 @@ func TestAccKatapultDataSourceSecurityGroupRules_not_found(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroupRules_blank(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
@@ -9,14 +9,14 @@ import (
 )
 
 func TestAccKatapultDataSourceSecurityGroup_minimal(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -72,14 +72,14 @@ func TestAccKatapultDataSourceSecurityGroup_minimal(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroup_include_rules(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -320,14 +320,14 @@ func TestAccKatapultDataSourceSecurityGroup_include_rules(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroup_no_include_rules(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -419,12 +419,12 @@ func TestAccKatapultDataSourceSecurityGroup_no_include_rules(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroup_not_found(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
@@ -445,12 +445,12 @@ func TestAccKatapultDataSourceSecurityGroup_not_found(t *testing.T) {
 }
 
 func TestAccKatapultDataSourceSecurityGroup_blank(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.String(`
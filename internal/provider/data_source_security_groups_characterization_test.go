@@ -21,7 +21,7 @@ type expectedSecurityGroupRuleState struct {
 }
 
 func TestAccKatapultDataSourceSecurityGroups_include_rules(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	config := undent.Stringf(`
@@ -58,9 +58,9 @@ func TestAccKatapultDataSourceSecurityGroups_include_rules(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: config,
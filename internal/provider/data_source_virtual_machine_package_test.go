@@ -27,17 +27,17 @@ func TestAccKatapultDataSourceVirtualMachinePackage_by_id(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_virtual_machine_package" "main" {
+					data "katapult_legacy_virtual_machine_package" "main" {
 					  id = "%s"
 					}`,
 					pkg.ID,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultVirtualMachinePackageExists(
-						tt, "data.katapult_virtual_machine_package.main",
+						tt, "data.katapult_legacy_virtual_machine_package.main",
 					),
 					testAccCheckKatapultVirtualMachinePackageAttrs(
-						"data.katapult_virtual_machine_package.main",
+						"data.katapult_legacy_virtual_machine_package.main",
 						pkg, "",
 					),
 				),
@@ -60,17 +60,17 @@ func TestAccKatapultDataSourceVirtualMachinePackage_by_permalink(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
-					data "katapult_virtual_machine_package" "main" {
+					data "katapult_legacy_virtual_machine_package" "main" {
 					  permalink = "%s"
 					}`,
 					pkg.Permalink,
 				),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultVirtualMachinePackageExists(
-						tt, "data.katapult_virtual_machine_package.main",
+						tt, "data.katapult_legacy_virtual_machine_package.main",
 					),
 					testAccCheckKatapultVirtualMachinePackageAttrs(
-						"data.katapult_virtual_machine_package.main",
+						"data.katapult_legacy_virtual_machine_package.main",
 						pkg, "",
 					),
 				),
@@ -87,7 +87,7 @@ func TestAccKatapultDataSourceVirtualMachinePackage_blank(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_virtual_machine_package" "main" {}`,
+				Config: `data "katapult_legacy_virtual_machine_package" "main" {}`,
 				ExpectError: regexp.MustCompile(
 					regexp.QuoteMeta("one of `id,permalink` must be specified"),
 				),
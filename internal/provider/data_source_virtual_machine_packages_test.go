@@ -21,10 +21,10 @@ func TestAccKatapultDataSourceVirtualMachinePackages_all(t *testing.T) {
 		ProviderFactories: tt.ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: `data "katapult_virtual_machine_packages" "all" {}`,
+				Config: `data "katapult_legacy_virtual_machine_packages" "all" {}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKatapultVirtualMachinePackages(
-						"data.katapult_virtual_machine_packages.all", pkgs,
+						"data.katapult_legacy_virtual_machine_packages.all", pkgs,
 					),
 				),
 			},
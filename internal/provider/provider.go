@@ -3,10 +3,11 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
-	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -20,6 +21,7 @@ import (
 	"github.com/krystal/go-katapult"
 	"github.com/krystal/go-katapult/core"
 	corenext "github.com/krystal/go-katapult/next/core"
+	"github.com/krystal/terraform-provider-katapult/internal/httpconfig"
 )
 
 const defaultGeneratedNamePrefix = "tf"
@@ -128,24 +130,125 @@ Skip purging deleted resources from Katapult's trash when they are destroyed by
 						"`KATAPULT_LOG_LEVEL` environment variable. " +
 						"Defaults to `info`.",
 				},
+				"api_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+					DefaultFunc: schema.EnvDefaultFunc(
+						"KATAPULT_API_URL", "",
+					),
+					Description: "Base URL of the Katapult API, without a " +
+						"path. Can be specified with the `KATAPULT_API_URL` " +
+						"environment variable. Defaults to " +
+						"`https://api.katapult.io`.",
+				},
+				"ca_cert_file": {
+					Type:     schema.TypeString,
+					Optional: true,
+					DefaultFunc: schema.EnvDefaultFunc(
+						"KATAPULT_CA_CERT_FILE", "",
+					),
+					Description: "Path to a PEM encoded file of certificate " +
+						"authorities trusted, in addition to the system " +
+						"roots, when connecting to the API. Can be specified " +
+						"with the `KATAPULT_CA_CERT_FILE` environment variable.",
+				},
+				"proxy_url": {
+					Type:     schema.TypeString,
+					Optional: true,
+					DefaultFunc: schema.EnvDefaultFunc(
+						"KATAPULT_PROXY_URL", "",
+					),
+					Description: "URL of a proxy to send API requests through. " +
+						"Can be specified with the `KATAPULT_PROXY_URL` " +
+						"environment variable. When not set, the standard " +
+						"`HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` " +
+						"environment variables are used.",
+				},
+				"insecure_skip_verify": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Description: "Skip verification of the API's TLS " +
+						"certificate. Only intended for development against " +
+						"local APIs. Can be specified with the " +
+						"`KATAPULT_INSECURE_SKIP_VERIFY` environment variable. " +
+						"Defaults to `false`.",
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description: "Number of times a failed API request is " +
+						"retried. Can be specified with the " +
+						"`KATAPULT_MAX_RETRIES` environment variable. " +
+						"Defaults to `10`.",
+				},
+				"retry_wait_min": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Minimum time to wait between retries of a " +
+						"failed API request, as a duration such as `1s`. Can " +
+						"be specified with the `KATAPULT_RETRY_WAIT_MIN` " +
+						"environment variable. Defaults to `1s`.",
+				},
+				"retry_wait_max": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: "Maximum time to wait between retries of a " +
+						"failed API request, as a duration such as `2m`. Also " +
+						"caps waits requested by the API through " +
+						"`Retry-After` headers. Can be specified with the " +
+						"`KATAPULT_RETRY_WAIT_MAX` environment variable. " +
+						"Defaults to `2m`.",
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description: "Maximum number of API requests made per " +
+						"second, shared across all concurrent resource " +
+						"operations. Can be specified with the " +
+						"`KATAPULT_REQUESTS_PER_SECOND` environment variable. " +
+						"Defaults to `0`, which disables the limit.",
+				},
+				"retry_error_codes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Description: "API error codes, such as " +
+						"`temporary_failure`, on which a failed API request is " +
+						"retried, in addition to rate limited requests, server " +
+						"errors and connection failures. Can be specified as a " +
+						"comma-separated list with the " +
+						"`KATAPULT_RETRY_ERROR_CODES` environment variable.",
+				},
+				"default_tags": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Description: "Tags applied to every taggable resource " +
+						"managed by this provider, in addition to the tags " +
+						"configured on the resource. Tags which do not exist " +
+						"in the organization are created automatically.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"tags": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+								Description: "Set of tag names to apply " +
+									"to every taggable resource.",
+							},
+						},
+					},
+				},
 			},
-			ResourcesMap: map[string]*schema.Resource{
-				"katapult_security_group":      resourceSecurityGroup(),
-				"katapult_security_group_rule": resourceSecurityGroupRule(),
-			},
+			ResourcesMap: map[string]*schema.Resource{},
 
 			DataSourcesMap: map[string]*schema.Resource{
-				"katapult_data_center":              dataSourceDataCenter(),
-				"katapult_disk_template":            dataSourceDiskTemplate(),
-				"katapult_disk_templates":           dataSourceDiskTemplates(),
-				"katapult_network_speed_profile":    dataSourceNetworkSpeedProfile(),
-				"katapult_network_speed_profiles":   dataSourceNetworkSpeedProfiles(),
-				"katapult_security_group":           dataSourceSecurityGroup(),
-				"katapult_security_group_rule":      dataSourceSecurityGroupRule(),
-				"katapult_security_group_rules":     dataSourceSecurityGroupRules(),
-				"katapult_security_groups":          dataSourceSecurityGroups(),
-				"katapult_virtual_machine_package":  dataSourceVirtualMachinePackage(),
-				"katapult_virtual_machine_packages": dataSourceVirtualMachinePackages(),
+				"katapult_security_group":       dataSourceSecurityGroup(),
+				"katapult_security_group_rule":  dataSourceSecurityGroupRule(),
+				"katapult_security_group_rules": dataSourceSecurityGroupRules(),
+				"katapult_security_groups":      dataSourceSecurityGroups(),
 			},
 		}
 
@@ -159,6 +262,10 @@ Skip purging deleted resources from Katapult's trash when they are destroyed by
 
 			p.ResourcesMap["katapult_legacy_virtual_machine_group"] = resourceVirtualMachineGroup()
 
+			p.ResourcesMap["katapult_legacy_security_group"] = resourceSecurityGroup()
+
+			p.ResourcesMap["katapult_legacy_security_group_rule"] = resourceSecurityGroupRule()
+
 			// TEST DATA SOURCES
 
 			p.DataSourcesMap["katapult_legacy_file_storage_volume"] = dataSourceFileStorageVolume()
@@ -170,6 +277,20 @@ Skip purging deleted resources from Katapult's trash when they are destroyed by
 			p.DataSourcesMap["katapult_legacy_virtual_machine_group"] = dataSourceVirtualMachineGroup()
 
 			p.DataSourcesMap["katapult_legacy_virtual_machine_groups"] = dataSourceVirtualMachineGroups()
+
+			p.DataSourcesMap["katapult_legacy_data_center"] = dataSourceDataCenter()
+
+			p.DataSourcesMap["katapult_legacy_disk_template"] = dataSourceDiskTemplate()
+
+			p.DataSourcesMap["katapult_legacy_disk_templates"] = dataSourceDiskTemplates()
+
+			p.DataSourcesMap["katapult_legacy_network_speed_profile"] = dataSourceNetworkSpeedProfile()
+
+			p.DataSourcesMap["katapult_legacy_network_speed_profiles"] = dataSourceNetworkSpeedProfiles()
+
+			p.DataSourcesMap["katapult_legacy_virtual_machine_package"] = dataSourceVirtualMachinePackage()
+
+			p.DataSourcesMap["katapult_legacy_virtual_machine_packages"] = dataSourceVirtualMachinePackages()
 		}
 
 		p.ConfigureContextFunc = configure(c, p)
@@ -186,9 +307,11 @@ func stringOrEnv(in string, env string) string {
 	return os.Getenv(env)
 }
 
-func boolOrEnv(in bool, env string) bool {
-	if in {
-		return true
+// boolOrEnv returns in when it is set, and otherwise parses the env
+// environment variable. An explicit false therefore overrides the environment.
+func boolOrEnv(in *bool, env string) bool {
+	if in != nil {
+		return *in
 	}
 
 	switch strings.ToLower(os.Getenv(env)) {
@@ -199,6 +322,51 @@ func boolOrEnv(in bool, env string) bool {
 	return false
 }
 
+// configBool returns the value of the bool attribute key when it is set in
+// the provider configuration, and nil when it is not. Unlike d.Get, this
+// tells an explicit false apart from an omitted attribute.
+func configBool(d *schema.ResourceData, key string) *bool {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+
+	v := raw.GetAttr(key)
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	b := v.True()
+
+	return &b
+}
+
+// httpConfig resolves the provider attributes controlling how the API is
+// reached against the environment.
+func httpConfig(d *schema.ResourceData) httpconfig.Config {
+	apiURL := stringOrEnv(d.Get("api_url").(string), "KATAPULT_API_URL")
+	if apiURL == "" {
+		// Legacy debug override of API URL for internal testing.
+		apiURL = os.Getenv("KATAPULT_TF_DEBUG_API_URL")
+	}
+
+	return httpconfig.Config{
+		APIURL: apiURL,
+		CACertFile: stringOrEnv(
+			d.Get("ca_cert_file").(string),
+			"KATAPULT_CA_CERT_FILE",
+		),
+		ProxyURL: stringOrEnv(
+			d.Get("proxy_url").(string),
+			"KATAPULT_PROXY_URL",
+		),
+		InsecureSkipVerify: boolOrEnv(
+			configBool(d, "insecure_skip_verify"),
+			"KATAPULT_INSECURE_SKIP_VERIFY",
+		),
+	}
+}
+
 func configure(
 	conf *Config,
 	p *schema.Provider,
@@ -234,7 +402,7 @@ func configure(
 				"KATAPULT_ORGANIZATION",
 			),
 			SkipTrashObjectPurge: boolOrEnv(
-				d.Get("skip_trash_object_purge").(bool),
+				configBool(d, "skip_trash_object_purge"),
 				"KATAPULT_SKIP_TRASH_OBJECT_PURGE",
 			),
 			GeneratedNamePrefix: conf.GeneratedNamePrefix,
@@ -252,31 +420,39 @@ func configure(
 			),
 		}
 
+		httpConf := httpConfig(d)
+
 		httpClient := conf.HTTPClient
 		if httpClient == nil {
 			httpClient = &http.Client{Timeout: 60 * time.Second}
 		}
 
+		httpClient, err := httpConf.HTTPClient(httpClient)
+		if err != nil {
+			return m, diag.FromErr(err)
+		}
+
 		if conf.HTTPClient != nil {
 			opts = append(opts, katapult.WithHTTPClient(httpClient))
 		}
 
-		// Debug override of API URL for internal testing purposes.
-		if apiURL := os.Getenv("KATAPULT_TF_DEBUG_API_URL"); apiURL != "" {
-			u, err := url.Parse(apiURL)
-			if err != nil {
-				return m, diag.FromErr(err)
-			}
-
-			opts = append(opts, katapult.WithBaseURL(u))
+		u, err := httpConf.BaseURL()
+		if err != nil {
+			return m, diag.FromErr(err)
 		}
+		opts = append(opts, katapult.WithBaseURL(u))
 
 		c, err := katapult.New(opts...)
 		if err != nil {
 			return m, diag.FromErr(err)
 		}
 
-		rhc := newRetryableHTTPClient(httpClient, m.Logger)
+		retry, err := retrySettings(d).Resolve()
+		if err != nil {
+			return m, diag.FromErr(err)
+		}
+
+		rhc := newRetryableHTTPClient(httpClient, m.Logger, retry)
 		if conf.TestMode {
 			rhc.RetryMax = 0
 			rhc.RetryWaitMin = 0
@@ -326,30 +502,76 @@ func configure(
 	}
 }
 
+// retrySettings returns the raw retry attributes of d, leaving unset ones
+// empty so they fall back to their environment variables.
+func retrySettings(d *schema.ResourceData) httpconfig.RetrySettings {
+	raw := d.GetRawConfig()
+	s := httpconfig.RetrySettings{
+		RetryWaitMin: d.Get("retry_wait_min").(string),
+		RetryWaitMax: d.Get("retry_wait_max").(string),
+	}
+
+	if raw.IsNull() {
+		return s
+	}
+
+	if !raw.GetAttr("max_retries").IsNull() {
+		n := int64(d.Get("max_retries").(int))
+		s.MaxRetries = &n
+	}
+	if !raw.GetAttr("requests_per_second").IsNull() {
+		rps := d.Get("requests_per_second").(float64)
+		s.RequestsPerSecond = &rps
+	}
+	if !raw.GetAttr("retry_error_codes").IsNull() {
+		s.RetryErrorCodes = []string{}
+		for _, code := range d.Get("retry_error_codes").([]interface{}) {
+			s.RetryErrorCodes = append(s.RetryErrorCodes, code.(string))
+		}
+	}
+
+	return s
+}
+
 func newRetryableHTTPClient(
 	httpClient *http.Client,
 	logger hclog.Logger,
+	retry httpconfig.Retry,
 ) *retryablehttp.Client {
 	client := retryablehttp.NewClient()
 	client.HTTPClient = httpClient
 	client.Logger = logger
 
-	client.RetryWaitMin = 1 * time.Second
-	client.RetryWaitMax = 2 * time.Minute
-	client.RetryMax = 4
-	client.CheckRetry = requestRetryPolicy
+	retry.Apply(client)
+	client.CheckRetry = requestRetryPolicy(retry)
 
 	return client
 }
 
-func requestRetryPolicy(
-	ctx context.Context,
-	resp *http.Response,
-	err error,
-) (bool, error) {
-	if resp == nil || resp.StatusCode == http.StatusTooManyRequests {
-		return true, err
-	}
+func requestRetryPolicy(retry httpconfig.Retry) retryablehttp.CheckRetry {
+	return func(
+		ctx context.Context,
+		resp *http.Response,
+		err error,
+	) (bool, error) {
+		if resp == nil || resp.StatusCode == http.StatusTooManyRequests {
+			return true, err
+		}
+
+		if len(retry.ErrorCodes) > 0 && resp.StatusCode >= 400 {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				return true, readErr
+			}
 
-	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+			apiErr := parseGenericAPIError(body)
+			if apiErr != nil && retry.RetryableErrorCode(apiErr.Code) {
+				return true, nil
+			}
+		}
+
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
 }
@@ -14,9 +14,16 @@ import (
 
 	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/dnaeon/go-vcr/recorder"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/krystal/terraform-provider-katapult/internal/v6provider"
 	"github.com/krystal/terraform-provider-katapult/internal/vcrtest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -88,6 +95,59 @@ func providerFactories(
 	}
 }
 
+type muxedProviderFactoryList map[string]func() (
+	tfprotov6.ProviderServer, error,
+)
+
+// muxedProviderFactories returns the provider as it is released: this
+// provider muxed with the framework provider, which implements resources
+// that have been moved out of this package.
+func muxedProviderFactories(
+	httpClient *http.Client,
+) muxedProviderFactoryList {
+	conf := &Config{
+		Version:             testAccProviderVersion,
+		GeneratedNamePrefix: testAccResourceNamePrefix,
+	}
+	v6conf := &v6provider.KatapultProvider{
+		Version:             testAccProviderVersion,
+		GeneratedNamePrefix: testAccResourceNamePrefix,
+	}
+
+	if httpClient != nil {
+		conf.HTTPClient = httpClient
+		v6conf.HTTPClient = httpClient
+		if r, ok := httpClient.Transport.(*recorder.Recorder); ok &&
+			r.Mode() == recorder.ModeReplaying {
+			conf.TestMode = true
+			v6conf.TestMode = true
+		}
+	}
+
+	return muxedProviderFactoryList{
+		"katapult": func() (tfprotov6.ProviderServer, error) {
+			ctx := context.Background()
+
+			upgradedSDKServer, err := tf5to6server.UpgradeServer(
+				ctx, New(conf)().GRPCProvider,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			muxServer, err := tf6muxserver.NewMuxServer(ctx,
+				func() tfprotov6.ProviderServer { return upgradedSDKServer },
+				providerserver.NewProtocol6(v6provider.New(v6conf)()),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return muxServer.ProviderServer(), nil
+		},
+	}
+}
+
 type stopRequests struct{}
 
 func (s *stopRequests) RoundTrip(_ *http.Request) (*http.Response, error) {
@@ -103,8 +163,11 @@ type testTools struct {
 	HTTPClient        *http.Client
 	Meta              *Meta
 	ProviderFactories providerFactoryList
-	noHTTP            bool
-	randID            string
+	// MuxedProviderFactories serve resources which have been moved to the
+	// framework provider, such as katapult_security_group.
+	MuxedProviderFactories muxedProviderFactoryList
+	noHTTP                 bool
+	randID                 string
 }
 
 func newTestTools(t *testing.T) *testTools {
@@ -126,12 +189,13 @@ func newTestTools(t *testing.T) *testTools {
 	m := p.Meta().(*Meta)
 
 	return &testTools{
-		T:                 t,
-		Ctx:               ctx,
-		Recorder:          r,
-		HTTPClient:        httpClient,
-		Meta:              m,
-		ProviderFactories: factories,
+		T:                      t,
+		Ctx:                    ctx,
+		Recorder:               r,
+		HTTPClient:             httpClient,
+		Meta:                   m,
+		ProviderFactories:      factories,
+		MuxedProviderFactories: muxedProviderFactories(httpClient),
 	}
 }
 
@@ -214,18 +278,6 @@ func testDataFilePath(t *testing.T, suffix string) string {
 	return filepath.Join(".", "testdata", baseName)
 }
 
-func exampleResourceConfig(t *testing.T, name string) string {
-	t.Helper()
-
-	filename := filepath.Join(
-		"..", "..", "examples", "resources", name, "resource.tf",
-	)
-	data, err := os.ReadFile(filename)
-	require.NoError(t, err)
-
-	return string(data)
-}
-
 func vcrMode() recorder.Mode {
 	switch strings.ToLower(os.Getenv("VCR")) {
 	case "disabled", "off", "no", "0":
@@ -285,3 +337,93 @@ func TestProvider(t *testing.T) {
 	err := pf().InternalValidate()
 	require.NoError(t, err)
 }
+
+// testProviderResourceData returns the ResourceData the provider is
+// configured with for a configuration setting only attrs.
+func testProviderResourceData(
+	t *testing.T,
+	attrs map[string]cty.Value,
+) *schema.ResourceData {
+	t.Helper()
+
+	p := New(&Config{Version: testAccProviderVersion})()
+	block := schema.InternalMap(p.Schema).CoreConfigSchema()
+
+	vals := map[string]cty.Value{}
+	for name, ty := range block.ImpliedType().AttributeTypes() {
+		vals[name] = cty.NullVal(ty)
+	}
+	for name, v := range attrs {
+		vals[name] = v
+	}
+
+	var data *schema.ResourceData
+	p.ConfigureContextFunc = func(
+		_ context.Context,
+		d *schema.ResourceData,
+	) (interface{}, diag.Diagnostics) {
+		data = d
+
+		return nil, nil
+	}
+
+	// Mirror the gRPC server, which keeps the raw configuration alongside the
+	// shimmed one.
+	config := terraform.NewResourceConfigShimmed(cty.ObjectVal(vals), block)
+	config.CtyValue = cty.ObjectVal(vals)
+
+	diags := p.Configure(context.Background(), config)
+	require.False(t, diags.HasError(), diags)
+	require.NotNil(t, data)
+
+	return data
+}
+
+func TestHTTPConfigInsecureSkipVerify(t *testing.T) {
+	tests := []struct {
+		name   string
+		config cty.Value
+		env    string
+		want   bool
+	}{
+		{
+			name:   "unset",
+			config: cty.NullVal(cty.Bool),
+			want:   false,
+		},
+		{
+			name:   "unset with env",
+			config: cty.NullVal(cty.Bool),
+			env:    "true",
+			want:   true,
+		},
+		{
+			name:   "true",
+			config: cty.True,
+			want:   true,
+		},
+		{
+			name:   "false overrides env",
+			config: cty.False,
+			env:    "true",
+			want:   false,
+		},
+		{
+			name:   "true overrides env",
+			config: cty.True,
+			env:    "false",
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("KATAPULT_INSECURE_SKIP_VERIFY", tt.env)
+
+			d := testProviderResourceData(t, map[string]cty.Value{
+				"insecure_skip_verify": tt.config,
+			})
+
+			assert.Equal(t, tt.want, httpConfig(d).InsecureSkipVerify)
+		})
+	}
+}
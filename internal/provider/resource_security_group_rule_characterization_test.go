@@ -8,7 +8,7 @@ import (
 )
 
 func TestAccKatapultSecurityGroupRule_external_rules_coexistence(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	config := undent.Stringf(`
@@ -59,8 +59,8 @@ func TestAccKatapultSecurityGroupRule_external_rules_coexistence(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
 		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
 			testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 			testAccCheckKatapultSecurityGroupDestroy(tt),
@@ -79,7 +79,7 @@ func TestAccKatapultSecurityGroupRule_external_rules_coexistence(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroupRule_empty_optional_values(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	var ruleID string
@@ -142,8 +142,8 @@ func TestAccKatapultSecurityGroupRule_empty_optional_values(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
 		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
 			testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 			testAccCheckKatapultSecurityGroupDestroy(tt),
@@ -5,65 +5,20 @@ import (
 	"regexp"
 	"testing"
 
-	"github.com/dnaeon/go-vcr/recorder"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/jimeh/undent"
 )
 
-func TestAccKatapultSecurityGroupRule_example(t *testing.T) {
-	if vcrMode() == recorder.ModeReplaying {
-		t.Skip("example based tests are not supported in replay mode")
-	}
-
-	tt := newTestTools(t)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
-		Steps: []resource.TestStep{
-			{
-				Config: exampleResourceConfig(
-					t, "katapult_security_group_rule",
-				),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.minimal",
-					),
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.http",
-					),
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.ssh",
-					),
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.range",
-					),
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.range_all_ports",
-					),
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.smtp",
-					),
-					testAccCheckKatapultSecurityGroupRuleExists(
-						tt, "katapult_security_group_rule.http_out",
-					),
-				),
-			},
-		},
-	})
-}
-
 func TestAccKatapultSecurityGroupRule_minimal(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -108,14 +63,14 @@ func TestAccKatapultSecurityGroupRule_minimal(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroupRule_update(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -228,14 +183,14 @@ func TestAccKatapultSecurityGroupRule_update(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroupRule_tcp(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -344,14 +299,14 @@ func TestAccKatapultSecurityGroupRule_tcp(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroupRule_udp(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -460,14 +415,14 @@ func TestAccKatapultSecurityGroupRule_udp(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroupRule_icmp(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -593,14 +548,14 @@ func TestAccKatapultSecurityGroupRule_icmp(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroupRule_invalid(t *testing.T) {
-	tt := newTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 
 	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: undent.Stringf(`
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"testing"
@@ -21,7 +23,19 @@ const (
 	securityGroupTargetsJSONField      = "targets"
 )
 
-func newSecurityGroupCharacterizationTestTools(t *testing.T) *testTools {
+var (
+	// apiObjectIDPattern matches object IDs such as sg_nGEX9Khqu5X5P8dG.
+	apiObjectIDPattern = regexp.MustCompile(`^[a-z]+_[A-Za-z0-9]{16}$`)
+
+	// apiLookupQueryPattern matches lookup query parameters such as
+	// security_group[id].
+	apiLookupQueryPattern = regexp.MustCompile(`^[a-z_]+\[[a-z_]+\]$`)
+)
+
+// newSecurityGroupTestTools returns test tools for tests of the security
+// group resources, which replay cassettes recorded from the SDKv2
+// implementation against the framework implementation.
+func newSecurityGroupTestTools(t *testing.T) *testTools {
 	t.Helper()
 
 	tt := newTestTools(t)
@@ -32,6 +46,12 @@ func newSecurityGroupCharacterizationTestTools(t *testing.T) *testTools {
 	return tt
 }
 
+// securityGroupJSONMatcher matches requests by method, canonical URL and
+// semantic JSON body. The SDKv2 client and next/core look objects up in
+// different places, as "_" or object ID path segments with query parameters,
+// or as named path segments with lookups in the body, so each request is
+// reduced to its path, its lookups and the rest of its body before being
+// compared.
 func securityGroupJSONMatcher(
 	request *http.Request,
 	recorded cassette.Request,
@@ -41,11 +61,130 @@ func securityGroupJSONMatcher(
 		return false
 	}
 
-	if !cassette.DefaultMatcher(request, recorded) {
+	if request.Method != recorded.Method {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	actual, ok := canonicalAPIRequest(request.URL, body)
+	if !ok {
+		return false
+	}
+	want, ok := canonicalAPIRequest(recordedURL, []byte(recorded.Body))
+	if !ok {
+		return false
+	}
+
+	if actual.Path != want.Path ||
+		!reflect.DeepEqual(actual.Lookups, want.Lookups) ||
+		actual.Query.Encode() != want.Query.Encode() {
 		return false
 	}
 
-	return securityGroupJSONBodiesEqual(body, []byte(recorded.Body))
+	return securityGroupJSONBodiesEqual(actual.Body, want.Body)
+}
+
+type apiRequest struct {
+	Path    string
+	Lookups map[string]string
+	Query   url.Values
+	Body    []byte
+}
+
+// canonicalAPIRequest reduces a request to the API to a form which does not
+// depend on where object lookups are given:
+//
+//   - "_" path segments, and segments naming the object being looked up such
+//     as "security_group", become "_".
+//   - Object ID path segments become "_", with the ID recorded as a lookup of
+//     the object named by the preceding segment.
+//   - Lookup query parameters, and top-level lookup objects in a JSON body,
+//     are recorded as lookups.
+//
+// The per_page query parameter is dropped, as it only changes how results
+// are split into pages, which paginated requests follow from the responses.
+func canonicalAPIRequest(u *url.URL, body []byte) (*apiRequest, bool) {
+	req := &apiRequest{
+		Lookups: map[string]string{},
+		Query:   url.Values{},
+		Body:    body,
+	}
+
+	segments := strings.Split(u.Path, "/")
+	for i, segment := range segments {
+		if i == 0 {
+			continue
+		}
+		object := strings.TrimSuffix(segments[i-1], "s")
+
+		switch {
+		case segment == "_":
+		case apiObjectIDPattern.MatchString(segment):
+			req.Lookups[object+"[id]"] = segment
+		case i > 1 && strings.HasSuffix(segment, "_rule") &&
+			strings.HasPrefix(segments[i-2], strings.TrimSuffix(segment, "_rule")):
+			// security_groups/rules/security_group_rule
+		case segment == object:
+		default:
+			continue
+		}
+		segments[i] = "_"
+	}
+	req.Path = strings.Join(segments, "/")
+
+	for key, values := range u.Query() {
+		switch {
+		case key == "per_page":
+		case apiLookupQueryPattern.MatchString(key) && len(values) == 1:
+			req.Lookups[key] = values[0]
+		default:
+			req.Query[key] = values
+		}
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return req, true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &fields); err != nil {
+		return req, true
+	}
+
+	for name, raw := range fields {
+		var lookup map[string]any
+		if name == "properties" || json.Unmarshal(raw, &lookup) != nil ||
+			len(lookup) != 1 {
+			continue
+		}
+
+		for key, value := range lookup {
+			s, ok := value.(string)
+			if !ok {
+				continue
+			}
+			req.Lookups[name+"["+key+"]"] = s
+			delete(fields, name)
+		}
+	}
+
+	if len(fields) == 0 {
+		req.Body = nil
+		return req, true
+	}
+
+	rest, err := json.Marshal(fields)
+	if err != nil {
+		return nil, false
+	}
+	req.Body = rest
+
+	return req, true
 }
 
 func readAndRestoreRequestBody(request *http.Request) ([]byte, error) {
@@ -236,6 +375,88 @@ func TestSecurityGroupJSONMatcher(t *testing.T) {
 			},
 			want: true,
 		},
+		"lookup in body and query": {
+			method: http.MethodDelete,
+			url:    "https://api.example.test/core/v1/security_groups/security_group",
+			body:   `{"security_group":{"id":"sg_nGEX9Khqu5X5P8dG"}}`,
+			recorded: cassette.Request{
+				Method: http.MethodDelete,
+				URL: "https://api.example.test/core/v1/security_groups/_" +
+					"?security_group%5Bid%5D=sg_nGEX9Khqu5X5P8dG",
+			},
+			want:         true,
+			wantReadable: true,
+		},
+		"different lookups": {
+			method: http.MethodDelete,
+			url:    "https://api.example.test/core/v1/security_groups/security_group",
+			body:   `{"security_group":{"id":"sg_nGEX9Khqu5X5P8dG"}}`,
+			recorded: cassette.Request{
+				Method: http.MethodDelete,
+				URL: "https://api.example.test/core/v1/security_groups/_" +
+					"?security_group%5Bid%5D=sg_63aGh7frqqmWclZA",
+			},
+			want:         false,
+			wantReadable: true,
+		},
+		"object ID path segment": {
+			method: http.MethodPost,
+			url: "https://api.example.test/core/v1/security_groups/" +
+				"security_group/rules",
+			body: `{
+				"security_group": {"id": "sg_nGEX9Khqu5X5P8dG"},
+				"properties": {"protocol": "TCP", "ports": "22"}
+			}`,
+			recorded: cassette.Request{
+				Method: http.MethodPost,
+				URL: "https://api.example.test/core/v1/security_groups/" +
+					"sg_nGEX9Khqu5X5P8dG/rules",
+				Body: `{"properties":{"protocol":"TCP","ports":"22"}}`,
+			},
+			want:         true,
+			wantReadable: true,
+		},
+		"named rule path segment": {
+			method: http.MethodPatch,
+			url: "https://api.example.test/core/v1/security_groups/rules/" +
+				"security_group_rule",
+			body: `{
+				"security_group_rule": {"id": "sgr_nGEX9Khqu5X5P8dG"},
+				"properties": {"notes": "SSH"}
+			}`,
+			recorded: cassette.Request{
+				Method: http.MethodPatch,
+				URL: "https://api.example.test/core/v1/security_groups/rules/_" +
+					"?security_group_rule%5Bid%5D=sgr_nGEX9Khqu5X5P8dG",
+				Body: `{"properties":{"notes":"SSH"}}`,
+			},
+			want:         true,
+			wantReadable: true,
+		},
+		"page size": {
+			method: http.MethodGet,
+			url: "https://api.example.test/core/v1/security_groups/" +
+				"security_group/rules?page=1&per_page=100" +
+				"&security_group%5Bid%5D=sg_nGEX9Khqu5X5P8dG",
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL: "https://api.example.test/core/v1/security_groups/_/rules" +
+					"?page=1&security_group%5Bid%5D=sg_nGEX9Khqu5X5P8dG",
+			},
+			want: true,
+		},
+		"different page": {
+			method: http.MethodGet,
+			url: "https://api.example.test/core/v1/security_groups/" +
+				"security_group/rules?page=2" +
+				"&security_group%5Bid%5D=sg_nGEX9Khqu5X5P8dG",
+			recorded: cassette.Request{
+				Method: http.MethodGet,
+				URL: "https://api.example.test/core/v1/security_groups/_/rules" +
+					"?page=1&security_group%5Bid%5D=sg_nGEX9Khqu5X5P8dG",
+			},
+			want: false,
+		},
 		"empty and non-empty bodies": {
 			method: http.MethodPost,
 			url:    "https://api.example.test/security_groups",
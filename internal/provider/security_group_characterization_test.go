@@ -14,7 +14,7 @@ import (
 )
 
 func TestAccKatapultSecurityGroup_external_rules_enable(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	var inboundRuleID string
@@ -49,9 +49,9 @@ func TestAccKatapultSecurityGroup_external_rules_enable(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: managedConfig,
@@ -103,7 +103,7 @@ func TestAccKatapultSecurityGroup_external_rules_enable(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroup_external_rules_disable(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	var inboundRuleID string
@@ -186,9 +186,9 @@ func TestAccKatapultSecurityGroup_external_rules_disable(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: externalConfig,
@@ -315,7 +315,7 @@ func TestAccKatapultSecurityGroup_external_rules_disable(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroup_out_of_band_deletion(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	var groupID string
@@ -346,8 +346,8 @@ func TestAccKatapultSecurityGroup_out_of_band_deletion(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
 		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
 			testAccCheckKatapultSecurityGroupRuleDestroy(tt),
 			testAccCheckKatapultSecurityGroupDestroy(tt),
@@ -397,7 +397,7 @@ func TestAccKatapultSecurityGroup_out_of_band_deletion(t *testing.T) {
 }
 
 func TestAccKatapultSecurityGroup_partial_rule_creation_failure(t *testing.T) {
-	tt := newSecurityGroupCharacterizationTestTools(t)
+	tt := newSecurityGroupTestTools(t)
 
 	name := tt.ResourceName()
 	var groupID string
@@ -423,9 +423,9 @@ func TestAccKatapultSecurityGroup_partial_rule_creation_failure(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: tt.ProviderFactories,
-		CheckDestroy:      testAccCheckKatapultSecurityGroupDestroy(tt),
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.MuxedProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
 		Steps: []resource.TestStep{
 			{
 				Config: failingConfig,
@@ -84,7 +84,7 @@ func (ds *AddressListDataSource) Read(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Address List Error", err.Error())
@@ -17,8 +17,9 @@ type (
 	}
 
 	AddressListEntriesDataSourceModel struct {
-		AddressListID types.String `tfsdk:"address_list_id"`
-		Entries       types.Set    `tfsdk:"entries"`
+		AddressListID types.String            `tfsdk:"address_list_id"`
+		Entries       types.Set               `tfsdk:"entries"`
+		Filter        []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -78,6 +79,9 @@ func (ds *AddressListEntriesDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -93,6 +97,22 @@ func (ds *AddressListEntriesDataSource) Read(
 		return
 	}
 
+	listValueType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":      types.StringType,
+			"name":    types.StringType,
+			"address": types.StringType,
+		},
+	}
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterAttrNames(listValueType.AttrTypes),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	entries := []core.AddressListEntry{}
 	totalPages := 2
 
@@ -103,7 +123,7 @@ func (ds *AddressListEntriesDataSource) Read(
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 
 			resp.Diagnostics.AddError("Address List Entries Error", err.Error())
@@ -114,14 +134,6 @@ func (ds *AddressListEntriesDataSource) Read(
 		totalPages, _ = res.JSON200.Pagination.TotalPages.Get()
 	}
 
-	listValueType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":      types.StringType,
-			"name":    types.StringType,
-			"address": types.StringType,
-		},
-	}
-
 	entryListValues, diags := convertAddrListEntriesToValues(
 		entries,
 		listValueType.AttrTypes,
@@ -133,7 +145,7 @@ func (ds *AddressListEntriesDataSource) Read(
 
 	entriesValue, diags := types.SetValue(
 		listValueType,
-		entryListValues,
+		filterDataSourceObjects(filters, entryListValues),
 	)
 
 	resp.Diagnostics.Append(diags...)
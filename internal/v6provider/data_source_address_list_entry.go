@@ -88,7 +88,7 @@ func (ds *AddressListEntryDataSource) Read(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Address List Entry Error", err.Error())
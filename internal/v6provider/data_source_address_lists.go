@@ -17,7 +17,9 @@ type (
 	}
 
 	AddressListsDataSourceModel struct {
-		AddressLists types.Set `tfsdk:"address_lists"`
+		Organization types.String            `tfsdk:"organization"`
+		AddressLists types.Set               `tfsdk:"address_lists"`
+		Filter       []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -57,6 +59,7 @@ func (ds *AddressListsDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"address_lists": schema.SetNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -71,6 +74,9 @@ func (ds *AddressListsDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -86,18 +92,35 @@ func (ds *AddressListsDataSource) Read(
 		return
 	}
 
+	org := ds.M.organization(data.Organization)
+
+	listValueType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterAttrNames(listValueType.AttrTypes),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addressLists := []core.GetOrganizationAddressLists200ResponseAddressLists{}
 	totalPages := 2
 
 	for i := 1; i < totalPages; i++ {
 		res, err := ds.M.Core.GetOrganizationAddressListsWithResponse(ctx,
 			&core.GetOrganizationAddressListsParams{
-				OrganizationSubDomain: &ds.M.confOrganization,
+				OrganizationSubDomain: &org,
 				Page:                  &i,
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 
 			resp.Diagnostics.AddError("Address Lists Error", err.Error())
@@ -108,13 +131,6 @@ func (ds *AddressListsDataSource) Read(
 		totalPages, _ = res.JSON200.Pagination.TotalPages.Get()
 	}
 
-	listValueType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":   types.StringType,
-			"name": types.StringType,
-		},
-	}
-
 	addrListValues, diags := convertAddrListsToValues(
 		addressLists,
 		listValueType.AttrTypes,
@@ -126,7 +142,7 @@ func (ds *AddressListsDataSource) Read(
 
 	addrListValue, diags := types.SetValue(
 		listValueType,
-		addrListValues,
+		filterDataSourceObjects(filters, addrListValues),
 	)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
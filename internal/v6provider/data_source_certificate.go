@@ -0,0 +1,239 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	CertificateDataSource struct {
+		M *Meta
+	}
+
+	CertificateDataSourceModel struct {
+		ID                  types.String `tfsdk:"id"`
+		Name                types.String `tfsdk:"name"`
+		AdditionalNames     types.List   `tfsdk:"additional_names"`
+		Issuer              types.String `tfsdk:"issuer"`
+		State               types.String `tfsdk:"state"`
+		AuthorizationMethod types.String `tfsdk:"authorization_method"`
+		IssueError          types.String `tfsdk:"issue_error"`
+		ExpiresAt           types.String `tfsdk:"expires_at"`
+		LastIssuedAt        types.String `tfsdk:"last_issued_at"`
+		Certificate         types.String `tfsdk:"certificate"`
+		Chain               types.String `tfsdk:"chain"`
+		PrivateKey          types.String `tfsdk:"private_key"`
+	}
+)
+
+const (
+	certificateIDDescription   = "The ID of the certificate."
+	certificateNameDescription = "The primary hostname the certificate " +
+		"is issued for."
+	certificateIssuerDescription = "The issuer of the certificate " +
+		"(`lets_encrypt`, `self_signed`, or `custom`)."
+	certificateStateDescription = "The state of the certificate " +
+		"(`pending`, `issuing`, `issued`, or `issue_failed`)."
+	certificateExpiresAtDescription = "The time the certificate expires, " +
+		"in RFC 3339 format."
+	certificateLastIssuedAtDescription = "The time the certificate was " +
+		"last issued, in RFC 3339 format."
+)
+
+func (ds *CertificateDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (ds *CertificateDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	ds.M = meta
+}
+
+func (ds *CertificateDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a certificate, for use with load balancer rules.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: certificateIDDescription,
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: certificateNameDescription,
+			},
+			"additional_names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Additional hostnames the certificate is " +
+					"issued for.",
+			},
+			"issuer": schema.StringAttribute{
+				Computed:            true,
+				Description:         certificateIssuerDescription,
+				MarkdownDescription: certificateIssuerDescription,
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Description:         certificateStateDescription,
+				MarkdownDescription: certificateStateDescription,
+			},
+			"authorization_method": schema.StringAttribute{
+				Computed: true,
+				Description: "The method used to authorize issuance of " +
+					"the certificate.",
+			},
+			"issue_error": schema.StringAttribute{
+				Computed: true,
+				Description: "The error returned by the last failed " +
+					"issuance attempt, if any.",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: certificateExpiresAtDescription,
+			},
+			"last_issued_at": schema.StringAttribute{
+				Computed:    true,
+				Description: certificateLastIssuedAtDescription,
+			},
+			"certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			"chain": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded intermediate certificate chain.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate.",
+			},
+		},
+	}
+}
+
+func (ds *CertificateDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data CertificateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.ID.ValueString()
+
+	res, err := ds.M.Core.GetCertificateWithResponse(ctx,
+		&core.GetCertificateParams{
+			CertificateId: &id,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Certificate Error", err.Error())
+		return
+	}
+
+	if len(res.JSON200.Certificate) == 0 {
+		resp.Diagnostics.AddError(
+			"Certificate Error",
+			fmt.Sprintf("certificate %s not found", id),
+		)
+		return
+	}
+
+	cert := res.JSON200.Certificate[0]
+
+	data.ID = types.StringPointerValue(cert.Id)
+	data.Name = types.StringPointerValue(cert.Name)
+	data.AuthorizationMethod = nullableStringValue(cert.AuthorizationMethod)
+	data.IssueError = nullableStringValue(cert.IssueError)
+	data.ExpiresAt = unixTimestampValue(cert.ExpiresAt)
+	data.LastIssuedAt = unixTimestampValue(cert.LastIssuedAt)
+	data.Certificate = nullableStringValue(cert.Certificate)
+	data.Chain = nullableStringValue(cert.Chain)
+	data.PrivateKey = nullableStringValue(cert.PrivateKey)
+
+	data.Issuer = types.StringNull()
+	if cert.Issuer != nil {
+		data.Issuer = types.StringValue(string(*cert.Issuer))
+	}
+
+	data.State = types.StringNull()
+	if cert.State != nil {
+		data.State = types.StringValue(string(*cert.State))
+	}
+
+	additionalNames := []string{}
+	if cert.AdditionalNames != nil {
+		additionalNames = *cert.AdditionalNames
+	}
+
+	names, diags := types.ListValueFrom(ctx, types.StringType, additionalNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.AdditionalNames = names
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func unixTimestampValue(value interface {
+	IsSpecified() bool
+	IsNull() bool
+	Get() (int, error)
+},
+) types.String {
+	if !value.IsSpecified() || value.IsNull() {
+		return types.StringNull()
+	}
+
+	ts, err := value.Get()
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(
+		time.Unix(int64(ts), 0).UTC().Format(time.RFC3339),
+	)
+}
@@ -0,0 +1,85 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCertificateDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, "/certificates/certificate", r.URL.Path)
+		assert.Equal(t, "cert_test", r.URL.Query().Get("certificate[id]"))
+
+		writeTestJSON(w, http.StatusOK, `{
+			"certificate": [{
+				"id": "cert_test",
+				"name": "example.com",
+				"additional_names": ["www.example.com"],
+				"issuer": "lets_encrypt",
+				"state": "issued",
+				"authorization_method": "http",
+				"issue_error": null,
+				"expires_at": 1767225600,
+				"last_issued_at": null,
+				"certificate": "certificate",
+				"chain": "chain",
+				"private_key": "key"
+			}]
+		}`)
+	})
+	ds := &CertificateDataSource{M: &Meta{Core: client, testMode: true}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), CertificateDataSourceModel{
+		ID:              types.StringValue("cert_test"),
+		AdditionalNames: types.ListNull(types.StringType),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got CertificateDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "example.com", got.Name.ValueString())
+	assert.Equal(t, "lets_encrypt", got.Issuer.ValueString())
+	assert.Equal(t, "issued", got.State.ValueString())
+	assert.Equal(t, "http", got.AuthorizationMethod.ValueString())
+	assert.True(t, got.IssueError.IsNull())
+	assert.Equal(t, "2026-01-01T00:00:00Z", got.ExpiresAt.ValueString())
+	assert.True(t, got.LastIssuedAt.IsNull())
+	assert.Equal(t, "key", got.PrivateKey.ValueString())
+
+	var names []string
+	diags = got.AdditionalNames.ElementsAs(context.Background(), &names, false)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.Equal(t, []string{"www.example.com"}, names)
+
+	assert.True(t, schemaResp.Schema.Attributes["private_key"].IsSensitive())
+}
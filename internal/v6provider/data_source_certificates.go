@@ -0,0 +1,181 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	CertificatesDataSource struct {
+		M *Meta
+	}
+
+	CertificatesDataSourceModel struct {
+		Organization types.String                             `tfsdk:"organization"`
+		Certificates []CertificatesDataSourceCertificateModel `tfsdk:"certificates"`
+		Filter       []DataSourceFilterModel                  `tfsdk:"filter"`
+	}
+
+	CertificatesDataSourceCertificateModel struct {
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		Issuer       types.String `tfsdk:"issuer"`
+		State        types.String `tfsdk:"state"`
+		ExpiresAt    types.String `tfsdk:"expires_at"`
+		LastIssuedAt types.String `tfsdk:"last_issued_at"`
+	}
+)
+
+const certificatesPageSize = 100
+
+func (ds *CertificatesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_certificates"
+}
+
+func (ds *CertificatesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	ds.M = meta
+}
+
+func (ds *CertificatesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch all certificates in the organization.",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"certificates": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: certificateIDDescription,
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: certificateNameDescription,
+						},
+						"issuer": schema.StringAttribute{
+							Computed:            true,
+							Description:         certificateIssuerDescription,
+							MarkdownDescription: certificateIssuerDescription,
+						},
+						"state": schema.StringAttribute{
+							Computed:            true,
+							Description:         certificateStateDescription,
+							MarkdownDescription: certificateStateDescription,
+						},
+						"expires_at": schema.StringAttribute{
+							Computed:    true,
+							Description: certificateExpiresAtDescription,
+						},
+						"last_issued_at": schema.StringAttribute{
+							Computed:    true,
+							Description: certificateLastIssuedAtDescription,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (ds *CertificatesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data CertificatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := ds.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[CertificatesDataSourceCertificateModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Certificates = []CertificatesDataSourceCertificateModel{}
+
+	for page := 1; ; page++ {
+		res, err := ds.M.Core.GetOrganizationCertificatesWithResponse(ctx,
+			&core.GetOrganizationCertificatesParams{
+				OrganizationSubDomain: &org,
+				Page:                  ptr(page),
+				PerPage:               ptr(certificatesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			resp.Diagnostics.AddError("Certificates Error", err.Error())
+			return
+		}
+
+		for _, cert := range res.JSON200.Certificates {
+			model := CertificatesDataSourceCertificateModel{
+				ID:           types.StringPointerValue(cert.Id),
+				Name:         types.StringPointerValue(cert.Name),
+				Issuer:       types.StringNull(),
+				State:        types.StringNull(),
+				ExpiresAt:    unixTimestampValue(cert.ExpiresAt),
+				LastIssuedAt: unixTimestampValue(cert.LastIssuedAt),
+			}
+			if cert.Issuer != nil {
+				model.Issuer = types.StringValue(string(*cert.Issuer))
+			}
+			if cert.State != nil {
+				model.State = types.StringValue(string(*cert.State))
+			}
+
+			data.Certificates = append(data.Certificates, model)
+		}
+
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(res.JSON200.Certificates),
+			certificatesPageSize,
+		) {
+			break
+		}
+	}
+
+	data.Certificates = filterDataSourceModels(filters, data.Certificates)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
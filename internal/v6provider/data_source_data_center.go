@@ -0,0 +1,138 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	DataCenterDataSource struct {
+		M *Meta
+	}
+
+	DataCenterDataSourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		Permalink   types.String `tfsdk:"permalink"`
+		Name        types.String `tfsdk:"name"`
+		CountryID   types.String `tfsdk:"country_id"`
+		CountryName types.String `tfsdk:"country_name"`
+	}
+)
+
+func (d *DataCenterDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_data_center"
+}
+
+func (d *DataCenterDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func (d *DataCenterDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a data center by ID or permalink. " +
+			"When neither is set, the data center configured on the " +
+			"provider is returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The unique identifier of the data " +
+					"center. Takes precedence over `permalink`.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"permalink": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The permalink of the data center, e.g. `uk-lon-01`.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the data center.",
+			},
+			"country_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the country the data center is in.",
+			},
+			"country_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the country the data center is in.",
+			},
+		},
+	}
+}
+
+func (d *DataCenterDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DataCenterDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &core.GetDataCenterParams{}
+	if !data.ID.IsNull() {
+		params.DataCenterId = data.ID.ValueStringPointer()
+	} else {
+		params.DataCenterPermalink = ptr(d.M.dataCenter(data.Permalink))
+	}
+
+	res, err := d.M.Core.GetDataCenterWithResponse(ctx, params)
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Data Center Error", err.Error())
+		return
+	}
+
+	dc := res.JSON200.DataCenter
+	data = DataCenterDataSourceModel{
+		ID:          types.StringPointerValue(dc.Id),
+		Permalink:   types.StringPointerValue(dc.Permalink),
+		Name:        types.StringPointerValue(dc.Name),
+		CountryID:   types.StringNull(),
+		CountryName: types.StringNull(),
+	}
+	if dc.Country != nil {
+		data.CountryID = types.StringPointerValue(dc.Country.Id)
+		data.CountryName = types.StringPointerValue(dc.Country.Name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
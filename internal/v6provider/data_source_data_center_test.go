@@ -0,0 +1,127 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataCenterDataSourceReadDefaultsToProviderDataCenter(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, "/data_centers/data_center", r.URL.Path)
+		assert.Equal(
+			t, "uk-lon-01", r.URL.Query().Get("data_center[permalink]"),
+		)
+
+		writeTestJSON(w, http.StatusOK, `{
+			"data_center": {
+				"id": "loc_test",
+				"name": "London",
+				"permalink": "uk-lon-01",
+				"country": {"id": "ctry_gb", "name": "United Kingdom"}
+			}
+		}`)
+	})
+	ds := &DataCenterDataSource{M: &Meta{
+		Core:           client,
+		confDataCenter: "uk-lon-01",
+		testMode:       true,
+	}}
+
+	got := readDataCenterDataSource(t, ds, DataCenterDataSourceModel{
+		ID:          types.StringNull(),
+		Permalink:   types.StringNull(),
+		Name:        types.StringNull(),
+		CountryID:   types.StringNull(),
+		CountryName: types.StringNull(),
+	})
+
+	assert.Equal(t, DataCenterDataSourceModel{
+		ID:          types.StringValue("loc_test"),
+		Permalink:   types.StringValue("uk-lon-01"),
+		Name:        types.StringValue("London"),
+		CountryID:   types.StringValue("ctry_gb"),
+		CountryName: types.StringValue("United Kingdom"),
+	}, got)
+}
+
+func TestDataCenterDataSourceReadByID(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, "loc_other", r.URL.Query().Get("data_center[id]"))
+		assert.Empty(t, r.URL.Query().Get("data_center[permalink]"))
+
+		writeTestJSON(w, http.StatusOK, `{
+			"data_center": {
+				"id": "loc_other",
+				"name": "Other",
+				"permalink": "other"
+			}
+		}`)
+	})
+	ds := &DataCenterDataSource{M: &Meta{
+		Core:           client,
+		confDataCenter: "uk-lon-01",
+		testMode:       true,
+	}}
+
+	got := readDataCenterDataSource(t, ds, DataCenterDataSourceModel{
+		ID:          types.StringValue("loc_other"),
+		Permalink:   types.StringValue("ignored"),
+		Name:        types.StringNull(),
+		CountryID:   types.StringNull(),
+		CountryName: types.StringNull(),
+	})
+
+	assert.Equal(t, types.StringValue("other"), got.Permalink)
+	assert.True(t, got.CountryID.IsNull())
+	assert.True(t, got.CountryName.IsNull())
+}
+
+func readDataCenterDataSource(
+	t *testing.T,
+	ds *DataCenterDataSource,
+	model DataCenterDataSourceModel,
+) DataCenterDataSourceModel {
+	t.Helper()
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got DataCenterDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return got
+}
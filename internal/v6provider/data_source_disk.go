@@ -160,7 +160,7 @@ func (d *DiskDataSource) Read(
 	})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Disk Error", err.Error())
 		return
@@ -0,0 +1,327 @@
+package v6provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ datasource.DataSourceWithConfigValidators = (*DiskBackupPoliciesDataSource)(nil)
+
+type (
+	DiskBackupPoliciesDataSource struct {
+		M *Meta
+	}
+
+	DiskBackupPoliciesDataSourceModel struct {
+		DiskID           types.String                      `tfsdk:"disk_id"`
+		VirtualMachineID types.String                      `tfsdk:"virtual_machine_id"`
+		Policies         []DiskBackupPolicyDataSourceModel `tfsdk:"policies"`
+		Filter           []DataSourceFilterModel           `tfsdk:"filter"`
+	}
+
+	DiskBackupPolicyDataSourceModel struct {
+		ID               types.String  `tfsdk:"id"`
+		DiskID           types.String  `tfsdk:"disk_id"`
+		VirtualMachineID types.String  `tfsdk:"virtual_machine_id"`
+		Retention        types.Int64   `tfsdk:"retention"`
+		Interval         types.String  `tfsdk:"interval"`
+		NextInvocationAt types.String  `tfsdk:"next_invocation_at"`
+		TotalSize        types.Float64 `tfsdk:"total_size"`
+	}
+
+	// diskBackupPolicySummary holds the fields shared by the disk and
+	// virtual machine listing responses.
+	diskBackupPolicySummary struct {
+		id               *string
+		retention        *int
+		interval         *core.ScheduleIntervalTypeEnum
+		nextInvocationAt *int
+		target           *core.DiskBackupPolicyTarget
+		totalSize        *float32
+	}
+)
+
+const diskBackupPoliciesPageSize = 100
+
+func (ds *DiskBackupPoliciesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_disk_backup_policies"
+}
+
+func (ds *DiskBackupPoliciesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	ds.M = meta
+}
+
+func (ds *DiskBackupPoliciesDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("disk_id"),
+			path.MatchRoot("virtual_machine_id"),
+		),
+	}
+}
+
+func (ds *DiskBackupPoliciesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch the backup policies for a disk or virtual machine.",
+		Attributes: map[string]schema.Attribute{
+			"disk_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the disk to list backup policies for.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"virtual_machine_id": schema.StringAttribute{
+				Optional: true,
+				Description: "The ID of the virtual machine to list backup " +
+					"policies for. Policies belonging to disks attached to " +
+					"the virtual machine are included.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: diskBackupPolicyIDDescription,
+						},
+						"disk_id": schema.StringAttribute{
+							Computed: true,
+							Description: "The ID of the disk the policy " +
+								"applies to, if it targets a single disk.",
+						},
+						"virtual_machine_id": schema.StringAttribute{
+							Computed: true,
+							Description: "The ID of the virtual machine the " +
+								"policy applies to, if it targets a virtual " +
+								"machine.",
+						},
+						"retention": schema.Int64Attribute{
+							Computed:    true,
+							Description: diskBackupPolicyRetentionDescription,
+						},
+						"interval": schema.StringAttribute{
+							Computed:            true,
+							Description:         diskBackupPolicyIntervalDescription,
+							MarkdownDescription: diskBackupPolicyIntervalDescription,
+						},
+						"next_invocation_at": schema.StringAttribute{
+							Computed: true,
+							Description: "The time the next backup will be " +
+								"taken, in RFC 3339 format.",
+						},
+						"total_size": schema.Float64Attribute{
+							Computed: true,
+							Description: "The total size in GB of the " +
+								"backups held by the policy.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (ds *DiskBackupPoliciesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DiskBackupPoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[DiskBackupPolicyDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var (
+		policies []diskBackupPolicySummary
+		err      error
+	)
+	if !data.DiskID.IsNull() {
+		policies, err = ds.diskPolicies(ctx, data.DiskID.ValueString())
+	} else {
+		policies, err = ds.virtualMachinePolicies(
+			ctx, data.VirtualMachineID.ValueString(),
+		)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Disk Backup Policies Error", err.Error())
+		return
+	}
+
+	data.Policies = make([]DiskBackupPolicyDataSourceModel, 0, len(policies))
+	for _, p := range policies {
+		diskID, vmID, err := diskBackupPolicyTargetIDs(p.target)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Disk Backup Policies Error", err.Error(),
+			)
+			return
+		}
+
+		model := DiskBackupPolicyDataSourceModel{
+			ID:               types.StringPointerValue(p.id),
+			DiskID:           diskID,
+			VirtualMachineID: vmID,
+			Retention:        intPointerValue(p.retention),
+			Interval:         stringerPointerValue(p.interval),
+			NextInvocationAt: types.StringNull(),
+			TotalSize:        types.Float64Null(),
+		}
+		if p.nextInvocationAt != nil {
+			model.NextInvocationAt = types.StringValue(
+				time.Unix(int64(*p.nextInvocationAt), 0).UTC().
+					Format(time.RFC3339),
+			)
+		}
+		if p.totalSize != nil {
+			model.TotalSize = types.Float64Value(float64(*p.totalSize))
+		}
+
+		data.Policies = append(data.Policies, model)
+	}
+
+	data.Policies = filterDataSourceModels(filters, data.Policies)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (ds *DiskBackupPoliciesDataSource) diskPolicies(
+	ctx context.Context,
+	diskID string,
+) ([]diskBackupPolicySummary, error) {
+	policies := []diskBackupPolicySummary{}
+
+	for page := 1; ; page++ {
+		res, err := ds.M.Core.GetDiskDiskBackupPoliciesWithResponse(ctx,
+			&core.GetDiskDiskBackupPoliciesParams{
+				DiskId:  &diskID,
+				Page:    ptr(page),
+				PerPage: ptr(diskBackupPoliciesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return nil, err
+		}
+
+		for _, p := range res.JSON200.DiskBackupPolicies {
+			summary := diskBackupPolicySummary{
+				id:        p.Id,
+				retention: p.Retention,
+				target:    p.Target,
+				totalSize: p.TotalSize,
+			}
+			if p.Schedule != nil {
+				summary.interval = p.Schedule.Interval
+				summary.nextInvocationAt = p.Schedule.NextInvocationAt
+			}
+
+			policies = append(policies, summary)
+		}
+
+		if !paginationHasNext(
+			res.JSON200.Pagination, page,
+			len(res.JSON200.DiskBackupPolicies), diskBackupPoliciesPageSize,
+		) {
+			return policies, nil
+		}
+	}
+}
+
+func (ds *DiskBackupPoliciesDataSource) virtualMachinePolicies(
+	ctx context.Context,
+	vmID string,
+) ([]diskBackupPolicySummary, error) {
+	policies := []diskBackupPolicySummary{}
+
+	for page := 1; ; page++ {
+		res, err := ds.M.Core.GetVirtualMachineDiskBackupPoliciesWithResponse(
+			ctx,
+			&core.GetVirtualMachineDiskBackupPoliciesParams{
+				VirtualMachineId: &vmID,
+				IncludeDisks:     ptr(true),
+				Page:             ptr(page),
+				PerPage:          ptr(diskBackupPoliciesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return nil, err
+		}
+
+		for _, p := range res.JSON200.DiskBackupPolicies {
+			summary := diskBackupPolicySummary{
+				id:        p.Id,
+				retention: p.Retention,
+				target:    p.Target,
+				totalSize: p.TotalSize,
+			}
+			if p.Schedule != nil {
+				summary.interval = p.Schedule.Interval
+				summary.nextInvocationAt = p.Schedule.NextInvocationAt
+			}
+
+			policies = append(policies, summary)
+		}
+
+		if !paginationHasNext(
+			res.JSON200.Pagination, page,
+			len(res.JSON200.DiskBackupPolicies), diskBackupPoliciesPageSize,
+		) {
+			return policies, nil
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskBackupPoliciesDataSourceReadVirtualMachine(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(
+			t, "/virtual_machines/virtual_machine/disk_backup_policies",
+			r.URL.Path,
+		)
+		assert.Equal(t, "vm_test", r.URL.Query().Get("virtual_machine[id]"))
+		assert.Equal(t, "true", r.URL.Query().Get("include_disks"))
+
+		writeTestJSON(w, http.StatusOK, `{
+			"disk_backup_policies": [
+				{
+					"id": "dbp_vm",
+					"retention": 7,
+					"schedule": {
+						"interval": "daily",
+						"next_invocation_at": 1767225600
+					},
+					"target": {
+						"target": {"id": "vm_test", "fqdn": "web.example.com"}
+					},
+					"total_size": 12.5
+				},
+				{
+					"id": "dbp_disk",
+					"retention": 4,
+					"schedule": {"interval": "weekly"},
+					"target": {"target": {"id": "disk_test", "name": "data"}}
+				}
+			],
+			"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+		}`)
+	})
+	ds := &DiskBackupPoliciesDataSource{M: &Meta{Core: client, testMode: true}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), DiskBackupPoliciesDataSourceModel{
+		DiskID:           types.StringNull(),
+		VirtualMachineID: types.StringValue("vm_test"),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got DiskBackupPoliciesDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, []DiskBackupPolicyDataSourceModel{
+		{
+			ID:               types.StringValue("dbp_vm"),
+			DiskID:           types.StringNull(),
+			VirtualMachineID: types.StringValue("vm_test"),
+			Retention:        types.Int64Value(7),
+			Interval:         types.StringValue("daily"),
+			NextInvocationAt: types.StringValue("2026-01-01T00:00:00Z"),
+			TotalSize:        types.Float64Value(12.5),
+		},
+		{
+			ID:               types.StringValue("dbp_disk"),
+			DiskID:           types.StringValue("disk_test"),
+			VirtualMachineID: types.StringNull(),
+			Retention:        types.Int64Value(4),
+			Interval:         types.StringValue("weekly"),
+			NextInvocationAt: types.StringNull(),
+			TotalSize:        types.Float64Null(),
+		},
+	}, got.Policies)
+}
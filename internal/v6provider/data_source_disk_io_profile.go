@@ -28,6 +28,14 @@ type (
 		SpeedInMB types.Int64  `tfsdk:"speed_in_mb"`
 		IOPS      types.Int64  `tfsdk:"iops"`
 	}
+
+	// diskIOProfileDataSourceLookupModel adds the arguments only used to
+	// look up a single profile to DiskIOProfileDataSourceModel, which
+	// katapult_disk_io_profiles shares.
+	diskIOProfileDataSourceLookupModel struct {
+		DiskIOProfileDataSourceModel
+		Organization types.String `tfsdk:"organization"`
+	}
 )
 
 func (d *DiskIOProfileDataSource) Metadata(
@@ -110,9 +118,12 @@ func (d *DiskIOProfileDataSource) Schema(
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
+	attrs := diskIOProfileSchemaAttributes(true)
+	attrs[organizationAttributeName] = organizationDataSourceAttribute()
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Retrieves a disk I/O profile by ID or permalink.",
-		Attributes:          diskIOProfileSchemaAttributes(true),
+		Attributes:          attrs,
 	}
 }
 
@@ -121,13 +132,15 @@ func (d *DiskIOProfileDataSource) Read(
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var data DiskIOProfileDataSourceModel
+	var data diskIOProfileDataSourceLookupModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	profiles, err := fetchAllOrganizationDiskIOProfiles(ctx, d.M)
+	org := d.M.organization(data.Organization)
+
+	profiles, err := fetchAllOrganizationDiskIOProfiles(ctx, d.M, org)
 	if err != nil {
 		resp.Diagnostics.AddError("Disk I/O Profile Error", err.Error())
 		return
@@ -137,7 +150,7 @@ func (d *DiskIOProfileDataSource) Read(
 		profiles, data.ID, data.Permalink,
 	)
 	if profile != nil {
-		data = diskIOProfileDataSourceModel(profile)
+		data.DiskIOProfileDataSourceModel = diskIOProfileDataSourceModel(profile)
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
@@ -145,7 +158,7 @@ func (d *DiskIOProfileDataSource) Read(
 	resp.Diagnostics.AddError(
 		"Disk I/O Profile Not Found",
 		fmt.Sprintf("No disk I/O profile with %s %q exists in organization %q.",
-			selectorName, selectorValue, d.M.confOrganization),
+			selectorName, selectorValue, org),
 	)
 }
 
@@ -177,18 +190,19 @@ func findDiskIOProfile(
 func fetchAllOrganizationDiskIOProfiles(
 	ctx context.Context,
 	m *Meta,
+	org string,
 ) ([]core.DiskIOProfile, error) {
 	profiles := []core.DiskIOProfile{}
 	for page := 1; ; page++ {
 		res, err := m.Core.GetOrganizationDiskIoProfilesWithResponse(ctx,
 			&core.GetOrganizationDiskIoProfilesParams{
-				OrganizationSubDomain: &m.confOrganization,
+				OrganizationSubDomain: &org,
 				Page:                  &page,
 				PerPage:               ptr(diskDataSourcePageSize),
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 			return nil, err
 		}
@@ -107,8 +107,8 @@ func TestFetchAllOrganizationDiskIOProfilesPaginationSortingEmptyAndErrors(t *te
 		})
 
 		profiles, err := fetchAllOrganizationDiskIOProfiles(context.Background(), &Meta{
-			Core: client, confOrganization: "test-org",
-		})
+			Core: client,
+		}, "test-org")
 		require.NoError(t, err)
 		require.Len(t, profiles, 2)
 		assert.Equal(t, "iop_a", *profiles[0].Id)
@@ -125,7 +125,7 @@ func TestFetchAllOrganizationDiskIOProfilesPaginationSortingEmptyAndErrors(t *te
 			}`)
 		})
 		profiles, err := fetchAllOrganizationDiskIOProfiles(
-			context.Background(), &Meta{Core: client},
+			context.Background(), &Meta{Core: client}, "test-org",
 		)
 		require.NoError(t, err)
 		require.Len(t, profiles, 2)
@@ -139,7 +139,7 @@ func TestFetchAllOrganizationDiskIOProfilesPaginationSortingEmptyAndErrors(t *te
 			writeTestJSON(w, http.StatusOK, `{"disk_io_profiles":[],"pagination":{"total_pages":1}}`)
 		})
 		profiles, err := fetchAllOrganizationDiskIOProfiles(
-			context.Background(), &Meta{Core: client},
+			context.Background(), &Meta{Core: client}, "test-org",
 		)
 		require.NoError(t, err)
 		assert.NotNil(t, profiles)
@@ -172,7 +172,7 @@ func TestFetchAllOrganizationDiskIOProfilesPaginationSortingEmptyAndErrors(t *te
 				_, _ = w.Write([]byte(test.body))
 			})
 			_, err := fetchAllOrganizationDiskIOProfiles(
-				context.Background(), &Meta{Core: client},
+				context.Background(), &Meta{Core: client}, "test-org",
 			)
 			require.ErrorContains(t, err, test.want)
 		})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type (
@@ -13,7 +14,9 @@ type (
 	}
 
 	DiskIOProfilesDataSourceModel struct {
-		Profiles []DiskIOProfileDataSourceModel `tfsdk:"profiles"`
+		Organization types.String                   `tfsdk:"organization"`
+		Profiles     []DiskIOProfileDataSourceModel `tfsdk:"profiles"`
+		Filter       []DataSourceFilterModel        `tfsdk:"filter"`
 	}
 )
 
@@ -51,6 +54,7 @@ func (d *DiskIOProfilesDataSource) Schema(
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Lists disk I/O profiles available to the provider organization.",
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"profiles": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "Disk I/O profiles ordered lexically by ID.",
@@ -59,6 +63,9 @@ func (d *DiskIOProfilesDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -73,7 +80,17 @@ func (d *DiskIOProfilesDataSource) Read(
 		return
 	}
 
-	profiles, err := fetchAllOrganizationDiskIOProfiles(ctx, d.M)
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[DiskIOProfileDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := fetchAllOrganizationDiskIOProfiles(ctx, d.M, org)
 	if err != nil {
 		resp.Diagnostics.AddError("Disk I/O Profiles Error", err.Error())
 		return
@@ -83,5 +100,7 @@ func (d *DiskIOProfilesDataSource) Read(
 	for i := range profiles {
 		data.Profiles[i] = diskIOProfileDataSourceModel(&profiles[i])
 	}
+
+	data.Profiles = filterDataSourceModels(filters, data.Profiles)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
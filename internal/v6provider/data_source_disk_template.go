@@ -0,0 +1,222 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+var _ datasource.DataSourceWithConfigValidators = (*DiskTemplateDataSource)(nil)
+
+type (
+	DiskTemplateDataSource struct {
+		M *Meta
+	}
+
+	DiskTemplateDataSourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		Permalink         types.String `tfsdk:"permalink"`
+		Name              types.String `tfsdk:"name"`
+		Description       types.String `tfsdk:"description"`
+		Universal         types.Bool   `tfsdk:"universal"`
+		TemplateVersion   types.Int64  `tfsdk:"template_version"`
+		SizeInGB          types.Int64  `tfsdk:"size_in_gb"`
+		OSFamily          types.String `tfsdk:"os_family"`
+		OperatingSystemID types.String `tfsdk:"operating_system_id"`
+	}
+)
+
+func (d *DiskTemplateDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_disk_template"
+}
+
+func (d *DiskTemplateDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func diskTemplateSchemaAttributes(selector bool) map[string]schema.Attribute {
+	id := schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The unique identifier of the disk template.",
+	}
+	permalink := schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The permalink of the disk template.",
+	}
+	if selector {
+		id.Optional = true
+		id.MarkdownDescription += " Takes precedence over `permalink`."
+		id.Validators = []validator.String{
+			stringValidatorNotEmpty(),
+		}
+		permalink.Optional = true
+		permalink.Validators = []validator.String{
+			stringValidatorNotEmpty(),
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"id":        id,
+		"permalink": permalink,
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the disk template.",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the disk template.",
+		},
+		"universal": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the disk template is available to all organizations.",
+		},
+		"template_version": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of the latest version of the disk template.",
+		},
+		"size_in_gb": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The size of disk the template requires, in GB.",
+		},
+		"os_family": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the operating system family installed by the template.",
+		},
+		"operating_system_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The ID of the operating system installed by the template.",
+		},
+	}
+}
+
+func (d *DiskTemplateDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("permalink"),
+		),
+	}
+}
+
+func (d *DiskTemplateDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a disk template by ID or permalink.",
+		Attributes:          diskTemplateSchemaAttributes(true),
+	}
+}
+
+func (d *DiskTemplateDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DiskTemplateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &core.GetDiskTemplateParams{}
+	if !data.ID.IsNull() {
+		params.DiskTemplateId = data.ID.ValueStringPointer()
+	} else {
+		params.DiskTemplatePermalink = data.Permalink.ValueStringPointer()
+	}
+
+	res, err := d.M.Core.GetDiskTemplateWithResponse(ctx, params)
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Disk Template Error", err.Error())
+		return
+	}
+
+	tpl := res.JSON200.DiskTemplate
+	data = DiskTemplateDataSourceModel{
+		ID:                types.StringPointerValue(tpl.Id),
+		Permalink:         types.StringPointerValue(tpl.Permalink),
+		Name:              types.StringPointerValue(tpl.Name),
+		Description:       nullableStringValue(tpl.Description),
+		Universal:         types.BoolPointerValue(tpl.Universal),
+		TemplateVersion:   types.Int64Null(),
+		SizeInGB:          nullableIntValue(tpl.SizeInGb),
+		OSFamily:          types.StringNull(),
+		OperatingSystemID: types.StringNull(),
+	}
+	if opSys, err := tpl.OperatingSystem.Get(); err == nil {
+		data.OSFamily = types.StringPointerValue(opSys.Name)
+		data.OperatingSystemID = types.StringPointerValue(opSys.Id)
+	}
+
+	// The template lookup only references its latest version by ID, so the
+	// version number needs a second request.
+	if version, err := tpl.LatestVersion.Get(); err == nil && version.Id != nil {
+		number, err := diskTemplateVersionNumber(ctx, d.M, *version.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Disk Template Error", err.Error())
+			return
+		}
+
+		data.TemplateVersion = number
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func diskTemplateVersionNumber(
+	ctx context.Context,
+	m *Meta,
+	id string,
+) (types.Int64, error) {
+	res, err := m.Core.GetDiskTemplateVersionWithResponse(ctx,
+		&core.GetDiskTemplateVersionParams{
+			DiskTemplateVersionId: &id,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return types.Int64Null(), err
+	}
+	if res.JSON200 == nil {
+		return types.Int64Null(), fmt.Errorf(
+			"unexpected empty response fetching disk template version %s", id,
+		)
+	}
+
+	return intPointerValue(res.JSON200.DiskTemplateVersion.Number), nil
+}
@@ -0,0 +1,185 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskTemplateDataSourceReadFetchesVersionNumber(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		switch r.URL.Path {
+		case "/disk_templates/disk_template":
+			assert.Equal(
+				t, "templates/ubuntu-24-04",
+				r.URL.Query().Get("disk_template[permalink]"),
+			)
+
+			writeTestJSON(w, http.StatusOK, `{
+				"disk_template": {
+					"id": "dtpl_test",
+					"name": "Ubuntu 24.04",
+					"permalink": "templates/ubuntu-24-04",
+					"description": null,
+					"universal": true,
+					"size_in_gb": 10,
+					"latest_version": {"id": "dtplv_test"},
+					"operating_system": {"id": "os_ubuntu", "name": "Ubuntu"}
+				}
+			}`)
+		case "/disk_template_versions/disk_template_version":
+			assert.Equal(
+				t, "dtplv_test",
+				r.URL.Query().Get("disk_template_version[id]"),
+			)
+
+			writeTestJSON(w, http.StatusOK, `{
+				"disk_template_version": {"id": "dtplv_test", "number": 7}
+			}`)
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			writeTestJSON(w, http.StatusNotFound, `{}`)
+		}
+	})
+	ds := &DiskTemplateDataSource{M: &Meta{Core: client, testMode: true}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), DiskTemplateDataSourceModel{
+		ID:                types.StringNull(),
+		Permalink:         types.StringValue("templates/ubuntu-24-04"),
+		Name:              types.StringNull(),
+		Description:       types.StringNull(),
+		Universal:         types.BoolNull(),
+		TemplateVersion:   types.Int64Null(),
+		SizeInGB:          types.Int64Null(),
+		OSFamily:          types.StringNull(),
+		OperatingSystemID: types.StringNull(),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got DiskTemplateDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, DiskTemplateDataSourceModel{
+		ID:                types.StringValue("dtpl_test"),
+		Permalink:         types.StringValue("templates/ubuntu-24-04"),
+		Name:              types.StringValue("Ubuntu 24.04"),
+		Description:       types.StringNull(),
+		Universal:         types.BoolValue(true),
+		TemplateVersion:   types.Int64Value(7),
+		SizeInGB:          types.Int64Value(10),
+		OSFamily:          types.StringValue("Ubuntu"),
+		OperatingSystemID: types.StringValue("os_ubuntu"),
+	}, got)
+}
+
+func TestDiskTemplatesDataSourceReadFilters(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, "/organizations/organization/disk_templates", r.URL.Path)
+		assert.Equal(
+			t, "test-org", r.URL.Query().Get("organization[sub_domain]"),
+		)
+		assert.Equal(t, "false", r.URL.Query().Get("include_universal"))
+
+		writeTestJSON(w, http.StatusOK, `{
+			"disk_templates": [
+				{
+					"id": "dtpl_debian",
+					"name": "Debian 12",
+					"permalink": "templates/debian-12",
+					"universal": false,
+					"latest_version": {"id": "dtplv_debian", "number": 3},
+					"operating_system": {"id": "os_debian", "name": "Debian"}
+				},
+				{
+					"id": "dtpl_ubuntu",
+					"name": "Ubuntu 24.04",
+					"permalink": "templates/ubuntu-24-04",
+					"universal": false,
+					"operating_system": {"id": "os_ubuntu", "name": "Ubuntu"}
+				}
+			],
+			"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+		}`)
+	})
+	ds := &DiskTemplatesDataSource{M: &Meta{
+		Core:             client,
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), DiskTemplatesDataSourceModel{
+		ID:                types.StringNull(),
+		IncludeUniversal:  types.BoolValue(false),
+		OperatingSystemID: types.StringNull(),
+		OSFamily:          types.StringValue("debian"),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got DiskTemplatesDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, types.StringValue("test-org"), got.ID)
+	assert.Equal(t, []DiskTemplateDataSourceModel{
+		{
+			ID:                types.StringValue("dtpl_debian"),
+			Permalink:         types.StringValue("templates/debian-12"),
+			Name:              types.StringValue("Debian 12"),
+			Description:       types.StringNull(),
+			Universal:         types.BoolValue(false),
+			TemplateVersion:   types.Int64Value(3),
+			SizeInGB:          types.Int64Null(),
+			OSFamily:          types.StringValue("Debian"),
+			OperatingSystemID: types.StringValue("os_debian"),
+		},
+	}, got.Templates)
+}
@@ -0,0 +1,219 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	DiskTemplatesDataSource struct {
+		M *Meta
+	}
+
+	DiskTemplatesDataSourceModel struct {
+		Organization      types.String                  `tfsdk:"organization"`
+		ID                types.String                  `tfsdk:"id"`
+		IncludeUniversal  types.Bool                    `tfsdk:"include_universal"`
+		OperatingSystemID types.String                  `tfsdk:"operating_system_id"`
+		OSFamily          types.String                  `tfsdk:"os_family"`
+		Templates         []DiskTemplateDataSourceModel `tfsdk:"templates"`
+		Filter            []DataSourceFilterModel       `tfsdk:"filter"`
+	}
+)
+
+const diskTemplatesPageSize = 100
+
+func (d *DiskTemplatesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_disk_templates"
+}
+
+func (d *DiskTemplatesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func (d *DiskTemplatesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists disk templates available to the provider organization.",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always set to the organization sub-domain.",
+			},
+			"include_universal": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Include universal disk templates. " +
+					"Defaults to `true`.",
+			},
+			"operating_system_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only return templates for the operating system with this ID.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"os_family": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only return templates whose operating " +
+					"system family matches this name, ignoring case.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"templates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Disk templates matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: diskTemplateSchemaAttributes(false),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (d *DiskTemplatesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DiskTemplatesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[DiskTemplateDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	includeUniversal := true
+	if !data.IncludeUniversal.IsNull() {
+		includeUniversal = data.IncludeUniversal.ValueBool()
+	}
+
+	templates, err := fetchAllOrganizationDiskTemplates(
+		ctx, d.M, org, includeUniversal, data.OperatingSystemID.ValueStringPointer(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Disk Templates Error", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(org)
+	data.Templates = []DiskTemplateDataSourceModel{}
+	for i := range templates {
+		model := diskTemplateDataSourceModel(&templates[i])
+		if !data.OSFamily.IsNull() && !strings.EqualFold(
+			model.OSFamily.ValueString(), data.OSFamily.ValueString(),
+		) {
+			continue
+		}
+
+		data.Templates = append(data.Templates, model)
+	}
+
+	data.Templates = filterDataSourceModels(filters, data.Templates)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func fetchAllOrganizationDiskTemplates(
+	ctx context.Context,
+	m *Meta,
+	org string,
+	includeUniversal bool,
+	operatingSystemID *string,
+) ([]core.GetOrganizationDiskTemplates200ResponseDiskTemplates, error) {
+	templates := []core.GetOrganizationDiskTemplates200ResponseDiskTemplates{}
+	for page := 1; ; page++ {
+		res, err := m.Core.GetOrganizationDiskTemplatesWithResponse(ctx,
+			&core.GetOrganizationDiskTemplatesParams{
+				OrganizationSubDomain: &org,
+				IncludeUniversal:      &includeUniversal,
+				OperatingSystemId:     operatingSystemID,
+				Page:                  &page,
+				PerPage:               ptr(diskTemplatesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response listing disk templates on page %d", page)
+		}
+
+		pageTemplates := res.JSON200.DiskTemplates
+		templates = append(templates, pageTemplates...)
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(pageTemplates), diskTemplatesPageSize,
+		) {
+			break
+		}
+	}
+
+	return templates, nil
+}
+
+func diskTemplateDataSourceModel(
+	tpl *core.GetOrganizationDiskTemplates200ResponseDiskTemplates,
+) DiskTemplateDataSourceModel {
+	model := DiskTemplateDataSourceModel{
+		ID:                types.StringPointerValue(tpl.Id),
+		Permalink:         types.StringPointerValue(tpl.Permalink),
+		Name:              types.StringPointerValue(tpl.Name),
+		Description:       nullableStringValue(tpl.Description),
+		Universal:         types.BoolPointerValue(tpl.Universal),
+		TemplateVersion:   types.Int64Null(),
+		SizeInGB:          nullableIntValue(tpl.SizeInGb),
+		OSFamily:          types.StringNull(),
+		OperatingSystemID: types.StringNull(),
+	}
+	if version, err := tpl.LatestVersion.Get(); err == nil {
+		model.TemplateVersion = intPointerValue(version.Number)
+	}
+	if opSys, err := tpl.OperatingSystem.Get(); err == nil {
+		model.OSFamily = types.StringPointerValue(opSys.Name)
+		model.OperatingSystemID = types.StringPointerValue(opSys.Id)
+	}
+
+	return model
+}
@@ -130,8 +130,8 @@ func TestFetchAllOrganizationDisksPaginationEmptyErrorAndNoFanOut(t *testing.T)
 		})
 
 		disks, err := fetchAllOrganizationDisks(context.Background(), &Meta{
-			Core: client, confOrganization: "test-org",
-		})
+			Core: client,
+		}, "test-org")
 		require.NoError(t, err)
 		require.Len(t, disks, 2)
 		assert.Equal(t, int32(2), requests.Load())
@@ -145,7 +145,7 @@ func TestFetchAllOrganizationDisksPaginationEmptyErrorAndNoFanOut(t *testing.T)
 		client := newVirtualMachineTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
 			writeTestJSON(w, http.StatusOK, `{"disk":[],"pagination":{"total_pages":1}}`)
 		})
-		disks, err := fetchAllOrganizationDisks(context.Background(), &Meta{Core: client})
+		disks, err := fetchAllOrganizationDisks(context.Background(), &Meta{Core: client}, "test-org")
 		require.NoError(t, err)
 		assert.NotNil(t, disks)
 		assert.Empty(t, disks)
@@ -176,7 +176,7 @@ func TestFetchAllOrganizationDisksPaginationEmptyErrorAndNoFanOut(t *testing.T)
 				w.WriteHeader(test.status)
 				_, _ = w.Write([]byte(test.body))
 			})
-			_, err := fetchAllOrganizationDisks(context.Background(), &Meta{Core: client})
+			_, err := fetchAllOrganizationDisks(context.Background(), &Meta{Core: client}, "test-org")
 			require.ErrorContains(t, err, test.want)
 		})
 	}
@@ -19,7 +19,11 @@ type (
 	}
 
 	DisksDataSourceModel struct {
-		Disks []DiskSummaryDataSourceModel `tfsdk:"disks"`
+		Organization types.String                 `tfsdk:"organization"`
+		Tags         types.Set                    `tfsdk:"tags"`
+		TagIDs       types.Set                    `tfsdk:"tag_ids"`
+		Disks        []DiskSummaryDataSourceModel `tfsdk:"disks"`
+		Filter       []DataSourceFilterModel      `tfsdk:"filter"`
 	}
 
 	DiskSummaryDataSourceModel struct {
@@ -68,6 +72,15 @@ func (d *DisksDataSource) Schema(
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Lists disks belonging to the provider organization.",
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"tags": tagSelectionNamesAttribute(
+				"Disks cannot be tagged, so only disks attached to a " +
+					"Virtual Machine carrying the tags are returned.",
+			),
+			"tag_ids": tagSelectionIDsAttribute(
+				"Disks cannot be tagged, so only disks attached to a " +
+					"Virtual Machine carrying the tags are returned.",
+			),
 			"disks": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "Disks ordered lexically by ID.",
@@ -105,6 +118,9 @@ func (d *DisksDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -119,13 +135,38 @@ func (d *DisksDataSource) Read(
 		return
 	}
 
-	disks, err := fetchAllOrganizationDisks(ctx, d.M)
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[DiskSummaryDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selection, diags := expandTagSelection(ctx, d.M, org, data.Tags, data.TagIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	disks, err := fetchAllOrganizationDisks(ctx, d.M, org)
 	if err != nil {
 		resp.Diagnostics.AddError("Disks Error", err.Error())
 		return
 	}
 
-	data.Disks = diskSummaryDataSourceModels(disks)
+	models := diskSummaryDataSourceModels(disks)
+	if selection != nil {
+		models, err = selectTaggedDisks(ctx, d.M, org, selection, models)
+		if err != nil {
+			resp.Diagnostics.AddError("Disks Error", err.Error())
+			return
+		}
+	}
+
+	data.Disks = filterDataSourceModels(filters, models)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -133,18 +174,19 @@ func (d *DisksDataSource) Read(
 func fetchAllOrganizationDisks(
 	ctx context.Context,
 	m *Meta,
+	org string,
 ) ([]core.GetOrganizationDisks200ResponseDisk, error) {
 	disks := []core.GetOrganizationDisks200ResponseDisk{}
 	for page := 1; ; page++ {
 		res, err := m.Core.GetOrganizationDisksWithResponse(ctx,
 			&core.GetOrganizationDisksParams{
-				OrganizationSubDomain: &m.confOrganization,
+				OrganizationSubDomain: &org,
 				Page:                  &page,
 				PerPage:               ptr(diskDataSourcePageSize),
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 			return nil, err
 		}
@@ -164,6 +206,40 @@ func fetchAllOrganizationDisks(
 	return disks, nil
 }
 
+// selectTaggedDisks returns the disks attached to a virtual machine carrying
+// every selected tag.
+func selectTaggedDisks(
+	ctx context.Context,
+	m *Meta,
+	org string,
+	selection tagSelection,
+	disks []DiskSummaryDataSourceModel,
+) ([]DiskSummaryDataSourceModel, error) {
+	vms, err := fetchAllOrganizationVirtualMachines(ctx, m, org)
+	if err != nil {
+		return nil, err
+	}
+
+	vms, err = selectTaggedVirtualMachines(ctx, m, selection, vms)
+	if err != nil {
+		return nil, err
+	}
+
+	vmIDs := make(map[string]bool, len(vms))
+	for _, vm := range vms {
+		vmIDs[*vm.Id] = true
+	}
+
+	selected := []DiskSummaryDataSourceModel{}
+	for _, disk := range disks {
+		if vmIDs[disk.VirtualMachineID.ValueString()] {
+			selected = append(selected, disk)
+		}
+	}
+
+	return selected, nil
+}
+
 func diskSummaryDataSourceModel(
 	disk *core.GetOrganizationDisks200ResponseDisk,
 ) DiskSummaryDataSourceModel {
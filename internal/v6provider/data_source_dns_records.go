@@ -0,0 +1,191 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	DNSRecordsDataSource struct {
+		M *Meta
+	}
+
+	DNSRecordsDataSourceModel struct {
+		ZoneID  types.String               `tfsdk:"zone_id"`
+		Records []DNSRecordDataSourceModel `tfsdk:"records"`
+		Filter  []DataSourceFilterModel    `tfsdk:"filter"`
+	}
+
+	DNSRecordDataSourceModel struct {
+		ID       types.String `tfsdk:"id"`
+		Name     types.String `tfsdk:"name"`
+		FullName types.String `tfsdk:"full_name"`
+		Type     types.String `tfsdk:"type"`
+		TTL      types.Int64  `tfsdk:"ttl"`
+		Priority types.Int64  `tfsdk:"priority"`
+		Content  types.String `tfsdk:"content"`
+	}
+)
+
+func (ds *DNSRecordsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+func (ds *DNSRecordsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	ds.M = meta
+}
+
+func (ds *DNSRecordsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch all records in a DNS zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Required:            true,
+				Description:         dnsRecordZoneIDDescription,
+				MarkdownDescription: dnsRecordZoneIDDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         dnsRecordIDDescription,
+							MarkdownDescription: dnsRecordIDDescription,
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         dnsRecordNameDescription,
+							MarkdownDescription: dnsRecordNameDescription,
+						},
+						"full_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         dnsRecordFullNameDescription,
+							MarkdownDescription: dnsRecordFullNameDescription,
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the record.",
+						},
+						"ttl": schema.Int64Attribute{
+							Computed:            true,
+							Description:         dnsRecordTTLDescription,
+							MarkdownDescription: dnsRecordTTLDescription,
+						},
+						"priority": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The priority of the record.",
+						},
+						"content": schema.StringAttribute{
+							Computed: true,
+							Description: "The content of the record, as " +
+								"it would appear in a zone file.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (ds *DNSRecordsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DNSRecordsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[DNSRecordDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := ds.M.Core.GetDnsZoneRecordsWithResponse(ctx,
+		&core.GetDnsZoneRecordsParams{
+			DnsZoneId: data.ZoneID.ValueStringPointer(),
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("DNS Records Error", err.Error())
+		return
+	}
+
+	data.Records = make(
+		[]DNSRecordDataSourceModel, 0, len(res.JSON200.DnsRecords),
+	)
+
+	for _, record := range res.JSON200.DnsRecords {
+		model := DNSRecordDataSourceModel{
+			ID:       types.StringPointerValue(record.Id),
+			FullName: types.StringPointerValue(record.FullName),
+			Content:  types.StringPointerValue(record.Content),
+			TTL:      types.Int64Null(),
+			Priority: types.Int64Null(),
+		}
+
+		name, _ := record.Name.Get()
+		model.Name = types.StringValue(name)
+
+		if record.Type != nil {
+			model.Type = types.StringValue(string(*record.Type))
+		}
+		if ttl, err := record.Ttl.Get(); err == nil {
+			model.TTL = types.Int64Value(int64(ttl))
+		}
+		if priority, err := record.Priority.Get(); err == nil {
+			model.Priority = types.Int64Value(int64(priority))
+		}
+
+		data.Records = append(data.Records, model)
+	}
+
+	data.Records = filterDataSourceModels(filters, data.Records)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
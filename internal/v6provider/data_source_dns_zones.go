@@ -0,0 +1,170 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	DNSZonesDataSource struct {
+		M *Meta
+	}
+
+	DNSZonesDataSourceModel struct {
+		Organization types.String             `tfsdk:"organization"`
+		Zones        []DNSZoneDataSourceModel `tfsdk:"zones"`
+		Filter       []DataSourceFilterModel  `tfsdk:"filter"`
+	}
+
+	DNSZoneDataSourceModel struct {
+		ID         types.String `tfsdk:"id"`
+		Name       types.String `tfsdk:"name"`
+		DefaultTTL types.Int64  `tfsdk:"default_ttl"`
+		Verified   types.Bool   `tfsdk:"verified"`
+	}
+)
+
+const dnsZonesPageSize = 100
+
+func (ds *DNSZonesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zones"
+}
+
+func (ds *DNSZonesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	ds.M = meta
+}
+
+func (ds *DNSZonesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch all DNS zones in the organization.",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"zones": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         dnsZoneIDDescription,
+							MarkdownDescription: dnsZoneIDDescription,
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         dnsZoneNameDescription,
+							MarkdownDescription: dnsZoneNameDescription,
+						},
+						"default_ttl": schema.Int64Attribute{
+							Computed:            true,
+							Description:         dnsZoneTTLDescription,
+							MarkdownDescription: dnsZoneTTLDescription,
+						},
+						"verified": schema.BoolAttribute{
+							Computed:            true,
+							Description:         dnsZoneVerifiedDescription,
+							MarkdownDescription: dnsZoneVerifiedDescription,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (ds *DNSZonesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data DNSZonesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := ds.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[DNSZoneDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Zones = []DNSZoneDataSourceModel{}
+
+	for page := 1; ; page++ {
+		res, err := ds.M.Core.GetOrganizationDnsZonesWithResponse(ctx,
+			&core.GetOrganizationDnsZonesParams{
+				OrganizationSubDomain: &org,
+				Page:                  ptr(page),
+				PerPage:               ptr(dnsZonesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			resp.Diagnostics.AddError("DNS Zones Error", err.Error())
+			return
+		}
+
+		for _, zone := range res.JSON200.DnsZones {
+			model := DNSZoneDataSourceModel{
+				ID:         types.StringPointerValue(zone.Id),
+				Name:       types.StringPointerValue(zone.Name),
+				DefaultTTL: types.Int64Null(),
+				Verified: types.BoolValue(
+					zone.Verified != nil && *zone.Verified,
+				),
+			}
+			if zone.DefaultTtl != nil {
+				model.DefaultTTL = types.Int64Value(int64(*zone.DefaultTtl))
+			}
+
+			data.Zones = append(data.Zones, model)
+		}
+
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(res.JSON200.DnsZones),
+			dnsZonesPageSize,
+		) {
+			break
+		}
+	}
+
+	data.Zones = filterDataSourceModels(filters, data.Zones)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
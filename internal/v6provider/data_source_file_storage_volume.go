@@ -110,7 +110,7 @@ func (r *FileStorageVolumeDataSource) Read(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("File Storage Volume Error", err.Error())
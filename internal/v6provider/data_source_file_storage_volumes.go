@@ -14,7 +14,9 @@ type FileStorageVolumesDataSource struct {
 }
 
 type FileStorageVolumesDataSourceModel struct {
+	Organization       types.String                       `tfsdk:"organization"`
 	FileStorageVolumes []FileStorageVolumeDataSourceModel `tfsdk:"file_storage_volumes"`
+	Filter             []DataSourceFilterModel            `tfsdk:"filter"`
 }
 
 func (r FileStorageVolumesDataSource) Metadata(
@@ -54,6 +56,7 @@ func (r *FileStorageVolumesDataSource) Schema(
 	resp.Schema = schema.Schema{
 		Description: "Fetch all file storage volumes in the organization.",
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"file_storage_volumes": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "A list of file storage volumes.",
@@ -94,27 +97,45 @@ func (r *FileStorageVolumesDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
 func (r *FileStorageVolumesDataSource) Read(
 	ctx context.Context,
-	_ datasource.ReadRequest,
+	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
 	var data FileStorageVolumesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := r.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[FileStorageVolumeDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var volumes []FileStorageVolumeDataSourceModel
 	totalPages := 2
 
 	for pageNum := 1; pageNum <= totalPages; pageNum++ {
 		res, err := r.M.Core.GetOrganizationFileStorageVolumesWithResponse(ctx,
 			&core.GetOrganizationFileStorageVolumesParams{
-				OrganizationSubDomain: &r.M.confOrganization,
+				OrganizationSubDomain: &org,
 				Page:                  &pageNum,
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 
 			resp.Diagnostics.AddError("File Storage Volumes Error", err.Error())
@@ -151,6 +172,6 @@ func (r *FileStorageVolumesDataSource) Read(
 		}
 	}
 
-	data.FileStorageVolumes = volumes
+	data.FileStorageVolumes = filterDataSourceModels(filters, volumes)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,291 @@
+package v6provider
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	dataSourceFilterMatchExact = "exact"
+	dataSourceFilterMatchRegex = "regex"
+)
+
+type (
+	// DataSourceFilterModel is a single filter block on a plural data source.
+	DataSourceFilterModel struct {
+		Name    types.String   `tfsdk:"name"`
+		Values  []types.String `tfsdk:"values"`
+		MatchBy types.String   `tfsdk:"match_by"`
+	}
+
+	// dataSourceFilter is a validated filter ready to match items against.
+	dataSourceFilter struct {
+		name    string
+		values  []string
+		regexps []*regexp.Regexp
+	}
+
+	dataSourceFilters []dataSourceFilter
+)
+
+// dataSourceFilterBlock returns the filter block shared by every plural data
+// source.
+func dataSourceFilterBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Only return items matching every filter block. " +
+			"Filters on set and list attributes, such as `tag_ids`, match " +
+			"when any element matches.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "The name of the returned attribute to filter on.",
+					Validators: []validator.String{
+						stringValidatorNotEmpty(),
+					},
+				},
+				"values": schema.ListAttribute{
+					Required:    true,
+					ElementType: types.StringType,
+					MarkdownDescription: "Values to match. An item matches " +
+						"when any value matches.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"match_by": schema.StringAttribute{
+					Optional: true,
+					MarkdownDescription: "How values are compared: `exact` or " +
+						"`regex`. Defaults to `exact`. Regular expressions " +
+						"match anywhere in the value unless anchored.",
+					Validators: []validator.String{
+						stringvalidator.OneOf(
+							dataSourceFilterMatchExact,
+							dataSourceFilterMatchRegex,
+						),
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandDataSourceFilters validates the configured filters against the
+// attribute names the data source returns and compiles any expressions.
+func expandDataSourceFilters(
+	models []DataSourceFilterModel,
+	names []string,
+) (dataSourceFilters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filters := make(dataSourceFilters, 0, len(models))
+	for i, model := range models {
+		filterPath := path.Root("filter").AtListIndex(i)
+
+		name := model.Name.ValueString()
+		if !slices.Contains(names, name) {
+			diags.AddAttributeError(
+				filterPath.AtName("name"),
+				"Invalid Filter",
+				fmt.Sprintf("Cannot filter on %q. Valid names are: %s.",
+					name, strings.Join(names, ", ")),
+			)
+			continue
+		}
+
+		filter := dataSourceFilter{name: name}
+		for _, value := range model.Values {
+			filter.values = append(filter.values, value.ValueString())
+		}
+
+		if model.MatchBy.ValueString() == dataSourceFilterMatchRegex {
+			for j, value := range filter.values {
+				re, err := regexp.Compile(value)
+				if err != nil {
+					diags.AddAttributeError(
+						filterPath.AtName("values").AtListIndex(j),
+						"Invalid Filter",
+						fmt.Sprintf("Invalid regular expression %q: %s",
+							value, err),
+					)
+					continue
+				}
+
+				filter.regexps = append(filter.regexps, re)
+			}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, diags
+}
+
+// Match reports whether the attributes satisfy every filter.
+func (f dataSourceFilters) Match(attrs map[string]attr.Value) bool {
+	for _, filter := range f {
+		if !filter.match(attrs[filter.name]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f dataSourceFilter) match(value attr.Value) bool {
+	for _, s := range dataSourceFilterStrings(value) {
+		if f.regexps != nil {
+			for _, re := range f.regexps {
+				if re.MatchString(s) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		if slices.Contains(f.values, s) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataSourceFilterStrings renders a value as the strings filters compare
+// against. Null, unknown and nested object values never match.
+func dataSourceFilterStrings(value attr.Value) []string {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case basetypes.StringValue:
+		return []string{v.ValueString()}
+	case basetypes.BoolValue:
+		return []string{strconv.FormatBool(v.ValueBool())}
+	case basetypes.Int64Value:
+		return []string{strconv.FormatInt(v.ValueInt64(), 10)}
+	case basetypes.Float64Value:
+		return []string{strconv.FormatFloat(v.ValueFloat64(), 'f', -1, 64)}
+	case basetypes.SetValue:
+		return dataSourceFilterElementStrings(v.Elements())
+	case basetypes.ListValue:
+		return dataSourceFilterElementStrings(v.Elements())
+	}
+
+	return nil
+}
+
+func dataSourceFilterElementStrings(elems []attr.Value) []string {
+	var values []string
+	for _, elem := range elems {
+		values = append(values, dataSourceFilterStrings(elem)...)
+	}
+
+	return values
+}
+
+// dataSourceFilterNames returns the tfsdk attribute names of the model type T
+// that filters can refer to.
+func dataSourceFilterNames[T any]() []string {
+	attrValue := reflect.TypeOf((*attr.Value)(nil)).Elem()
+
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := field.Tag.Get("tfsdk")
+		if name == "" || name == "-" || !field.Type.Implements(attrValue) {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// dataSourceFilterAttrNames returns the attribute names of an object type
+// that filters can refer to.
+func dataSourceFilterAttrNames(attrTypes map[string]attr.Type) []string {
+	names := make([]string, 0, len(attrTypes))
+	for name, attrType := range attrTypes {
+		if _, ok := attrType.(basetypes.ObjectType); ok {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// filterDataSourceModels returns the items matching every filter.
+func filterDataSourceModels[T any](filters dataSourceFilters, items []T) []T {
+	if len(filters) == 0 {
+		return items
+	}
+
+	filtered := make([]T, 0, len(items))
+	for _, item := range items {
+		if filters.Match(dataSourceModelAttributes(item)) {
+			filtered = append(filtered, item)
+		}
+	}
+
+	return filtered
+}
+
+// filterDataSourceObjects returns the object values matching every filter.
+func filterDataSourceObjects(
+	filters dataSourceFilters,
+	values []attr.Value,
+) []attr.Value {
+	if len(filters) == 0 {
+		return values
+	}
+
+	filtered := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		obj, ok := value.(basetypes.ObjectValue)
+		if ok && filters.Match(obj.Attributes()) {
+			filtered = append(filtered, value)
+		}
+	}
+
+	return filtered
+}
+
+func dataSourceModelAttributes(item any) map[string]attr.Value {
+	v := reflect.ValueOf(item)
+	t := v.Type()
+
+	attrs := make(map[string]attr.Value, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("tfsdk")
+		if value, ok := v.Field(i).Interface().(attr.Value); ok && name != "" {
+			attrs[name] = value
+		}
+	}
+
+	return attrs
+}
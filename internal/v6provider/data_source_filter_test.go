@@ -0,0 +1,249 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceFilters(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		ID     types.String `tfsdk:"id"`
+		Name   types.String `tfsdk:"name"`
+		Size   types.Int64  `tfsdk:"size"`
+		Active types.Bool   `tfsdk:"active"`
+		TagIDs types.Set    `tfsdk:"tag_ids"`
+	}
+
+	tagIDs := func(ids ...string) types.Set {
+		elems := make([]attr.Value, len(ids))
+		for i, id := range ids {
+			elems[i] = types.StringValue(id)
+		}
+
+		return types.SetValueMust(types.StringType, elems)
+	}
+
+	items := []item{
+		{
+			ID:     types.StringValue("a"),
+			Name:   types.StringValue("web-1"),
+			Size:   types.Int64Value(10),
+			Active: types.BoolValue(true),
+			TagIDs: tagIDs("tag_web", "tag_prod"),
+		},
+		{
+			ID:     types.StringValue("b"),
+			Name:   types.StringValue("web-2"),
+			Size:   types.Int64Value(20),
+			Active: types.BoolValue(false),
+			TagIDs: tagIDs("tag_web"),
+		},
+		{
+			ID:     types.StringValue("c"),
+			Name:   types.StringValue("db-1"),
+			Size:   types.Int64Value(20),
+			Active: types.BoolValue(true),
+			TagIDs: types.SetNull(types.StringType),
+		},
+	}
+
+	filter := func(name, matchBy string, values ...string) DataSourceFilterModel {
+		model := DataSourceFilterModel{
+			Name:    types.StringValue(name),
+			MatchBy: types.StringNull(),
+		}
+		if matchBy != "" {
+			model.MatchBy = types.StringValue(matchBy)
+		}
+		for _, v := range values {
+			model.Values = append(model.Values, types.StringValue(v))
+		}
+
+		return model
+	}
+
+	tests := []struct {
+		name      string
+		filters   []DataSourceFilterModel
+		want      []string
+		wantError string
+	}{
+		{
+			name: "no filters",
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name:    "exact string",
+			filters: []DataSourceFilterModel{filter("name", "", "web-2")},
+			want:    []string{"b"},
+		},
+		{
+			name: "any value",
+			filters: []DataSourceFilterModel{
+				filter("name", "exact", "web-1", "db-1"),
+			},
+			want: []string{"a", "c"},
+		},
+		{
+			name:    "regex",
+			filters: []DataSourceFilterModel{filter("name", "regex", "^web-")},
+			want:    []string{"a", "b"},
+		},
+		{
+			name:    "number",
+			filters: []DataSourceFilterModel{filter("size", "", "20")},
+			want:    []string{"b", "c"},
+		},
+		{
+			name:    "bool",
+			filters: []DataSourceFilterModel{filter("active", "", "true")},
+			want:    []string{"a", "c"},
+		},
+		{
+			name:    "set membership",
+			filters: []DataSourceFilterModel{filter("tag_ids", "", "tag_web")},
+			want:    []string{"a", "b"},
+		},
+		{
+			name: "all filters must match",
+			filters: []DataSourceFilterModel{
+				filter("tag_ids", "", "tag_web"),
+				filter("active", "", "true"),
+			},
+			want: []string{"a"},
+		},
+		{
+			name:      "unknown name",
+			filters:   []DataSourceFilterModel{filter("nope", "", "x")},
+			wantError: `Cannot filter on "nope". Valid names are: active, id, name, size, tag_ids.`,
+		},
+		{
+			name:      "invalid regex",
+			filters:   []DataSourceFilterModel{filter("name", "regex", "(")},
+			wantError: "Invalid regular expression \"(\": error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filters, diags := expandDataSourceFilters(
+				tt.filters, dataSourceFilterNames[item](),
+			)
+			if tt.wantError != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, "Invalid Filter", diags.Errors()[0].Summary())
+				assert.Equal(t, tt.wantError, diags.Errors()[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), diags.Errors())
+
+			got := []string{}
+			for _, i := range filterDataSourceModels(filters, items) {
+				got = append(got, i.ID.ValueString())
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDataSourceFilterObjects(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+		"ref": types.ObjectType{AttrTypes: map[string]attr.Type{
+			"id": types.StringType,
+		}},
+	}
+
+	names := dataSourceFilterAttrNames(attrTypes)
+	assert.Equal(t, []string{"id", "name"}, names)
+
+	obj := func(id, name string) attr.Value {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"id":   types.StringValue(id),
+			"name": types.StringValue(name),
+			"ref":  types.ObjectNull(attrTypes["ref"].(types.ObjectType).AttrTypes),
+		})
+	}
+
+	filters, diags := expandDataSourceFilters([]DataSourceFilterModel{
+		{
+			Name:    types.StringValue("name"),
+			Values:  []types.String{types.StringValue("b")},
+			MatchBy: types.StringValue("regex"),
+		},
+	}, names)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	got := filterDataSourceObjects(filters, []attr.Value{
+		obj("1", "alpha"), obj("2", "bravo"), obj("3", "charlie"),
+	})
+
+	assert.Equal(t, []attr.Value{obj("2", "bravo")}, got)
+}
+
+func TestNetworkSpeedProfilesDataSourceReadFilter(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusOK, testNetworkSpeedProfilesResponse)
+	})
+	ds := &NetworkSpeedProfilesDataSource{M: &Meta{
+		Core:             client,
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), NetworkSpeedProfilesDataSourceModel{
+		ID: types.StringNull(),
+		Filter: []DataSourceFilterModel{
+			{
+				Name:    types.StringValue("permalink"),
+				Values:  []types.String{types.StringValue("unrestricted")},
+				MatchBy: types.StringNull(),
+			},
+		},
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got NetworkSpeedProfilesDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	require.Len(t, got.Profiles, 1)
+	assert.Equal(t, types.StringValue("nsp_unrestricted"), got.Profiles[0].ID)
+}
@@ -17,7 +17,8 @@ type (
 	}
 
 	GlobalAddressListsDataSourceModel struct {
-		AddressLists types.Set `tfsdk:"address_lists"`
+		AddressLists types.Set               `tfsdk:"address_lists"`
+		Filter       []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -71,6 +72,9 @@ func (ds *GlobalAddressListsDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -86,6 +90,21 @@ func (ds *GlobalAddressListsDataSource) Read(
 		return
 	}
 
+	listValueType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":   types.StringType,
+			"name": types.StringType,
+		},
+	}
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterAttrNames(listValueType.AttrTypes),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	addressLists := []core.GetAddressLists200ResponseAddressLists{}
 	totalPages := 2
 
@@ -96,7 +115,7 @@ func (ds *GlobalAddressListsDataSource) Read(
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 
 			resp.Diagnostics.AddError("Address Lists Error", err.Error())
@@ -107,13 +126,6 @@ func (ds *GlobalAddressListsDataSource) Read(
 		totalPages, _ = res.JSON200.Pagination.TotalPages.Get()
 	}
 
-	listValueType := types.ObjectType{
-		AttrTypes: map[string]attr.Type{
-			"id":   types.StringType,
-			"name": types.StringType,
-		},
-	}
-
 	addrListValues, diags := convertGlobalAddrListsToValues(
 		addressLists,
 		listValueType.AttrTypes,
@@ -125,7 +137,7 @@ func (ds *GlobalAddressListsDataSource) Read(
 
 	addrListValue, diags := types.SetValue(
 		listValueType,
-		addrListValues,
+		filterDataSourceObjects(filters, addrListValues),
 	)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
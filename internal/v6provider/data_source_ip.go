@@ -146,7 +146,7 @@ func (r *IPDataSource) Read(
 
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("IP Error", err.Error())
@@ -112,7 +112,7 @@ func (ds *LoadBalancerDataSource) Read(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Load Balancer Error", err.Error())
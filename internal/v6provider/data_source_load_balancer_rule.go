@@ -153,7 +153,7 @@ func (ds LoadBalancerRuleDataSource) Read(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Load Balancer Rule Error", err.Error())
@@ -15,8 +15,9 @@ type (
 		M *Meta
 	}
 	LoadBalancerRulesDataSourceModel struct {
-		LoadBalancerID types.String `tfsdk:"load_balancer_id"`
-		Rules          types.List   `tfsdk:"rules"`
+		LoadBalancerID types.String            `tfsdk:"load_balancer_id"`
+		Rules          types.List              `tfsdk:"rules"`
+		Filter         []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -67,6 +68,9 @@ func (ds *LoadBalancerRulesDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -82,6 +86,14 @@ func (ds *LoadBalancerRulesDataSource) Read(
 		return
 	}
 
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterAttrNames(LoadBalancerRuleType().AttrTypes),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	rules, err := getLBRules(ctx, ds.M,
 		data.LoadBalancerID.ValueString(),
 	)
@@ -92,7 +104,9 @@ func (ds *LoadBalancerRulesDataSource) Read(
 
 	data.Rules = types.ListValueMust(
 		LoadBalancerRuleType(),
-		convertCoreLBRulesToAttrValue(rules),
+		filterDataSourceObjects(
+			filters, convertCoreLBRulesToAttrValue(rules),
+		),
 	)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -106,27 +120,9 @@ func getLBRules(
 	[]core.GetLoadBalancersRulesLoadBalancerRule200ResponseLoadBalancerRule,
 	error,
 ) {
-	var ruleList []core.GetLoadBalancerRules200ResponseLoadBalancerRules
-
-	totalPages := 2
-	for pageNum := 1; pageNum <= totalPages; pageNum++ {
-		res, err := m.Core.GetLoadBalancerRulesWithResponse(ctx,
-			&core.GetLoadBalancerRulesParams{
-				LoadBalancerId: &lbID,
-				Page:           &pageNum,
-			},
-		)
-		if err != nil {
-			if res != nil {
-				err = genericAPIError(err, res.Body)
-			}
-
-			return nil, err
-		}
-
-		totalPages, _ = res.JSON200.Pagination.TotalPages.Get()
-
-		ruleList = append(ruleList, res.JSON200.LoadBalancerRules...)
+	ruleList, err := listLBRules(ctx, m, lbID)
+	if err != nil {
+		return nil, err
 	}
 
 	rules := make(
@@ -141,7 +137,7 @@ func getLBRules(
 				})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 
 			return nil, err
@@ -153,6 +149,39 @@ func getLBRules(
 	return rules, nil
 }
 
+// listLBRules returns the summary of every rule on a load balancer, without
+// fetching each rule's full details.
+func listLBRules(
+	ctx context.Context,
+	m *Meta,
+	lbID string,
+) ([]core.GetLoadBalancerRules200ResponseLoadBalancerRules, error) {
+	var ruleList []core.GetLoadBalancerRules200ResponseLoadBalancerRules
+
+	totalPages := 2
+	for pageNum := 1; pageNum <= totalPages; pageNum++ {
+		res, err := m.Core.GetLoadBalancerRulesWithResponse(ctx,
+			&core.GetLoadBalancerRulesParams{
+				LoadBalancerId: &lbID,
+				Page:           &pageNum,
+			},
+		)
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return nil, err
+		}
+
+		totalPages, _ = res.JSON200.Pagination.TotalPages.Get()
+
+		ruleList = append(ruleList, res.JSON200.LoadBalancerRules...)
+	}
+
+	return ruleList, nil
+}
+
 func convertCoreLBRulesToAttrValue(
 	rules []core.GetLoadBalancersRulesLoadBalancerRule200ResponseLoadBalancerRule,
 ) []attr.Value {
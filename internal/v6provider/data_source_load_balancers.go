@@ -16,8 +16,12 @@ type (
 	}
 
 	LoadBalancersDataSourceModel struct {
-		ID            types.String `tfsdk:"id"`
-		LoadBalancers types.List   `tfsdk:"load_balancers"`
+		Organization  types.String            `tfsdk:"organization"`
+		ID            types.String            `tfsdk:"id"`
+		Tags          types.Set               `tfsdk:"tags"`
+		TagIDs        types.Set               `tfsdk:"tag_ids"`
+		LoadBalancers types.List              `tfsdk:"load_balancers"`
+		Filter        []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -59,10 +63,19 @@ func (ds *LoadBalancersDataSource) Schema(
 
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Always set to provider organization value.",
 			},
+			"tags": tagSelectionNamesAttribute(
+				"Load balancers cannot be tagged, so only load balancers " +
+					"targeting the tags are returned.",
+			),
+			"tag_ids": tagSelectionIDsAttribute(
+				"Load balancers cannot be tagged, so only load balancers " +
+					"targeting the tags are returned.",
+			),
 			"load_balancers": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -70,6 +83,9 @@ func (ds *LoadBalancersDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -84,17 +100,33 @@ func (ds *LoadBalancersDataSource) Read(
 		return
 	}
 
+	org := ds.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterAttrNames(LoadBalancerType().AttrTypes),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selection, diags := expandTagSelection(ctx, ds.M, org, data.Tags, data.TagIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	loadBalancers := []core.GetLoadBalancer200ResponseLoadBalancer{}
 	totalPages := 2
 	for pageNum := 1; pageNum < totalPages; pageNum++ {
 		res, err := ds.M.Core.GetOrganizationLoadBalancersWithResponse(ctx,
 			&core.GetOrganizationLoadBalancersParams{
-				OrganizationSubDomain: &ds.M.confOrganization,
+				OrganizationSubDomain: &org,
 				Page:                  &pageNum,
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 
 			resp.Diagnostics.AddError("Load Balancers Error", err.Error())
@@ -110,7 +142,7 @@ func (ds *LoadBalancersDataSource) Read(
 				&core.GetLoadBalancerParams{LoadBalancerId: lb.Id})
 			if err != nil {
 				if res != nil {
-					err = genericAPIError(err, res.Body)
+					err = genericAPIError(err, res.Body, res.HTTPResponse)
 				}
 
 				resp.Diagnostics.AddError("Load Balancer Error", err.Error())
@@ -121,9 +153,14 @@ func (ds *LoadBalancersDataSource) Read(
 		}
 	}
 
-	list := make([]attr.Value, len(loadBalancers))
+	list := make([]attr.Value, 0, len(loadBalancers))
+
+	for _, lb := range loadBalancers {
+		if selection != nil && (*lb.ResourceType != core.Tags ||
+			!selection.Match(*lb.ResourceIds)) {
+			continue
+		}
 
-	for i, lb := range loadBalancers {
 		attrs := map[string]attr.Value{
 			"id":   types.StringPointerValue(lb.Id),
 			"name": types.StringPointerValue(lb.Name),
@@ -149,15 +186,15 @@ func (ds *LoadBalancersDataSource) Read(
 			attrs["ip_address"] = types.StringPointerValue(lb.IpAddress.Address)
 		}
 
-		list[i] = types.ObjectValueMust(
+		list = append(list, types.ObjectValueMust(
 			LoadBalancerType().AttrTypes,
 			attrs,
-		)
+		))
 	}
 
 	data.LoadBalancers = types.ListValueMust(
 		LoadBalancerType(),
-		list,
+		filterDataSourceObjects(filters, list),
 	)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
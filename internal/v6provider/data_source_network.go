@@ -24,6 +24,13 @@ type NetworkDataSourceModel struct {
 	Default      types.Bool   `tfsdk:"default"`
 }
 
+// networkDataSourceLookupModel adds the arguments only used to look up a
+// single network to NetworkDataSourceModel, which katapult_networks shares.
+type networkDataSourceLookupModel struct {
+	NetworkDataSourceModel
+	DataCenter types.String `tfsdk:"data_center"`
+}
+
 func (nds NetworkDataSource) Metadata(
 	_ context.Context,
 	req datasource.MetadataRequest,
@@ -98,6 +105,21 @@ func networkDataSourceSchemaAttrs() map[string]schema.Attribute {
 			Description: "True if this is the default network for " +
 				"the data center it belongs to.",
 		},
+		dataCenterAttributeName: schema.StringAttribute{
+			Optional: true,
+			MarkdownDescription: "Permalink of the data center whose " +
+				"default network is looked up when neither `id`, " +
+				"`permalink` nor `data_center_id` is set. Defaults to " +
+				"the provider's `data_center`.",
+			Validators: []validator.String{
+				stringValidatorNotEmpty(),
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("id"),
+					path.MatchRoot("permalink"),
+					path.MatchRoot("data_center_id"),
+				),
+			},
+		},
 	}
 }
 
@@ -116,7 +138,7 @@ func (nds *NetworkDataSource) Read(
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var data NetworkDataSourceModel
+	var data networkDataSourceLookupModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -131,7 +153,7 @@ func (nds *NetworkDataSource) Read(
 		if dcID != "" {
 			params.DataCenterId = &dcID
 		} else {
-			params.DataCenterPermalink = &nds.M.confDataCenter
+			params.DataCenterPermalink = ptr(nds.M.dataCenter(data.DataCenter))
 		}
 
 		network, err := nds.getDefaultNetwork(ctx, params)
@@ -140,7 +162,7 @@ func (nds *NetworkDataSource) Read(
 			return
 		}
 
-		nds.populate(ctx, resp, network)
+		nds.populate(ctx, resp, network, data.DataCenter)
 		return
 	}
 
@@ -159,7 +181,7 @@ func (nds *NetworkDataSource) Read(
 	res, err := nds.M.Core.GetNetworkWithResponse(ctx, params)
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError(
@@ -169,18 +191,22 @@ func (nds *NetworkDataSource) Read(
 	}
 
 	network := res.JSON200.Network
-	nds.populate(ctx, resp, &network)
+	nds.populate(ctx, resp, &network, data.DataCenter)
 }
 
 func (nds *NetworkDataSource) populate(
 	ctx context.Context,
 	resp *datasource.ReadResponse,
 	network *core.Network,
+	dataCenter types.String,
 ) {
-	model := NetworkDataSourceModel{
-		ID:      types.StringPointerValue(network.Id),
-		Name:    types.StringPointerValue(network.Name),
-		Default: types.BoolPointerValue(network.Default),
+	model := networkDataSourceLookupModel{
+		NetworkDataSourceModel: NetworkDataSourceModel{
+			ID:      types.StringPointerValue(network.Id),
+			Name:    types.StringPointerValue(network.Name),
+			Default: types.BoolPointerValue(network.Default),
+		},
+		DataCenter: dataCenter,
 	}
 
 	if v, err := network.Permalink.Get(); err == nil {
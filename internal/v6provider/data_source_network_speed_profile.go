@@ -0,0 +1,236 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+var _ datasource.DataSourceWithConfigValidators = (*NetworkSpeedProfileDataSource)(nil)
+
+type (
+	NetworkSpeedProfileDataSource struct {
+		M *Meta
+	}
+
+	NetworkSpeedProfileDataSourceModel struct {
+		ID            types.String `tfsdk:"id"`
+		Permalink     types.String `tfsdk:"permalink"`
+		Name          types.String `tfsdk:"name"`
+		UploadSpeed   types.Int64  `tfsdk:"upload_speed"`
+		DownloadSpeed types.Int64  `tfsdk:"download_speed"`
+	}
+
+	// networkSpeedProfileDataSourceLookupModel adds the arguments only used
+	// to look up a single profile to NetworkSpeedProfileDataSourceModel,
+	// which katapult_network_speed_profiles shares.
+	networkSpeedProfileDataSourceLookupModel struct {
+		NetworkSpeedProfileDataSourceModel
+		Organization types.String `tfsdk:"organization"`
+	}
+)
+
+const networkSpeedProfilesPageSize = 100
+
+func (d *NetworkSpeedProfileDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_network_speed_profile"
+}
+
+func (d *NetworkSpeedProfileDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func networkSpeedProfileSchemaAttributes(
+	selector bool,
+) map[string]schema.Attribute {
+	id := schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The unique identifier of the network speed profile.",
+	}
+	permalink := schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The permalink of the network speed profile.",
+	}
+	if selector {
+		id.Optional = true
+		id.MarkdownDescription += " Takes precedence over `permalink`."
+		id.Validators = []validator.String{
+			stringValidatorNotEmpty(),
+		}
+		permalink.Optional = true
+		permalink.Validators = []validator.String{
+			stringValidatorNotEmpty(),
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"id":        id,
+		"permalink": permalink,
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the network speed profile.",
+		},
+		"upload_speed": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Upload speed in Mbit. A value of `0` means unrestricted.",
+		},
+		"download_speed": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Download speed in Mbit. A value of `0` means unrestricted.",
+		},
+	}
+}
+
+func (d *NetworkSpeedProfileDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("permalink"),
+		),
+	}
+}
+
+func (d *NetworkSpeedProfileDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	attrs := networkSpeedProfileSchemaAttributes(true)
+	attrs[organizationAttributeName] = organizationDataSourceAttribute()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a network speed profile by ID or permalink.",
+		Attributes:          attrs,
+	}
+}
+
+func (d *NetworkSpeedProfileDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data networkSpeedProfileDataSourceLookupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := d.M.organization(data.Organization)
+
+	profiles, err := fetchAllOrganizationNetworkSpeedProfiles(ctx, d.M, org)
+	if err != nil {
+		resp.Diagnostics.AddError("Network Speed Profile Error", err.Error())
+		return
+	}
+
+	selectorName := "id"
+	selectorValue := data.ID.ValueString()
+	if data.ID.IsNull() {
+		selectorName = "permalink"
+		selectorValue = data.Permalink.ValueString()
+	}
+
+	for i := range profiles {
+		candidate := profiles[i].Id
+		if selectorName == "permalink" {
+			candidate = profiles[i].Permalink
+		}
+		if candidate != nil && *candidate == selectorValue {
+			data.NetworkSpeedProfileDataSourceModel =
+				networkSpeedProfileDataSourceModel(&profiles[i])
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Network Speed Profile Not Found",
+		fmt.Sprintf("No network speed profile with %s %q exists in organization %q.",
+			selectorName, selectorValue, org),
+	)
+}
+
+func fetchAllOrganizationNetworkSpeedProfiles(
+	ctx context.Context,
+	m *Meta,
+	org string,
+) ([]core.NetworkSpeedProfile, error) {
+	profiles := []core.NetworkSpeedProfile{}
+	for page := 1; ; page++ {
+		res, err := m.Core.GetOrganizationNetworkSpeedProfilesWithResponse(ctx,
+			&core.GetOrganizationNetworkSpeedProfilesParams{
+				OrganizationSubDomain: &org,
+				Page:                  &page,
+				PerPage:               ptr(networkSpeedProfilesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response listing network speed profiles on page %d", page)
+		}
+
+		pageProfiles := res.JSON200.NetworkSpeedProfiles
+		profiles = append(profiles, pageProfiles...)
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(pageProfiles),
+			networkSpeedProfilesPageSize,
+		) {
+			break
+		}
+	}
+
+	return profiles, nil
+}
+
+// networkSpeedProfileDataSourceModel reports unrestricted speeds, which the
+// API returns as null, as 0.
+func networkSpeedProfileDataSourceModel(
+	profile *core.NetworkSpeedProfile,
+) NetworkSpeedProfileDataSourceModel {
+	model := NetworkSpeedProfileDataSourceModel{
+		ID:            types.StringPointerValue(profile.Id),
+		Permalink:     types.StringPointerValue(profile.Permalink),
+		Name:          types.StringPointerValue(profile.Name),
+		UploadSpeed:   nullableIntValue(profile.UploadSpeedInMbit),
+		DownloadSpeed: nullableIntValue(profile.DownloadSpeedInMbit),
+	}
+	if model.UploadSpeed.IsNull() {
+		model.UploadSpeed = types.Int64Value(0)
+	}
+	if model.DownloadSpeed.IsNull() {
+		model.DownloadSpeed = types.Int64Value(0)
+	}
+
+	return model
+}
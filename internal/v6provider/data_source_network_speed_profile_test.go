@@ -0,0 +1,197 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testNetworkSpeedProfilesResponse = `{
+	"network_speed_profiles": [
+		{
+			"id": "nsp_limited",
+			"name": "1 Gbps",
+			"permalink": "1gbps",
+			"upload_speed_in_mbit": 1000,
+			"download_speed_in_mbit": 1000
+		},
+		{
+			"id": "nsp_unrestricted",
+			"name": "Unrestricted",
+			"permalink": "unrestricted",
+			"upload_speed_in_mbit": null,
+			"download_speed_in_mbit": null
+		}
+	],
+	"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+}`
+
+func TestNetworkSpeedProfileDataSourceReadByPermalink(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(
+			t, "/organizations/organization/network_speed_profiles",
+			r.URL.Path,
+		)
+		writeTestJSON(w, http.StatusOK, testNetworkSpeedProfilesResponse)
+	})
+	ds := &NetworkSpeedProfileDataSource{M: &Meta{
+		Core:             client,
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name      string
+		permalink string
+		want      NetworkSpeedProfileDataSourceModel
+		wantError string
+	}{
+		{
+			name:      "limited",
+			permalink: "1gbps",
+			want: NetworkSpeedProfileDataSourceModel{
+				ID:            types.StringValue("nsp_limited"),
+				Permalink:     types.StringValue("1gbps"),
+				Name:          types.StringValue("1 Gbps"),
+				UploadSpeed:   types.Int64Value(1000),
+				DownloadSpeed: types.Int64Value(1000),
+			},
+		},
+		{
+			name:      "unrestricted",
+			permalink: "unrestricted",
+			want: NetworkSpeedProfileDataSourceModel{
+				ID:            types.StringValue("nsp_unrestricted"),
+				Permalink:     types.StringValue("unrestricted"),
+				Name:          types.StringValue("Unrestricted"),
+				UploadSpeed:   types.Int64Value(0),
+				DownloadSpeed: types.Int64Value(0),
+			},
+		},
+		{
+			name:      "missing",
+			permalink: "missing",
+			wantError: "Network Speed Profile Not Found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			diags := config.Set(
+				context.Background(), networkSpeedProfileDataSourceLookupModel{
+					NetworkSpeedProfileDataSourceModel: NetworkSpeedProfileDataSourceModel{
+						ID:            types.StringNull(),
+						Permalink:     types.StringValue(tt.permalink),
+						Name:          types.StringNull(),
+						UploadSpeed:   types.Int64Null(),
+						DownloadSpeed: types.Int64Null(),
+					},
+					Organization: types.StringNull(),
+				},
+			)
+			require.False(t, diags.HasError(), diags.Errors())
+
+			resp := datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			ds.Read(
+				context.Background(),
+				datasource.ReadRequest{
+					Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				},
+				&resp,
+			)
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(
+					t, tt.wantError, resp.Diagnostics.Errors()[0].Summary(),
+				)
+				return
+			}
+			require.False(
+				t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors(),
+			)
+
+			var got networkSpeedProfileDataSourceLookupModel
+			diags = resp.State.Get(context.Background(), &got)
+			require.False(t, diags.HasError(), diags.Errors())
+
+			assert.Equal(t, tt.want, got.NetworkSpeedProfileDataSourceModel)
+			assert.True(t, got.Organization.IsNull())
+		})
+	}
+}
+
+func TestNetworkSpeedProfileDataSourceReadWithOrganization(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(
+			t, "other-org",
+			r.URL.Query().Get("organization[sub_domain]"),
+		)
+		writeTestJSON(w, http.StatusOK, testNetworkSpeedProfilesResponse)
+	})
+	ds := &NetworkSpeedProfileDataSource{M: &Meta{
+		Core:             client,
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(
+		context.Background(), networkSpeedProfileDataSourceLookupModel{
+			NetworkSpeedProfileDataSourceModel: NetworkSpeedProfileDataSourceModel{
+				ID:            types.StringNull(),
+				Permalink:     types.StringValue("1gbps"),
+				Name:          types.StringNull(),
+				UploadSpeed:   types.Int64Null(),
+				DownloadSpeed: types.Int64Null(),
+			},
+			Organization: types.StringValue("other-org"),
+		},
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got networkSpeedProfileDataSourceLookupModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.Equal(t, "nsp_limited", got.ID.ValueString())
+	assert.Equal(t, "other-org", got.Organization.ValueString())
+}
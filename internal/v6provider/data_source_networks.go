@@ -14,7 +14,9 @@ type NetworksDataSource struct {
 }
 
 type NetworksDataSourceModel struct {
-	Networks []NetworkDataSourceModel `tfsdk:"networks"`
+	Organization types.String             `tfsdk:"organization"`
+	Networks     []NetworkDataSourceModel `tfsdk:"networks"`
+	Filter       []DataSourceFilterModel  `tfsdk:"filter"`
 }
 
 func (nds NetworksDataSource) Metadata(
@@ -53,6 +55,7 @@ func (nds *NetworksDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"networks": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -83,6 +86,9 @@ func (nds *NetworksDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -97,15 +103,25 @@ func (nds *NetworksDataSource) Read(
 		return
 	}
 
+	org := nds.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[NetworkDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := nds.M.Core.GetOrganizationAvailableNetworksWithResponse(
 		ctx,
 		&core.GetOrganizationAvailableNetworksParams{
-			OrganizationSubDomain: &nds.M.confOrganization,
+			OrganizationSubDomain: &org,
 		},
 	)
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Networks Error", err.Error())
@@ -133,6 +149,6 @@ func (nds *NetworksDataSource) Read(
 		list[i] = model
 	}
 
-	data.Networks = list
+	data.Networks = filterDataSourceModels(filters, list)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
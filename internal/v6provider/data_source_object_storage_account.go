@@ -17,6 +17,7 @@ type ObjectStorageAccountDataSource struct {
 }
 
 type ObjectStorageAccountDataSourceModel struct {
+	Organization      types.String `tfsdk:"organization"`
 	Region            types.String `tfsdk:"region"`
 	ProvisioningState types.String `tfsdk:"provisioning_state"`
 }
@@ -62,6 +63,7 @@ func (d *ObjectStorageAccountDataSource) Schema(
 	resp.Schema = schema.Schema{
 		MarkdownDescription: objectStorageAccountDataSourceMarkdownDesc,
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			objectStorageRegionAttributeName: schema.StringAttribute{
 				Required: true,
 				MarkdownDescription: "Object storage region. Currently the " +
@@ -91,15 +93,17 @@ func (d *ObjectStorageAccountDataSource) Read(
 		return
 	}
 
+	org := d.M.organization(data.Organization)
+
 	region := data.Region.ValueString()
 
-	acct, err := getObjectStorageAccount(ctx, d.M, region)
+	acct, err := getObjectStorageAccount(ctx, d.M, org, region)
 	if err != nil {
 		if errors.Is(err, core.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Object Storage Account Not Found",
 				"No object storage account exists for organization "+
-					d.M.confOrganization+" in region "+region+".",
+					org+" in region "+region+".",
 			)
 			return
 		}
@@ -64,6 +64,12 @@ func (d *ObjectStorageBucketDataSource) Schema(
 					stringValidatorNotEmpty(),
 				},
 			},
+			organizationAttributeName: schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Always null. Buckets are looked up by " +
+					"their globally unique name, and the API does not " +
+					"report the organization which owns them.",
+			},
 			"label": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Optional bucket label in Katapult.",
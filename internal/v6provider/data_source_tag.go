@@ -90,7 +90,7 @@ func (r TagDataSource) Read(
 	})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Tag Error", err.Error())
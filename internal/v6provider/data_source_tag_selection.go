@@ -0,0 +1,208 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+const tagsDataSourcePageSize = 200
+
+// tagSelection is the set of tag IDs an object must carry to be selected. A
+// nil selection selects everything.
+type tagSelection []string
+
+// tagSelectionNamesAttribute returns the tags argument used by plural data
+// sources to select objects by tag name. The description explains how the
+// data source relates its objects to tags.
+func tagSelectionNamesAttribute(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		MarkdownDescription: "Names of tags to select by. " + description +
+			" Every tag given here and in `tag_ids` must match.",
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringValidatorNotEmpty()),
+		},
+	}
+}
+
+// tagSelectionIDsAttribute is the tag_ids counterpart of
+// tagSelectionNamesAttribute.
+func tagSelectionIDsAttribute(description string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+		MarkdownDescription: "IDs of tags to select by. " + description +
+			" Every tag given here and in `tags` must match.",
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(stringValidatorNotEmpty()),
+		},
+	}
+}
+
+// expandTagSelection resolves the configured tag names and IDs into a
+// selection of tag IDs. Names that do not exist in the provider organization
+// are reported as errors rather than silently selecting nothing.
+func expandTagSelection(
+	ctx context.Context,
+	m *Meta,
+	org string,
+	tagNames types.Set,
+	tagIDs types.Set,
+) (tagSelection, diag.Diagnostics) {
+	names, diags := stringSetValueStrings(ctx, "tags", tagNames)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	ids, d := stringSetValueStrings(ctx, "tag_ids", tagIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if len(names) == 0 && len(ids) == 0 {
+		return nil, diags
+	}
+
+	selection := tagSelection(ids)
+	if len(names) > 0 {
+		tags, err := fetchAllOrganizationTags(ctx, m, org)
+		if err != nil {
+			diags.AddError("Tags Error", err.Error())
+			return nil, diags
+		}
+
+		for _, name := range names {
+			i := slices.IndexFunc(tags, func(tag core.GetOrganizationTags200ResponseTags) bool {
+				return tag.Name != nil && *tag.Name == name
+			})
+			if i == -1 || tags[i].Id == nil {
+				diags.AddError(
+					"Tag Not Found",
+					fmt.Sprintf("No tag named %q exists in the organization.", name),
+				)
+				continue
+			}
+
+			selection = append(selection, *tags[i].Id)
+		}
+	}
+
+	slices.Sort(selection)
+
+	return slices.Compact(selection), diags
+}
+
+// Match reports whether tagIDs contains every selected tag.
+func (s tagSelection) Match(tagIDs []string) bool {
+	for _, id := range s {
+		if !slices.Contains(tagIDs, id) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func fetchAllOrganizationTags(
+	ctx context.Context,
+	m *Meta,
+	org string,
+) ([]core.GetOrganizationTags200ResponseTags, error) {
+	tags := []core.GetOrganizationTags200ResponseTags{}
+	for page := 1; ; page++ {
+		res, err := m.Core.GetOrganizationTagsWithResponse(ctx,
+			&core.GetOrganizationTagsParams{
+				OrganizationSubDomain: &org,
+				Page:                  &page,
+				PerPage:               ptr(tagsDataSourcePageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response listing tags on page %d", page)
+		}
+
+		pageTags := res.JSON200.Tags
+		tags = append(tags, pageTags...)
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(pageTags), tagsDataSourcePageSize,
+		) {
+			break
+		}
+	}
+
+	return tags, nil
+}
+
+// fetchVirtualMachineTagIDs returns the IDs of the tags on a virtual machine.
+// Tags are not included when listing virtual machines, so each one has to be
+// fetched individually.
+func fetchVirtualMachineTagIDs(
+	ctx context.Context,
+	m *Meta,
+	vmID string,
+) ([]string, error) {
+	res, err := m.Core.GetVirtualMachineWithResponse(ctx,
+		&core.GetVirtualMachineParams{VirtualMachineId: &vmID})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected empty response reading virtual machine %s", vmID)
+	}
+
+	ids := []string{}
+	if tags := res.JSON200.VirtualMachine.Tags; tags != nil {
+		for _, tag := range *tags {
+			if tag.Id != nil {
+				ids = append(ids, *tag.Id)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// selectTaggedVirtualMachines returns the virtual machines carrying every
+// selected tag.
+func selectTaggedVirtualMachines(
+	ctx context.Context,
+	m *Meta,
+	selection tagSelection,
+	vms []core.GetOrganizationVirtualMachines200ResponseVirtualMachines,
+) ([]core.GetOrganizationVirtualMachines200ResponseVirtualMachines, error) {
+	if selection == nil {
+		return vms, nil
+	}
+
+	selected := []core.GetOrganizationVirtualMachines200ResponseVirtualMachines{}
+	for _, vm := range vms {
+		tagIDs, err := fetchVirtualMachineTagIDs(ctx, m, *vm.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		if selection.Match(tagIDs) {
+			selected = append(selected, vm)
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,309 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+var _ datasource.DataSourceWithConfigValidators = (*TaggedObjectsDataSource)(nil)
+
+const (
+	taggedObjectTypeVirtualMachine = "virtual_machine"
+	taggedObjectTypeLoadBalancer   = "load_balancer"
+
+	loadBalancersDataSourcePageSize = 100
+)
+
+type (
+	TaggedObjectsDataSource struct {
+		M *Meta
+	}
+
+	TaggedObjectsDataSourceModel struct {
+		Organization types.String                  `tfsdk:"organization"`
+		TagID        types.String                  `tfsdk:"tag_id"`
+		TagName      types.String                  `tfsdk:"tag_name"`
+		Objects      []TaggedObjectDataSourceModel `tfsdk:"objects"`
+		Filter       []DataSourceFilterModel       `tfsdk:"filter"`
+	}
+
+	TaggedObjectDataSourceModel struct {
+		ObjectType types.String `tfsdk:"object_type"`
+		ID         types.String `tfsdk:"id"`
+		Name       types.String `tfsdk:"name"`
+	}
+)
+
+func (d *TaggedObjectsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_tagged_objects"
+}
+
+func (d *TaggedObjectsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func (d *TaggedObjectsDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("tag_id"),
+			path.MatchRoot("tag_name"),
+		),
+	}
+}
+
+func (d *TaggedObjectsDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every object in the provider organization " +
+			"associated with a tag: Virtual Machines carrying the tag and " +
+			"load balancers targeting it.",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"tag_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The ID of the tag.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"tag_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "The name of the tag.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed: true,
+				MarkdownDescription: "Objects associated with the tag, " +
+					"Virtual Machines first, each ordered lexically by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_type": schema.StringAttribute{
+							Computed: true,
+							MarkdownDescription: "The type of object: " +
+								"`virtual_machine` or `load_balancer`.",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The unique identifier of the object.",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the object.",
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (d *TaggedObjectsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data TaggedObjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[TaggedObjectDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tag, err := fetchTaggedObjectsTag(
+		ctx, d.M, org, data.TagID.ValueString(), data.TagName.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Tag Error", err.Error())
+		return
+	}
+	if tag == nil {
+		resp.Diagnostics.AddError(
+			"Tag Not Found",
+			fmt.Sprintf(
+				"No tag named %q exists in the organization.",
+				data.TagName.ValueString(),
+			),
+		)
+		return
+	}
+
+	data.TagID = types.StringPointerValue(tag.Id)
+	data.TagName = types.StringPointerValue(tag.Name)
+	selection := tagSelection{*tag.Id}
+
+	vms, err := fetchAllOrganizationVirtualMachines(ctx, d.M, org)
+	if err == nil {
+		vms, err = selectTaggedVirtualMachines(ctx, d.M, selection, vms)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Virtual Machines Error", err.Error())
+		return
+	}
+
+	lbs, err := fetchAllOrganizationLoadBalancers(ctx, d.M, org)
+	if err != nil {
+		resp.Diagnostics.AddError("Load Balancers Error", err.Error())
+		return
+	}
+
+	objects := make([]TaggedObjectDataSourceModel, 0, len(vms))
+	for _, vm := range vms {
+		objects = append(objects, TaggedObjectDataSourceModel{
+			ObjectType: types.StringValue(taggedObjectTypeVirtualMachine),
+			ID:         types.StringPointerValue(vm.Id),
+			Name:       types.StringPointerValue(vm.Name),
+		})
+	}
+	for _, lb := range lbs {
+		if lb.ResourceType == nil || *lb.ResourceType != core.Tags ||
+			lb.ResourceIds == nil || !selection.Match(*lb.ResourceIds) {
+			continue
+		}
+
+		objects = append(objects, TaggedObjectDataSourceModel{
+			ObjectType: types.StringValue(taggedObjectTypeLoadBalancer),
+			ID:         types.StringPointerValue(lb.Id),
+			Name:       types.StringPointerValue(lb.Name),
+		})
+	}
+
+	data.Objects = filterDataSourceModels(filters, objects)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// fetchTaggedObjectsTag looks up a tag by ID, or by name when no ID is given.
+// A nil tag is returned when no tag has the name.
+func fetchTaggedObjectsTag(
+	ctx context.Context,
+	m *Meta,
+	org string,
+	id string,
+	name string,
+) (*core.GetOrganizationTags200ResponseTags, error) {
+	if id != "" {
+		res, err := m.Core.GetTagWithResponse(ctx, &core.GetTagParams{
+			TagId: &id,
+		})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response reading tag %s", id)
+		}
+
+		return &core.GetOrganizationTags200ResponseTags{
+			Id:   res.JSON200.Tag.Id,
+			Name: res.JSON200.Tag.Name,
+		}, nil
+	}
+
+	tags, err := fetchAllOrganizationTags(ctx, m, org)
+	if err != nil {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(tags, func(tag core.GetOrganizationTags200ResponseTags) bool {
+		return tag.Id != nil && tag.Name != nil && *tag.Name == name
+	})
+	if i == -1 {
+		return nil, nil
+	}
+
+	return &tags[i], nil
+}
+
+func fetchAllOrganizationLoadBalancers(
+	ctx context.Context,
+	m *Meta,
+	org string,
+) ([]core.GetOrganizationLoadBalancers200ResponseLoadBalancers, error) {
+	lbs := []core.GetOrganizationLoadBalancers200ResponseLoadBalancers{}
+	for page := 1; ; page++ {
+		res, err := m.Core.GetOrganizationLoadBalancersWithResponse(ctx,
+			&core.GetOrganizationLoadBalancersParams{
+				OrganizationSubDomain: &org,
+				Page:                  &page,
+				PerPage:               ptr(loadBalancersDataSourcePageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf(
+				"unexpected empty response listing load balancers on page %d", page,
+			)
+		}
+
+		pageLBs := res.JSON200.LoadBalancers
+		lbs = append(lbs, pageLBs...)
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(pageLBs),
+			loadBalancersDataSourcePageSize,
+		) {
+			break
+		}
+	}
+
+	sort.SliceStable(lbs, func(i, j int) bool {
+		iID, _ := nonNilString(lbs[i].Id)
+		jID, _ := nonNilString(lbs[j].Id)
+		return iID < jID
+	})
+
+	return lbs, nil
+}
@@ -0,0 +1,212 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func taggedObjectsTestHandler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+
+	vmTags := map[string]string{
+		"vm_a": `[{"id": "tag_web", "name": "web"}]`,
+		"vm_b": `[{"id": "tag_db", "name": "db"}]`,
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/organizations/organization/tags":
+			writeTestJSON(w, http.StatusOK, `{
+				"tags": [
+					{"id": "tag_web", "name": "web", "color": "blue"},
+					{"id": "tag_db", "name": "db", "color": "red"}
+				],
+				"pagination": {"current_page": 1, "total_pages": 1, "per_page": 200}
+			}`)
+		case "/organizations/organization/virtual_machines":
+			writeTestJSON(w, http.StatusOK, `{
+				"virtual_machines": [
+					{"id": "vm_b", "name": "DB", "hostname": "db"},
+					{"id": "vm_a", "name": "Web", "hostname": "web"}
+				],
+				"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+			}`)
+		case "/virtual_machines/virtual_machine":
+			id := r.URL.Query().Get("virtual_machine[id]")
+			tags, ok := vmTags[id]
+			require.True(t, ok, "unexpected virtual machine %q", id)
+
+			writeTestJSON(w, http.StatusOK, fmt.Sprintf(`{
+				"virtual_machine": {"id": %q, "tags": %s}
+			}`, id, tags))
+		case "/organizations/organization/load_balancers":
+			writeTestJSON(w, http.StatusOK, `{
+				"load_balancers": [
+					{
+						"id": "lbaas_vms",
+						"name": "VMs",
+						"resource_type": "virtual_machines",
+						"resource_ids": ["vm_a"]
+					},
+					{
+						"id": "lbaas_web",
+						"name": "Web",
+						"resource_type": "tags",
+						"resource_ids": ["tag_web"]
+					}
+				],
+				"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+			}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func TestTaggedObjectsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ds := &TaggedObjectsDataSource{M: &Meta{
+		Core:             newVirtualMachineTestClient(t, taggedObjectsTestHandler(t)),
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name      string
+		tagName   string
+		want      []TaggedObjectDataSourceModel
+		wantError string
+	}{
+		{
+			name:    "web",
+			tagName: "web",
+			want: []TaggedObjectDataSourceModel{
+				{
+					ObjectType: types.StringValue("virtual_machine"),
+					ID:         types.StringValue("vm_a"),
+					Name:       types.StringValue("Web"),
+				},
+				{
+					ObjectType: types.StringValue("load_balancer"),
+					ID:         types.StringValue("lbaas_web"),
+					Name:       types.StringValue("Web"),
+				},
+			},
+		},
+		{
+			name:    "db",
+			tagName: "db",
+			want: []TaggedObjectDataSourceModel{
+				{
+					ObjectType: types.StringValue("virtual_machine"),
+					ID:         types.StringValue("vm_b"),
+					Name:       types.StringValue("DB"),
+				},
+			},
+		},
+		{
+			name:      "missing",
+			tagName:   "missing",
+			wantError: "Tag Not Found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			diags := config.Set(
+				context.Background(), TaggedObjectsDataSourceModel{
+					TagID:   types.StringNull(),
+					TagName: types.StringValue(tt.tagName),
+				},
+			)
+			require.False(t, diags.HasError(), diags.Errors())
+
+			resp := datasource.ReadResponse{
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			ds.Read(
+				context.Background(),
+				datasource.ReadRequest{
+					Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+				},
+				&resp,
+			)
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(
+					t, tt.wantError, resp.Diagnostics.Errors()[0].Summary(),
+				)
+				return
+			}
+			require.False(
+				t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors(),
+			)
+
+			var got TaggedObjectsDataSourceModel
+			diags = resp.State.Get(context.Background(), &got)
+			require.False(t, diags.HasError(), diags.Errors())
+
+			assert.Equal(t, types.StringValue("tag_"+tt.tagName), got.TagID)
+			assert.Equal(t, tt.want, got.Objects)
+		})
+	}
+}
+
+func TestVirtualMachinesDataSourceReadTags(t *testing.T) {
+	t.Parallel()
+
+	ds := &VirtualMachinesDataSource{M: &Meta{
+		Core:             newVirtualMachineTestClient(t, taggedObjectsTestHandler(t)),
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), VirtualMachinesDataSourceModel{
+		Tags: types.SetValueMust(
+			types.StringType, []attr.Value{types.StringValue("web")},
+		),
+		TagIDs: types.SetNull(types.StringType),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got VirtualMachinesDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	require.Len(t, got.VirtualMachines, 1)
+	assert.Equal(t, types.StringValue("vm_a"), got.VirtualMachines[0].ID)
+}
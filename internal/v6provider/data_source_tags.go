@@ -7,7 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/krystal/go-katapult/next/core"
 )
 
 type (
@@ -16,7 +15,9 @@ type (
 	}
 
 	TagsDataSourceModel struct {
-		Tags types.List `tfsdk:"tags"`
+		Organization types.String            `tfsdk:"organization"`
+		Tags         types.List              `tfsdk:"tags"`
+		Filter       []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -56,6 +57,7 @@ func (r TagsDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"tags": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -76,6 +78,9 @@ func (r TagsDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -89,30 +94,8 @@ func (r TagsDataSource) Read(
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	tags := []core.GetOrganizationTags200ResponseTags{}
-	totalPages := 2
-	for page := 1; page <= totalPages; page++ {
-		res, err := r.M.Core.GetOrganizationTagsWithResponse(ctx,
-			&core.GetOrganizationTagsParams{
-				OrganizationSubDomain: &r.M.confOrganization,
-				Page:                  &page,
-				PerPage:               ptr(200),
-			})
-		if err != nil {
-			if res != nil {
-				err = genericAPIError(err, res.Body)
-			}
-
-			resp.Diagnostics.AddError("Tags Error", err.Error())
-			return
-		}
-
-		totalPages, _ = res.JSON200.Pagination.TotalPages.Get()
-
-		tags = append(tags, res.JSON200.Tags...)
-	}
 
-	attrs := make([]attr.Value, len(tags))
+	org := r.M.organization(data.Organization)
 
 	attrType := map[string]attr.Type{
 		"id":    types.StringType,
@@ -120,6 +103,22 @@ func (r TagsDataSource) Read(
 		"color": types.StringType,
 	}
 
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterAttrNames(attrType),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tags, err := fetchAllOrganizationTags(ctx, r.M, org)
+	if err != nil {
+		resp.Diagnostics.AddError("Tags Error", err.Error())
+		return
+	}
+
+	attrs := make([]attr.Value, len(tags))
+
 	for i, tag := range tags {
 		attrs[i] = types.ObjectValueMust(
 			attrType,
@@ -135,7 +134,7 @@ func (r TagsDataSource) Read(
 		types.ObjectType{
 			AttrTypes: attrType,
 		},
-		attrs,
+		filterDataSourceObjects(filters, attrs),
 	)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -233,7 +233,7 @@ func (d *VirtualMachineDataSource) Read(
 	vmRes, err := d.M.Core.GetVirtualMachineWithResponse(ctx, &params)
 	if err != nil {
 		if vmRes != nil {
-			err = genericAPIError(err, vmRes.Body)
+			err = genericAPIError(err, vmRes.Body, vmRes.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Read Error", err.Error())
 		return
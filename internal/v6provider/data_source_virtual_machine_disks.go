@@ -30,8 +30,9 @@ type (
 	}
 
 	VirtualMachineDisksDataSourceModel struct {
-		VirtualMachineID types.String `tfsdk:"virtual_machine_id"`
-		Disks            types.List   `tfsdk:"disks"`
+		VirtualMachineID types.String            `tfsdk:"virtual_machine_id"`
+		Disks            types.List              `tfsdk:"disks"`
+		Filter           []DataSourceFilterModel `tfsdk:"filter"`
 	}
 )
 
@@ -146,6 +147,9 @@ func (d *VirtualMachineDisksDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -160,6 +164,14 @@ func (d *VirtualMachineDisksDataSource) Read(
 		return
 	}
 
+	filters, diags := expandDataSourceFilters(
+		state.Filter, dataSourceFilterAttrNames(vmDiskAttrTypes),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vmID := state.VirtualMachineID.ValueString()
 
 	attachments, err := fetchAllVMDisks(ctx, d.M, vmID)
@@ -204,7 +216,9 @@ func (d *VirtualMachineDisksDataSource) Read(
 		elems = append(elems, obj)
 	}
 
-	diskList, diags := types.ListValue(diskObjType, elems)
+	diskList, diags := types.ListValue(
+		diskObjType, filterDataSourceObjects(filters, elems),
+	)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -264,7 +278,7 @@ func fetchDiskDetailsForAttachments(
 				&core.GetDiskParams{DiskId: &diskID})
 			if err != nil {
 				if res != nil {
-					err = genericAPIError(err, res.Body)
+					err = genericAPIError(err, res.Body, res.HTTPResponse)
 				}
 				return fmt.Errorf("fetching disk %s: %w", diskID, err)
 			}
@@ -102,7 +102,7 @@ func (d *VirtualMachineGroupDataSource) Read(
 			return
 		}
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Read Error", err.Error())
 		return
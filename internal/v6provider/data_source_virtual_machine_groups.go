@@ -15,8 +15,10 @@ type (
 	}
 
 	VirtualMachineGroupsDataSourceModel struct {
-		ID     types.String                         `tfsdk:"id"`
-		Groups []VirtualMachineGroupDataSourceModel `tfsdk:"groups"`
+		Organization types.String                         `tfsdk:"organization"`
+		ID           types.String                         `tfsdk:"id"`
+		Groups       []VirtualMachineGroupDataSourceModel `tfsdk:"groups"`
+		Filter       []DataSourceFilterModel              `tfsdk:"filter"`
 	}
 )
 
@@ -58,6 +60,7 @@ func (d *VirtualMachineGroupsDataSource) Schema(
 		MarkdownDescription: "Retrieve a list of all Virtual Machine Groups " +
 			"in the organization.",
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"id": schema.StringAttribute{
 				Computed: true,
 				MarkdownDescription: "Always set to the " +
@@ -89,6 +92,9 @@ func (d *VirtualMachineGroupsDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -103,13 +109,23 @@ func (d *VirtualMachineGroupsDataSource) Read(
 		return
 	}
 
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[VirtualMachineGroupDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := d.M.Core.GetOrganizationVirtualMachineGroupsWithResponse(ctx,
 		&core.GetOrganizationVirtualMachineGroupsParams{
-			OrganizationSubDomain: &d.M.confOrganization,
+			OrganizationSubDomain: &org,
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Read Error", err.Error())
 		return
@@ -128,8 +144,8 @@ func (d *VirtualMachineGroupsDataSource) Read(
 		})
 	}
 
-	data.ID = types.StringValue(d.M.confOrganization)
-	data.Groups = groups
+	data.ID = types.StringValue(org)
+	data.Groups = filterDataSourceModels(filters, groups)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,205 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+var _ datasource.DataSourceWithConfigValidators = (*VirtualMachinePackageDataSource)(nil)
+
+type (
+	VirtualMachinePackageDataSource struct {
+		M *Meta
+	}
+
+	VirtualMachinePackageDataSourceModel struct {
+		ID                            types.String `tfsdk:"id"`
+		Permalink                     types.String `tfsdk:"permalink"`
+		Name                          types.String `tfsdk:"name"`
+		CPUCores                      types.Int64  `tfsdk:"cpu_cores"`
+		DedicatedCPUs                 types.Bool   `tfsdk:"dedicated_cpus"`
+		IPv4Addresses                 types.Int64  `tfsdk:"ipv4_addresses"`
+		MemoryInGB                    types.Int64  `tfsdk:"memory_in_gb"`
+		StorageInGB                   types.Int64  `tfsdk:"storage_in_gb"`
+		MonthlyBandwidthAllowanceInGB types.Int64  `tfsdk:"monthly_bandwidth_allowance_in_gb"`
+		Privacy                       types.String `tfsdk:"privacy"`
+		GroupName                     types.String `tfsdk:"group_name"`
+	}
+)
+
+func (d *VirtualMachinePackageDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine_package"
+}
+
+func (d *VirtualMachinePackageDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func virtualMachinePackageSchemaAttributes(
+	selector bool,
+) map[string]schema.Attribute {
+	id := schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The unique identifier of the package.",
+	}
+	permalink := schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "The permalink of the package.",
+	}
+	if selector {
+		id.Optional = true
+		id.MarkdownDescription += " Takes precedence over `permalink`."
+		id.Validators = []validator.String{
+			stringValidatorNotEmpty(),
+		}
+		permalink.Optional = true
+		permalink.Validators = []validator.String{
+			stringValidatorNotEmpty(),
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"id":        id,
+		"permalink": permalink,
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the package.",
+		},
+		"cpu_cores": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of CPU cores.",
+		},
+		"dedicated_cpus": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the CPU cores are dedicated to the virtual machine.",
+		},
+		"ipv4_addresses": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The number of IPv4 addresses included.",
+		},
+		"memory_in_gb": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The amount of memory in GB.",
+		},
+		"storage_in_gb": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The amount of storage in GB.",
+		},
+		"monthly_bandwidth_allowance_in_gb": schema.Int64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The monthly bandwidth allowance in GB, or null when unmetered.",
+		},
+		"privacy": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The privacy of the package, e.g. `public`.",
+		},
+		"group_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the group the package belongs to.",
+		},
+	}
+}
+
+func (d *VirtualMachinePackageDataSource) ConfigValidators(
+	_ context.Context,
+) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("permalink"),
+		),
+	}
+}
+
+func (d *VirtualMachinePackageDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a virtual machine package by ID or permalink.",
+		Attributes:          virtualMachinePackageSchemaAttributes(true),
+	}
+}
+
+func (d *VirtualMachinePackageDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data VirtualMachinePackageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &core.GetVirtualMachinePackageParams{}
+	if !data.ID.IsNull() {
+		params.VirtualMachinePackageId = data.ID.ValueStringPointer()
+	} else {
+		params.VirtualMachinePackagePermalink = data.Permalink.ValueStringPointer()
+	}
+
+	res, err := d.M.Core.GetVirtualMachinePackageWithResponse(ctx, params)
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Virtual Machine Package Error", err.Error())
+		return
+	}
+
+	data = virtualMachinePackageDataSourceModel(
+		&res.JSON200.VirtualMachinePackage,
+	)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func virtualMachinePackageDataSourceModel(
+	pkg *core.VirtualMachinePackage,
+) VirtualMachinePackageDataSourceModel {
+	model := VirtualMachinePackageDataSourceModel{
+		ID:                            types.StringPointerValue(pkg.Id),
+		Permalink:                     types.StringPointerValue(pkg.Permalink),
+		Name:                          types.StringPointerValue(pkg.Name),
+		CPUCores:                      intPointerValue(pkg.CpuCores),
+		DedicatedCPUs:                 types.BoolPointerValue(pkg.UseDedicatedCpus),
+		IPv4Addresses:                 intPointerValue(pkg.Ipv4Addresses),
+		MemoryInGB:                    intPointerValue(pkg.MemoryInGb),
+		StorageInGB:                   intPointerValue(pkg.StorageInGb),
+		MonthlyBandwidthAllowanceInGB: nullableIntValue(pkg.MonthlyBandwidthAllowanceInGb),
+		Privacy:                       stringerPointerValue(pkg.Privacy),
+		GroupName:                     types.StringNull(),
+	}
+	if pkg.Group != nil {
+		model.GroupName = types.StringPointerValue(pkg.Group.Name)
+	}
+
+	return model
+}
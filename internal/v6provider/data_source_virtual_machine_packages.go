@@ -0,0 +1,196 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	core "github.com/krystal/go-katapult/next/core"
+)
+
+type (
+	VirtualMachinePackagesDataSource struct {
+		M *Meta
+	}
+
+	VirtualMachinePackagesDataSourceModel struct {
+		Organization   types.String                           `tfsdk:"organization"`
+		ID             types.String                           `tfsdk:"id"`
+		MinCPUCores    types.Int64                            `tfsdk:"min_cpu_cores"`
+		MinMemoryInGB  types.Int64                            `tfsdk:"min_memory_in_gb"`
+		MinStorageInGB types.Int64                            `tfsdk:"min_storage_in_gb"`
+		Packages       []VirtualMachinePackageDataSourceModel `tfsdk:"packages"`
+		Filter         []DataSourceFilterModel                `tfsdk:"filter"`
+	}
+)
+
+const virtualMachinePackagesPageSize = 100
+
+func (d *VirtualMachinePackagesDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine_packages"
+}
+
+func (d *VirtualMachinePackagesDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	resp *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	d.M = meta
+}
+
+func (d *VirtualMachinePackagesDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists virtual machine packages available to " +
+			"the provider organization, including its private packages.",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Always set to `all`.",
+			},
+			"min_cpu_cores": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return packages with at least this many CPU cores.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_memory_in_gb": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return packages with at least this much memory in GB.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"min_storage_in_gb": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Only return packages with at least this much storage in GB.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"packages": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Virtual machine packages matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: virtualMachinePackageSchemaAttributes(false),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
+	}
+}
+
+func (d *VirtualMachinePackagesDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var data VirtualMachinePackagesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[VirtualMachinePackageDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pkgs, err := fetchAllVirtualMachinePackages(ctx, d.M, org)
+	if err != nil {
+		resp.Diagnostics.AddError("Virtual Machine Packages Error", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue("all")
+	data.Packages = []VirtualMachinePackageDataSourceModel{}
+	for i := range pkgs {
+		model := virtualMachinePackageDataSourceModel(&pkgs[i])
+		if !int64AtLeast(model.CPUCores, data.MinCPUCores) ||
+			!int64AtLeast(model.MemoryInGB, data.MinMemoryInGB) ||
+			!int64AtLeast(model.StorageInGB, data.MinStorageInGB) {
+			continue
+		}
+
+		data.Packages = append(data.Packages, model)
+	}
+
+	data.Packages = filterDataSourceModels(filters, data.Packages)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// int64AtLeast reports whether value meets the minimum, treating a null
+// minimum as no constraint.
+func int64AtLeast(value types.Int64, minimum types.Int64) bool {
+	if minimum.IsNull() || minimum.IsUnknown() {
+		return true
+	}
+
+	return !value.IsNull() && value.ValueInt64() >= minimum.ValueInt64()
+}
+
+func fetchAllVirtualMachinePackages(
+	ctx context.Context,
+	m *Meta,
+	org string,
+) ([]core.VirtualMachinePackage, error) {
+	pkgs := []core.VirtualMachinePackage{}
+	for page := 1; ; page++ {
+		res, err := m.Core.GetVirtualMachinePackagesWithResponse(ctx,
+			&core.GetVirtualMachinePackagesParams{
+				OrganizationSubDomain: &org,
+				Page:                  &page,
+				PerPage:               ptr(virtualMachinePackagesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected empty response listing virtual machine packages on page %d", page)
+		}
+
+		pagePkgs := res.JSON200.VirtualMachinePackages
+		pkgs = append(pkgs, pagePkgs...)
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(pagePkgs),
+			virtualMachinePackagesPageSize,
+		) {
+			break
+		}
+	}
+
+	return pkgs, nil
+}
@@ -0,0 +1,104 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualMachinePackagesDataSourceReadFilters(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, "/virtual_machine_packages", r.URL.Path)
+		assert.Equal(
+			t, "test-org", r.URL.Query().Get("organization[sub_domain]"),
+		)
+
+		writeTestJSON(w, http.StatusOK, `{
+			"virtual_machine_packages": [
+				{
+					"id": "vmpkg_small",
+					"name": "Small",
+					"permalink": "small",
+					"cpu_cores": 1,
+					"memory_in_gb": 2,
+					"storage_in_gb": 20
+				},
+				{
+					"id": "vmpkg_large",
+					"name": "Large",
+					"permalink": "large",
+					"cpu_cores": 8,
+					"memory_in_gb": 32,
+					"storage_in_gb": 200,
+					"ipv4_addresses": 1,
+					"use_dedicated_cpus": true,
+					"monthly_bandwidth_allowance_in_gb": 5000,
+					"privacy": "public",
+					"group": {"id": "vmpkggrp_test", "name": "General"}
+				}
+			],
+			"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+		}`)
+	})
+	ds := &VirtualMachinePackagesDataSource{M: &Meta{
+		Core:             client,
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	diags := config.Set(context.Background(), VirtualMachinePackagesDataSourceModel{
+		ID:             types.StringNull(),
+		MinCPUCores:    types.Int64Value(2),
+		MinMemoryInGB:  types.Int64Null(),
+		MinStorageInGB: types.Int64Value(100),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	resp := datasource.ReadResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	ds.Read(
+		context.Background(),
+		datasource.ReadRequest{
+			Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got VirtualMachinePackagesDataSourceModel
+	diags = resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, types.StringValue("all"), got.ID)
+	assert.Equal(t, []VirtualMachinePackageDataSourceModel{
+		{
+			ID:                            types.StringValue("vmpkg_large"),
+			Permalink:                     types.StringValue("large"),
+			Name:                          types.StringValue("Large"),
+			CPUCores:                      types.Int64Value(8),
+			DedicatedCPUs:                 types.BoolValue(true),
+			IPv4Addresses:                 types.Int64Value(1),
+			MemoryInGB:                    types.Int64Value(32),
+			StorageInGB:                   types.Int64Value(200),
+			MonthlyBandwidthAllowanceInGB: types.Int64Value(5000),
+			Privacy:                       types.StringValue("public"),
+			GroupName:                     types.StringValue("General"),
+		},
+	}, got.Packages)
+}
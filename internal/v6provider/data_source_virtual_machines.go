@@ -23,7 +23,11 @@ type (
 	}
 
 	VirtualMachinesDataSourceModel struct {
+		Organization    types.String                           `tfsdk:"organization"`
+		Tags            types.Set                              `tfsdk:"tags"`
+		TagIDs          types.Set                              `tfsdk:"tag_ids"`
 		VirtualMachines []VirtualMachineSummaryDataSourceModel `tfsdk:"virtual_machines"`
+		Filter          []DataSourceFilterModel                `tfsdk:"filter"`
 	}
 
 	VirtualMachineSummaryDataSourceModel struct {
@@ -70,6 +74,13 @@ func (d *VirtualMachinesDataSource) Schema(
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Lists Virtual Machines belonging to the provider organization.",
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
+			"tags": tagSelectionNamesAttribute(
+				"Only Virtual Machines carrying the tags are returned.",
+			),
+			"tag_ids": tagSelectionIDsAttribute(
+				"Only Virtual Machines carrying the tags are returned.",
+			),
 			"virtual_machines": schema.ListNestedAttribute{
 				Computed:            true,
 				MarkdownDescription: "Virtual Machines ordered lexically by ID.",
@@ -104,6 +115,9 @@ func (d *VirtualMachinesDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -118,31 +132,55 @@ func (d *VirtualMachinesDataSource) Read(
 		return
 	}
 
-	virtualMachines, err := fetchAllOrganizationVirtualMachines(ctx, d.M)
+	org := d.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[VirtualMachineSummaryDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selection, diags := expandTagSelection(ctx, d.M, org, data.Tags, data.TagIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	virtualMachines, err := fetchAllOrganizationVirtualMachines(ctx, d.M, org)
+	if err == nil {
+		virtualMachines, err = selectTaggedVirtualMachines(
+			ctx, d.M, selection, virtualMachines,
+		)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Virtual Machines Error", err.Error())
 		return
 	}
 
-	data.VirtualMachines = virtualMachineSummaryDataSourceModels(virtualMachines)
+	data.VirtualMachines = filterDataSourceModels(
+		filters, virtualMachineSummaryDataSourceModels(virtualMachines),
+	)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func fetchAllOrganizationVirtualMachines(
 	ctx context.Context,
 	m *Meta,
+	org string,
 ) ([]core.GetOrganizationVirtualMachines200ResponseVirtualMachines, error) {
 	virtualMachines := []core.GetOrganizationVirtualMachines200ResponseVirtualMachines{}
 	for page := 1; ; page++ {
 		res, err := m.Core.GetOrganizationVirtualMachinesWithResponse(ctx,
 			&core.GetOrganizationVirtualMachinesParams{
-				OrganizationSubDomain: &m.confOrganization,
+				OrganizationSubDomain: &org,
 				Page:                  &page,
 				PerPage:               ptr(virtualMachinesDataSourcePageSize),
 			})
 		if err != nil {
 			if res != nil {
-				err = genericAPIError(err, res.Body)
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 			return nil, err
 		}
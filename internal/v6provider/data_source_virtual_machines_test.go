@@ -56,7 +56,8 @@ func TestFetchAllOrganizationVirtualMachinesPaginationRequestAndSorting(t *testi
 
 	virtualMachines, err := fetchAllOrganizationVirtualMachines(
 		context.Background(),
-		&Meta{Core: client, confOrganization: "test-org"},
+		&Meta{Core: client},
+		"test-org",
 	)
 	require.NoError(t, err)
 	require.Len(t, virtualMachines, 2)
@@ -77,7 +78,7 @@ func TestFetchAllOrganizationVirtualMachinesEmptyAndErrors(t *testing.T) {
 			}`)
 		})
 		virtualMachines, err := fetchAllOrganizationVirtualMachines(
-			context.Background(), &Meta{Core: client},
+			context.Background(), &Meta{Core: client}, "test-org",
 		)
 		require.NoError(t, err)
 		assert.NotNil(t, virtualMachines)
@@ -115,7 +116,7 @@ func TestFetchAllOrganizationVirtualMachinesEmptyAndErrors(t *testing.T) {
 				_, _ = w.Write([]byte(test.body))
 			})
 			_, err := fetchAllOrganizationVirtualMachines(
-				context.Background(), &Meta{Core: client},
+				context.Background(), &Meta{Core: client}, "test-org",
 			)
 			require.ErrorContains(t, err, test.want)
 		})
@@ -98,7 +98,7 @@ func (ds *VirtualNetworkDataSource) Read(
 	)
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Virtual Networks Error", err.Error())
@@ -14,7 +14,9 @@ type VirtualNetworksDataSource struct {
 }
 
 type VirtualNetworksDataSourceModel struct {
+	Organization    types.String                    `tfsdk:"organization"`
 	VirtualNetworks []VirtualNetworkDataSourceModel `tfsdk:"virtual_networks"`
+	Filter          []DataSourceFilterModel         `tfsdk:"filter"`
 }
 
 func (nds VirtualNetworksDataSource) Metadata(
@@ -53,6 +55,7 @@ func (nds *VirtualNetworksDataSource) Schema(
 ) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationDataSourceAttribute(),
 			"virtual_networks": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -74,6 +77,9 @@ func (nds *VirtualNetworksDataSource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"filter": dataSourceFilterBlock(),
+		},
 	}
 }
 
@@ -88,15 +94,25 @@ func (nds *VirtualNetworksDataSource) Read(
 		return
 	}
 
+	org := nds.M.organization(data.Organization)
+
+	filters, diags := expandDataSourceFilters(
+		data.Filter, dataSourceFilterNames[VirtualNetworkDataSourceModel](),
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	res, err := nds.M.Core.GetOrganizationVirtualNetworksWithResponse(
 		ctx,
 		&core.GetOrganizationVirtualNetworksParams{
-			OrganizationSubDomain: &nds.M.confOrganization,
+			OrganizationSubDomain: &org,
 		},
 	)
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Virtual Networks Error", err.Error())
@@ -121,6 +137,6 @@ func (nds *VirtualNetworksDataSource) Read(
 		list[i] = model
 	}
 
-	data.VirtualNetworks = list
+	data.VirtualNetworks = filterDataSourceModels(filters, list)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,158 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+// providerDefaultTags returns the sorted, de-duplicated tag names of the
+// provider's default_tags block.
+func providerDefaultTags(
+	ctx context.Context,
+	list types.List,
+) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if list.IsNull() || list.IsUnknown() {
+		return nil, diags
+	}
+
+	blocks := []KatapultProviderDefaultTagsModel{}
+	diags.Append(list.ElementsAs(ctx, &blocks, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tags := []string{}
+	for _, block := range blocks {
+		if block.Tags.IsUnknown() {
+			diags.AddError(
+				"Unknown Default Tags",
+				"default_tags must be known when the provider is configured.",
+			)
+			return nil, diags
+		}
+
+		names, d := stringSetValueStrings(ctx, "default_tags", block.Tags)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		tags = append(tags, names...)
+	}
+
+	slices.Sort(tags)
+
+	return slices.Compact(tags), diags
+}
+
+// ensureDefaultTags creates any of the provider's default tags which do not
+// yet exist in org. The API only accepts names of existing tags.
+func (m *Meta) ensureDefaultTags(ctx context.Context, org string) error {
+	if len(m.DefaultTags) == 0 {
+		return nil
+	}
+
+	m.defaultTagsMu.Lock()
+	defer m.defaultTagsMu.Unlock()
+
+	if m.defaultTagsEnsured[org] {
+		return nil
+	}
+
+	existing, err := fetchAllOrganizationTags(ctx, m, org)
+	if err != nil {
+		return fmt.Errorf("listing tags: %w", err)
+	}
+
+	for _, name := range m.DefaultTags {
+		found := slices.ContainsFunc(existing,
+			func(tag core.GetOrganizationTags200ResponseTags) bool {
+				return tag.Name != nil && *tag.Name == name
+			},
+		)
+		if found {
+			continue
+		}
+
+		res, err := m.Core.PostOrganizationTagsWithResponse(ctx,
+			core.PostOrganizationTagsJSONRequestBody{
+				Organization: core.OrganizationLookup{
+					SubDomain: &org,
+				},
+				Properties: core.TagArguments{Name: ptr(name)},
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return fmt.Errorf("creating default tag %q: %w", name, err)
+		}
+	}
+
+	if m.defaultTagsEnsured == nil {
+		m.defaultTagsEnsured = map[string]bool{}
+	}
+	m.defaultTagsEnsured[org] = true
+
+	return nil
+}
+
+// mergeDefaultTags returns tags combined with the provider's default tags,
+// which is the set of tags Katapult will hold for the resource. The result is
+// unknown when tags is, and tags itself when there are no defaults.
+func mergeDefaultTags(
+	ctx context.Context,
+	tags types.Set,
+	defaults []string,
+) (types.Set, diag.Diagnostics) {
+	if len(defaults) == 0 {
+		return tags, nil
+	}
+	if tags.IsUnknown() {
+		return types.SetUnknown(types.StringType), nil
+	}
+
+	names, diags := stringSetValueStrings(ctx, "tags", tags)
+	if diags.HasError() {
+		return types.SetNull(types.StringType), diags
+	}
+
+	names = append(names, defaults...)
+	slices.Sort(names)
+
+	return buildStringSet(slices.Compact(names)), diags
+}
+
+// splitDefaultTags returns the part of the remote tags which belongs in the
+// configurable tags attribute. Tags supplied only by the provider's defaults
+// are left out so they do not show up as drift; a default tag which was also
+// configured on the resource is kept.
+func splitDefaultTags(
+	ctx context.Context,
+	prior types.Set,
+	remote []string,
+	defaults []string,
+) types.Set {
+	configured := []string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		// State never holds unknown values, the only conversion error.
+		configured, _ = stringSetValueStrings(ctx, "tags", prior)
+	}
+
+	values := make([]attr.Value, 0, len(remote))
+	for _, name := range remote {
+		if slices.Contains(defaults, name) &&
+			!slices.Contains(configured, name) {
+			continue
+		}
+		values = append(values, types.StringValue(name))
+	}
+
+	return types.SetValueMust(types.StringType, values)
+}
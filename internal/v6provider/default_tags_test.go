@@ -0,0 +1,180 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderDefaultTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	blockType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"tags": types.SetType{ElemType: types.StringType},
+	}}
+
+	tests := []struct {
+		name string
+		list types.List
+		want []string
+	}{
+		{
+			name: "null",
+			list: types.ListNull(blockType),
+			want: nil,
+		},
+		{
+			name: "null tags",
+			list: types.ListValueMust(blockType, []attr.Value{
+				types.ObjectValueMust(blockType.AttrTypes, map[string]attr.Value{
+					"tags": types.SetNull(types.StringType),
+				}),
+			}),
+			want: []string{},
+		},
+		{
+			name: "sorted",
+			list: types.ListValueMust(blockType, []attr.Value{
+				types.ObjectValueMust(blockType.AttrTypes, map[string]attr.Value{
+					"tags": buildStringSet([]string{"team", "env"}),
+				}),
+			}),
+			want: []string{"env", "team"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := providerDefaultTags(ctx, tt.list)
+			require.False(t, diags.HasError(), diags.Errors())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		tags     types.Set
+		defaults []string
+		want     types.Set
+	}{
+		{
+			name: "no defaults",
+			tags: types.SetNull(types.StringType),
+			want: types.SetNull(types.StringType),
+		},
+		{
+			name:     "unknown tags",
+			tags:     types.SetUnknown(types.StringType),
+			defaults: []string{"env"},
+			want:     types.SetUnknown(types.StringType),
+		},
+		{
+			name:     "null tags",
+			tags:     types.SetNull(types.StringType),
+			defaults: []string{"env"},
+			want:     buildStringSet([]string{"env"}),
+		},
+		{
+			name:     "overlapping",
+			tags:     buildStringSet([]string{"web", "env"}),
+			defaults: []string{"env", "team"},
+			want:     buildStringSet([]string{"env", "team", "web"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := mergeDefaultTags(ctx, tt.tags, tt.defaults)
+			require.False(t, diags.HasError(), diags.Errors())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplitDefaultTags(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	defaults := []string{"env", "team"}
+
+	tests := []struct {
+		name   string
+		prior  types.Set
+		remote []string
+		want   types.Set
+	}{
+		{
+			name:   "import",
+			prior:  types.SetNull(types.StringType),
+			remote: []string{"env", "team", "web"},
+			want:   buildStringSet([]string{"web"}),
+		},
+		{
+			name:   "default also configured",
+			prior:  buildStringSet([]string{"env", "web"}),
+			remote: []string{"env", "team", "web"},
+			want:   buildStringSet([]string{"env", "web"}),
+		},
+		{
+			name:   "tag added outside terraform",
+			prior:  buildStringSet([]string{"web"}),
+			remote: []string{"db", "team", "web"},
+			want:   buildStringSet([]string{"db", "web"}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := splitDefaultTags(ctx, tt.prior, tt.remote, defaults)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMetaEnsureDefaultTags(t *testing.T) {
+	t.Parallel()
+	created := []string{}
+	client := newVirtualMachineTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/organizations/organization/tags", req.URL.Path)
+		switch req.Method {
+		case http.MethodGet:
+			writeTestJSON(w, http.StatusOK, `{
+				"pagination": {"current_page": 1, "total_pages": 1},
+				"tags": [{"id": "tag_env", "name": "env"}]
+			}`)
+		case http.MethodPost:
+			var body struct {
+				Properties struct {
+					Name string `json:"name"`
+				} `json:"properties"`
+			}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+			created = append(created, body.Properties.Name)
+			writeTestJSON(w, http.StatusOK, `{"tag": {"id": "tag_new"}}`)
+		default:
+			http.NotFound(w, req)
+		}
+	})
+	m := &Meta{
+		Core:             client,
+		DefaultTags:      []string{"env", "team"},
+		confOrganization: "acme",
+	}
+
+	require.NoError(t, m.ensureDefaultTags(context.Background(), "acme"))
+	require.NoError(t, m.ensureDefaultTags(context.Background(), "acme"))
+	assert.Equal(t, []string{"team"}, created)
+}
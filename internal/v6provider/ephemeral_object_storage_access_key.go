@@ -0,0 +1,264 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+const objectStorageAccessKeyMintedIDPrivateKey = "minted_access_key_id"
+
+var (
+	_ ephemeral.EphemeralResourceWithConfigure = (*ObjectStorageAccessKeyEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*ObjectStorageAccessKeyEphemeralResource)(nil)
+)
+
+type (
+	ObjectStorageAccessKeyEphemeralResource struct {
+		M *Meta
+	}
+
+	ObjectStorageAccessKeyEphemeralResourceModel struct {
+		ID              types.String   `tfsdk:"id"`
+		Name            types.String   `tfsdk:"name"`
+		Region          types.String   `tfsdk:"region"`
+		Organization    types.String   `tfsdk:"organization"`
+		AllBucketsRead  types.Bool     `tfsdk:"all_buckets_read"`
+		AllObjectsRead  types.Bool     `tfsdk:"all_objects_read"`
+		AllObjectsWrite types.Bool     `tfsdk:"all_objects_write"`
+		AccessKeyID     types.String   `tfsdk:"access_key_id"`
+		SecretAccessKey types.String   `tfsdk:"secret_access_key"`
+		ServerURL       types.String   `tfsdk:"server_url"`
+		Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	}
+)
+
+func (e *ObjectStorageAccessKeyEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_object_storage_access_key"
+}
+
+func (e *ObjectStorageAccessKeyEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	e.M = meta
+}
+
+func (e *ObjectStorageAccessKeyEphemeralResource) Schema(
+	ctx context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: strings.TrimSpace(`
+Provides object storage credentials without storing the secret in Terraform
+state or plan files.
+
+A temporary access key is created each time Terraform opens the ephemeral
+resource, and deleted again when Terraform no longer needs it.
+`),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Internal Katapult ID of the temporary access key.",
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "Name of a temporary access key to " +
+					"create. The key is deleted when the ephemeral resource " +
+					"is closed.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			organizationAttributeName: schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Sub-domain of the organization to " +
+					"create the temporary access key in. Defaults to the " +
+					"provider's `organization`.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			objectStorageRegionAttributeName: schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "Object storage region in which to " +
+					"create the temporary access key. Currently the only " +
+					"available region is `uk-lon-1`.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"all_buckets_read": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Allow the temporary access key to list " +
+					"all buckets in the cluster. Defaults to `false`.",
+			},
+			"all_objects_read": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Allow the temporary access key to read " +
+					"objects across all buckets in the cluster. Defaults " +
+					"to `false`.",
+			},
+			"all_objects_write": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Allow the temporary access key to " +
+					"write objects across all buckets in the cluster. " +
+					"Defaults to `false`.",
+			},
+			"access_key_id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Access key ID for " +
+					"authenticating object storage clients.",
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret access key.",
+			},
+			"server_url": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Endpoint URL for " +
+					"configuring object storage clients.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+func (e *ObjectStorageAccessKeyEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data ObjectStorageAccessKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := data.Timeouts.Open(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keyID, err := createObjectStorageAccessKey(
+		ctx, e.M, e.M.organization(data.Organization),
+		data.Region.ValueString(),
+		core.ObjectStorageAccessKeyArguments{
+			Name:            data.Name.ValueString(),
+			AllBucketsRead:  ptr(data.AllBucketsRead.ValueBool()),
+			AllObjectsRead:  ptr(data.AllObjectsRead.ValueBool()),
+			AllObjectsWrite: ptr(data.AllObjectsWrite.ValueBool()),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Object Storage Access Key Create Error",
+			err.Error(),
+		)
+		return
+	}
+
+	credentials, err := generateObjectStorageAccessKeyCredentials(
+		ctx, e.M, keyID, timeout,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Object Storage Access Key Credentials Error",
+			err.Error(),
+		)
+
+		// Close is not called after a failed Open, so the temporary key has
+		// to be removed here.
+		if delErr := deleteObjectStorageAccessKey(
+			ctx, e.M, keyID,
+		); delErr != nil {
+			resp.Diagnostics.AddError(
+				"Object Storage Access Key Delete Error",
+				delErr.Error(),
+			)
+		}
+		return
+	}
+
+	encodedKeyID, err := json.Marshal(keyID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to encode object storage access key ID.",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(
+		ctx, objectStorageAccessKeyMintedIDPrivateKey, encodedKeyID,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(keyID)
+	data.AccessKeyID = types.StringValue(credentials.S3AccessKeyId.MustGet())
+	data.SecretAccessKey = types.StringValue(
+		credentials.S3SecretAccessKey.MustGet(),
+	)
+	data.ServerURL = types.StringValue(credentials.ServerUrl.MustGet())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *ObjectStorageAccessKeyEphemeralResource) Close(
+	ctx context.Context,
+	req ephemeral.CloseRequest,
+	resp *ephemeral.CloseResponse,
+) {
+	raw, diags := req.Private.GetKey(
+		ctx, objectStorageAccessKeyMintedIDPrivateKey,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(raw) == 0 {
+		return
+	}
+
+	var keyID string
+	if err := json.Unmarshal(raw, &keyID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Object Storage Access Key Private State",
+			err.Error(),
+		)
+		return
+	}
+
+	if err := deleteObjectStorageAccessKey(ctx, e.M, keyID); err != nil {
+		resp.Diagnostics.AddError(
+			"Object Storage Access Key Delete Error",
+			err.Error(),
+		)
+	}
+}
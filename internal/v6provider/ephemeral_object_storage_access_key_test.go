@@ -0,0 +1,174 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/ephemeral/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/require"
+)
+
+func objectStorageAccessKeyEphemeralTestHandler(
+	credentialStatus int,
+	createCalls *atomic.Int32,
+	deleteCalls *atomic.Int32,
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL.Path {
+		case "/core/v1/organizations/organization/object_storage/" +
+			"object_storage_cluster/access_keys":
+			createCalls.Add(1)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{
+				"object_storage_access_key": {
+					"id": "objkey_temporary",
+					"name": "temporary-key",
+					"region": "uk-lon-1"
+				}
+			}`))
+		case "/core/v1/object_storage/access_keys/access_key/" +
+			"generate_credentials":
+			w.WriteHeader(credentialStatus)
+			if credentialStatus != http.StatusOK {
+				_, _ = w.Write([]byte(`{
+					"error": {
+						"code": "credential_generation_failed",
+						"description": "injected credential failure",
+						"detail": {}
+					}
+				}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{
+				"object_storage_access_key": {
+					"s3_access_key_id": "generated-access-key",
+					"s3_secret_access_key": "generated-secret",
+					"server_url": "https://objects.example.test"
+				}
+			}`))
+		case "/core/v1/object_storage/access_keys/access_key":
+			if req.Method != http.MethodDelete {
+				http.Error(w, "unexpected method", http.StatusInternalServerError)
+				return
+			}
+			deleteCalls.Add(1)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.Error(w, "unexpected request: "+req.URL.Path,
+				http.StatusInternalServerError)
+		}
+	}
+}
+
+func objectStorageAccessKeyEphemeralOpen(
+	t *testing.T,
+	e *ObjectStorageAccessKeyEphemeralResource,
+	model ObjectStorageAccessKeyEphemeralResourceModel,
+) (ephemeral.OpenResponse, ephemeral.CloseRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, config.Set(ctx, &model).HasError())
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw},
+	}
+	resp := ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema},
+	}
+	closeReq := ephemeral.CloseRequest{}
+	initializeResourcePrivateState(t, &closeReq, &resp)
+
+	e.Open(ctx, req, &resp)
+
+	return resp, closeReq
+}
+
+func TestObjectStorageAccessKeyEphemeralResourceTemporaryKey(t *testing.T) {
+	var createCalls, deleteCalls atomic.Int32
+	meta := newObjectStorageFailureTestMeta(t,
+		objectStorageAccessKeyEphemeralTestHandler(
+			http.StatusOK, &createCalls, &deleteCalls,
+		),
+	)
+	e := &ObjectStorageAccessKeyEphemeralResource{M: meta}
+
+	resp, closeReq := objectStorageAccessKeyEphemeralOpen(t, e,
+		ObjectStorageAccessKeyEphemeralResourceModel{
+			ID:              types.StringNull(),
+			Name:            types.StringValue("temporary-key"),
+			Region:          types.StringValue("uk-lon-1"),
+			AllBucketsRead:  types.BoolValue(true),
+			AllObjectsRead:  types.BoolNull(),
+			AllObjectsWrite: types.BoolNull(),
+			AccessKeyID:     types.StringNull(),
+			SecretAccessKey: types.StringNull(),
+			ServerURL:       types.StringNull(),
+			Timeouts:        nullEphemeralTimeouts(),
+		},
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.Equal(t, int32(1), createCalls.Load())
+
+	var result ObjectStorageAccessKeyEphemeralResourceModel
+	require.False(t, resp.Result.Get(context.Background(), &result).HasError())
+	require.Equal(t, "objkey_temporary", result.ID.ValueString())
+	require.Equal(t, "generated-access-key", result.AccessKeyID.ValueString())
+	require.Equal(t, "generated-secret", result.SecretAccessKey.ValueString())
+	require.Equal(t, "https://objects.example.test", result.ServerURL.ValueString())
+
+	var closeResp ephemeral.CloseResponse
+	e.Close(context.Background(), closeReq, &closeResp)
+	require.False(t, closeResp.Diagnostics.HasError(),
+		closeResp.Diagnostics.Errors())
+	require.Equal(t, int32(1), deleteCalls.Load())
+}
+
+func TestObjectStorageAccessKeyEphemeralResourceDeletesKeyWhenCredentialsFail(
+	t *testing.T,
+) {
+	var createCalls, deleteCalls atomic.Int32
+	meta := newObjectStorageFailureTestMeta(t,
+		objectStorageAccessKeyEphemeralTestHandler(
+			http.StatusBadRequest, &createCalls, &deleteCalls,
+		),
+	)
+	e := &ObjectStorageAccessKeyEphemeralResource{M: meta}
+
+	resp, _ := objectStorageAccessKeyEphemeralOpen(t, e,
+		ObjectStorageAccessKeyEphemeralResourceModel{
+			ID:              types.StringNull(),
+			Name:            types.StringValue("temporary-key"),
+			Region:          types.StringValue("uk-lon-1"),
+			AllBucketsRead:  types.BoolNull(),
+			AllObjectsRead:  types.BoolNull(),
+			AllObjectsWrite: types.BoolNull(),
+			AccessKeyID:     types.StringNull(),
+			SecretAccessKey: types.StringNull(),
+			ServerURL:       types.StringNull(),
+			Timeouts:        nullEphemeralTimeouts(),
+		},
+	)
+
+	requireDiagnosticContains(t, resp.Diagnostics,
+		"Object Storage Access Key Credentials Error")
+	require.Equal(t, int32(1), createCalls.Load())
+	require.Equal(t, int32(1), deleteCalls.Load())
+}
+
+func nullEphemeralTimeouts() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+		"open": types.StringType,
+	})}
+}
@@ -0,0 +1,118 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = (*VirtualMachineInitialPasswordEphemeralResource)(nil)
+
+type (
+	VirtualMachineInitialPasswordEphemeralResource struct {
+		M *Meta
+	}
+
+	VirtualMachineInitialPasswordEphemeralResourceModel struct {
+		ID                  types.String `tfsdk:"id"`
+		InitialRootPassword types.String `tfsdk:"initial_root_password"`
+	}
+)
+
+func (e *VirtualMachineInitialPasswordEphemeralResource) Metadata(
+	_ context.Context,
+	req ephemeral.MetadataRequest,
+	resp *ephemeral.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName +
+		"_virtual_machine_initial_password"
+}
+
+func (e *VirtualMachineInitialPasswordEphemeralResource) Configure(
+	_ context.Context,
+	req ephemeral.ConfigureRequest,
+	resp *ephemeral.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	e.M = meta
+}
+
+func (e *VirtualMachineInitialPasswordEphemeralResource) Schema(
+	_ context.Context,
+	_ ephemeral.SchemaRequest,
+	resp *ephemeral.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the initial root password of a " +
+			"Virtual Machine without storing it in Terraform state or " +
+			"plan files.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Virtual Machine.",
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+			},
+			"initial_root_password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The initial root password.",
+			},
+		},
+	}
+}
+
+func (e *VirtualMachineInitialPasswordEphemeralResource) Open(
+	ctx context.Context,
+	req ephemeral.OpenRequest,
+	resp *ephemeral.OpenResponse,
+) {
+	var data VirtualMachineInitialPasswordEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := e.M.Core.GetVirtualMachineWithResponse(ctx,
+		&core.GetVirtualMachineParams{
+			VirtualMachineId: data.ID.ValueStringPointer(),
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	password, err := res.JSON200.VirtualMachine.InitialRootPassword.Get()
+	if err != nil || password == "" {
+		resp.Diagnostics.AddError(
+			"Initial Root Password Unavailable",
+			fmt.Sprintf(
+				"Virtual Machine %s has no initial root password available.",
+				data.ID.ValueString(),
+			),
+		)
+		return
+	}
+
+	data.InitialRootPassword = types.StringValue(password)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
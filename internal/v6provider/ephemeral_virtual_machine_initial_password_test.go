@@ -0,0 +1,102 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVirtualMachineInitialPasswordEphemeralResourceOpen(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		password  string
+		want      string
+		wantError string
+	}{
+		{
+			name:     "password",
+			password: `"s3cret"`,
+			want:     "s3cret",
+		},
+		{
+			name:      "null password",
+			password:  `null`,
+			wantError: "Initial Root Password Unavailable",
+		},
+		{
+			name:      "empty password",
+			password:  `""`,
+			wantError: "Initial Root Password Unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+
+			client := newVirtualMachineTestClient(t, func(
+				w http.ResponseWriter,
+				r *http.Request,
+			) {
+				require.Equal(t, "/virtual_machines/virtual_machine", r.URL.Path)
+				require.Equal(t, "vm_abc",
+					r.URL.Query().Get("virtual_machine[id]"))
+
+				writeTestJSON(w, http.StatusOK, fmt.Sprintf(`{
+					"virtual_machine": {
+						"id": "vm_abc",
+						"initial_root_password": %s
+					}
+				}`, tt.password))
+			})
+			e := &VirtualMachineInitialPasswordEphemeralResource{
+				M: &Meta{Core: client, testMode: true},
+			}
+
+			var schemaResp ephemeral.SchemaResponse
+			e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			diags := config.Set(ctx,
+				&VirtualMachineInitialPasswordEphemeralResourceModel{
+					ID:                  types.StringValue("vm_abc"),
+					InitialRootPassword: types.StringNull(),
+				},
+			)
+			require.False(t, diags.HasError(), diags.Errors())
+
+			resp := ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema},
+			}
+			e.Open(ctx, ephemeral.OpenRequest{
+				Config: tfsdk.Config{Schema: config.Schema, Raw: config.Raw},
+			}, &resp)
+
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(
+					t, tt.wantError, resp.Diagnostics.Errors()[0].Summary(),
+				)
+				return
+			}
+			require.False(
+				t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors(),
+			)
+
+			var got VirtualMachineInitialPasswordEphemeralResourceModel
+			diags = resp.Result.Get(ctx, &got)
+			require.False(t, diags.HasError(), diags.Errors())
+			assert.Equal(t, tt.want, got.InitialRootPassword.ValueString())
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*BuildImportIDFunction)(nil)
+
+// importIDBuilders maps resource types with composite import IDs to the
+// attributes their import ID is made of and a function assembling it. Values
+// of optional attributes follow those of required ones, and are empty when
+// not given.
+var importIDBuilders = map[string]struct {
+	attributes []string
+	optional   []string
+	build      func(values []string) string
+}{
+	"katapult_disk_assignment": {
+		attributes: []string{"virtual_machine_id", "disk_id"},
+		build: func(values []string) string {
+			return assignmentID(values[0], values[1])
+		},
+	},
+	"katapult_object_storage_account": {
+		attributes: []string{objectStorageRegionAttributeName},
+		optional:   []string{organizationAttributeName},
+		build: func(values []string) string {
+			return objectStorageAccountImportID(values[1], values[0])
+		},
+	},
+	"katapult_object_storage_bucket": {
+		attributes: []string{"name", objectStorageRegionAttributeName},
+		build: func(values []string) string {
+			return objectStorageBucketImportID(values[0], values[1])
+		},
+	},
+}
+
+type BuildImportIDFunction struct{}
+
+func (f *BuildImportIDFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "build_import_id"
+}
+
+func (f *BuildImportIDFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Builds the import ID of a resource with a composite " +
+			"import ID.",
+		MarkdownDescription: "Builds the import ID expected by " +
+			"`terraform import` and `import` blocks from resource " +
+			"attributes. Supported resource types are " +
+			"`katapult_disk_assignment` (`virtual_machine_id`, `disk_id`), " +
+			"`katapult_object_storage_account` (`region`, optionally " +
+			"`organization`) and " +
+			"`katapult_object_storage_bucket` (`name`, `region`). Other " +
+			"resources are imported by their `id`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "The resource type, e.g. `katapult_object_storage_bucket`.",
+			},
+			function.MapParameter{
+				Name:                "attributes",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The resource attributes making up the import ID.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildImportIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var resourceType string
+	var attributes map[string]string
+	resp.Error = req.Arguments.Get(ctx, &resourceType, &attributes)
+	if resp.Error != nil {
+		return
+	}
+
+	builder, ok := importIDBuilders[resourceType]
+	if !ok {
+		supported := make([]string, 0, len(importIDBuilders))
+		for t := range importIDBuilders {
+			supported = append(supported, t)
+		}
+		slices.Sort(supported)
+
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf(
+			"unsupported resource type %q, supported types are: %s",
+			resourceType, strings.Join(supported, ", "),
+		))
+		return
+	}
+
+	values := make([]string, 0, len(builder.attributes)+len(builder.optional))
+	for _, name := range builder.attributes {
+		if attributes[name] == "" {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf(
+				"%s import IDs require the %q attribute",
+				resourceType, name,
+			))
+			return
+		}
+		values = append(values, attributes[name])
+	}
+	for _, name := range builder.optional {
+		values = append(values, attributes[name])
+	}
+
+	resp.Error = resp.Result.Set(ctx, builder.build(values))
+}
@@ -0,0 +1,97 @@
+package v6provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildImportIDFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		resourceType string
+		attributes   map[string]string
+		want         string
+		wantError    string
+	}{
+		{
+			name:         "disk assignment",
+			resourceType: "katapult_disk_assignment",
+			attributes: map[string]string{
+				"virtual_machine_id": "vm_abc",
+				"disk_id":            "disk_def",
+			},
+			want: "vm_abc/disk_def",
+		},
+		{
+			name:         "object storage bucket",
+			resourceType: "katapult_object_storage_bucket",
+			attributes: map[string]string{
+				"name":   "my-bucket",
+				"region": "uk-lon-1",
+				"extra":  "ignored",
+			},
+			want: "my-bucket/uk-lon-1",
+		},
+		{
+			name:         "object storage account",
+			resourceType: "katapult_object_storage_account",
+			attributes:   map[string]string{"region": "uk-lon-1"},
+			want:         "uk-lon-1",
+		},
+		{
+			name:         "object storage account with organization",
+			resourceType: "katapult_object_storage_account",
+			attributes: map[string]string{
+				"organization": "acme",
+				"region":       "uk-lon-1",
+			},
+			want: "acme/uk-lon-1",
+		},
+		{
+			name:         "missing attribute",
+			resourceType: "katapult_object_storage_bucket",
+			attributes:   map[string]string{"name": "my-bucket"},
+			wantError: "katapult_object_storage_bucket import IDs " +
+				`require the "region" attribute`,
+		},
+		{
+			name:         "unsupported type",
+			resourceType: "katapult_ip",
+			attributes:   map[string]string{},
+			wantError: `unsupported resource type "katapult_ip", ` +
+				"supported types are: katapult_disk_assignment, " +
+				"katapult_object_storage_account, " +
+				"katapult_object_storage_bucket",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			attributes := map[string]attr.Value{}
+			for k, v := range tt.attributes {
+				attributes[k] = types.StringValue(v)
+			}
+
+			got, err := runTestFunction(t, &BuildImportIDFunction{},
+				types.StringValue(tt.resourceType),
+				types.MapValueMust(types.StringType, attributes),
+			)
+			if tt.wantError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantError, err.Text)
+				return
+			}
+			require.Nil(t, err)
+
+			assert.Equal(t, types.StringValue(tt.want), got)
+		})
+	}
+}
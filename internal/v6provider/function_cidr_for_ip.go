@@ -0,0 +1,81 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*CIDRForIPFunction)(nil)
+
+type CIDRForIPFunction struct{}
+
+func (f *CIDRForIPFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "cidr_for_ip"
+}
+
+func (f *CIDRForIPFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the network CIDR an IP address belongs to.",
+		MarkdownDescription: "Returns the network CIDR of an IP address in " +
+			"the `address_with_mask` format, e.g. `185.1.2.3/24` returns " +
+			"`185.1.2.0/24`. An address without a mask returns a single " +
+			"address CIDR (`/32` for IPv4, `/128` for IPv6).",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "address_with_mask",
+				MarkdownDescription: "The IP address, optionally with a prefix length.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CIDRForIPFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var address string
+	resp.Error = req.Arguments.Get(ctx, &address)
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := cidrForIP(address)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, prefix.String())
+}
+
+func cidrForIP(address string) (netip.Prefix, error) {
+	if !strings.Contains(address, "/") {
+		addr, err := netip.ParseAddr(address)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid IP address %q", address)
+		}
+
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(address)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid IP address %q", address)
+	}
+
+	return prefix.Masked(), nil
+}
@@ -0,0 +1,48 @@
+package v6provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCIDRForIPFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		address   string
+		want      string
+		wantError string
+	}{
+		{name: "ipv4", address: "185.1.2.3/24", want: "185.1.2.0/24"},
+		{name: "ipv6", address: "2a03:2800::1234/64", want: "2a03:2800::/64"},
+		{name: "ipv4 without mask", address: "185.1.2.3", want: "185.1.2.3/32"},
+		{name: "ipv6 without mask", address: "2a03:2800::1", want: "2a03:2800::1/128"},
+		{
+			name:      "invalid",
+			address:   "185.1.2.3/33",
+			wantError: `invalid IP address "185.1.2.3/33"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := runTestFunction(
+				t, &CIDRForIPFunction{}, types.StringValue(tt.address),
+			)
+			if tt.wantError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantError, err.Text)
+				return
+			}
+			require.Nil(t, err)
+
+			assert.Equal(t, types.StringValue(tt.want), got)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package v6provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = (*HostnameToFQDNFunction)(nil)
+
+// virtualMachineFQDNDomain is the domain under which Katapult publishes
+// Virtual Machine hostnames, one subdomain per organization.
+const virtualMachineFQDNDomain = "katapult.cloud"
+
+type HostnameToFQDNFunction struct{}
+
+func (f *HostnameToFQDNFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "hostname_to_fqdn"
+}
+
+func (f *HostnameToFQDNFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the FQDN Katapult assigns to a Virtual Machine " +
+			"hostname.",
+		MarkdownDescription: "Returns the fully-qualified domain name " +
+			"Katapult assigns to a Virtual Machine hostname within an " +
+			"organization, e.g. `web-1` in `acme-labs` returns " +
+			"`web-1.acme-labs.katapult.cloud`. Useful for looking up " +
+			"Virtual Machines by `fqdn` before they are managed by " +
+			"Terraform.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "hostname",
+				MarkdownDescription: "The Virtual Machine hostname.",
+			},
+			function.StringParameter{
+				Name:                "organization",
+				MarkdownDescription: "The sub-domain of the organization.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HostnameToFQDNFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var hostname, organization string
+	resp.Error = req.Arguments.Get(ctx, &hostname, &organization)
+	if resp.Error != nil {
+		return
+	}
+
+	if hostname == "" || strings.Contains(hostname, ".") {
+		resp.Error = function.NewArgumentFuncError(
+			0, "hostname must be a single, non-empty DNS label",
+		)
+		return
+	}
+	if organization == "" || strings.Contains(organization, ".") {
+		resp.Error = function.NewArgumentFuncError(
+			1, "organization must be a non-empty organization sub-domain",
+		)
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, strings.ToLower(
+		hostname+"."+organization+"."+virtualMachineFQDNDomain,
+	))
+}
@@ -0,0 +1,59 @@
+package v6provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostnameToFQDNFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		hostname     string
+		organization string
+		want         string
+		wantError    string
+	}{
+		{
+			name:         "valid",
+			hostname:     "Web-1",
+			organization: "acme-labs",
+			want:         "web-1.acme-labs.katapult.cloud",
+		},
+		{
+			name:         "hostname with dots",
+			hostname:     "web-1.example.com",
+			organization: "acme-labs",
+			wantError:    "hostname must be a single, non-empty DNS label",
+		},
+		{
+			name:         "empty organization",
+			hostname:     "web-1",
+			organization: "",
+			wantError:    "organization must be a non-empty organization sub-domain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := runTestFunction(t, &HostnameToFQDNFunction{},
+				types.StringValue(tt.hostname),
+				types.StringValue(tt.organization),
+			)
+			if tt.wantError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantError, err.Text)
+				return
+			}
+			require.Nil(t, err)
+
+			assert.Equal(t, types.StringValue(tt.want), got)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = (*ParseDiskAssignmentIDFunction)(nil)
+
+type (
+	ParseDiskAssignmentIDFunction struct{}
+
+	ParseDiskAssignmentIDFunctionModel struct {
+		VirtualMachineID types.String `tfsdk:"virtual_machine_id"`
+		DiskID           types.String `tfsdk:"disk_id"`
+	}
+)
+
+func (f *ParseDiskAssignmentIDFunction) Metadata(
+	_ context.Context,
+	_ function.MetadataRequest,
+	resp *function.MetadataResponse,
+) {
+	resp.Name = "parse_disk_assignment_id"
+}
+
+func (f *ParseDiskAssignmentIDFunction) Definition(
+	_ context.Context,
+	_ function.DefinitionRequest,
+	resp *function.DefinitionResponse,
+) {
+	resp.Definition = function.Definition{
+		Summary: "Splits a disk assignment ID into its Virtual Machine " +
+			"and disk IDs.",
+		MarkdownDescription: "Splits the `VM_ID/DISK_ID` ID of a " +
+			"`katapult_disk_assignment` into an object with " +
+			"`virtual_machine_id` and `disk_id` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The disk assignment ID.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"virtual_machine_id": types.StringType,
+				"disk_id":            types.StringType,
+			},
+		},
+	}
+}
+
+func (f *ParseDiskAssignmentIDFunction) Run(
+	ctx context.Context,
+	req function.RunRequest,
+	resp *function.RunResponse,
+) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	vmID, diskID, err := parseAssignmentID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, ParseDiskAssignmentIDFunctionModel{
+		VirtualMachineID: types.StringValue(vmID),
+		DiskID:           types.StringValue(diskID),
+	})
+}
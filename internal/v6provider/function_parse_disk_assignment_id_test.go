@@ -0,0 +1,88 @@
+package v6provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runTestFunction calls a provider function with the given arguments and
+// returns its result value, or the error it produced.
+func runTestFunction(
+	t *testing.T,
+	f function.Function,
+	args ...attr.Value,
+) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	var defResp function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &defResp)
+	require.Len(t, defResp.Definition.Parameters, len(args))
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(
+			defResp.Definition.Return.GetType().ValueType(ctx),
+		),
+	}
+	f.Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData(args),
+	}, &resp)
+
+	return resp.Result.Value(), resp.Error
+}
+
+func TestParseDiskAssignmentIDFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		id        string
+		want      map[string]attr.Value
+		wantError string
+	}{
+		{
+			name: "valid",
+			id:   "vm_abc/disk_def",
+			want: map[string]attr.Value{
+				"virtual_machine_id": types.StringValue("vm_abc"),
+				"disk_id":            types.StringValue("disk_def"),
+			},
+		},
+		{
+			name:      "missing disk",
+			id:        "vm_abc/",
+			wantError: "assignment ID must be exactly VM_ID/DISK_ID",
+		},
+		{
+			name:      "too many parts",
+			id:        "vm_abc/disk_def/extra",
+			wantError: "assignment ID must be exactly VM_ID/DISK_ID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := runTestFunction(
+				t, &ParseDiskAssignmentIDFunction{}, types.StringValue(tt.id),
+			)
+			if tt.wantError != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, tt.wantError, err.Text)
+				return
+			}
+			require.Nil(t, err)
+
+			obj, ok := got.(types.Object)
+			require.True(t, ok)
+			assert.Equal(t, tt.want, obj.Attributes())
+		})
+	}
+}
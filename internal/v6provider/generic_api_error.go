@@ -1,16 +1,65 @@
 package v6provider
 
 import (
+	"errors"
+	"net/http"
 	"sort"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tidwall/gjson"
 )
 
+// APIErrorCategory groups API errors by what the user can do about them.
+type APIErrorCategory string
+
+const (
+	APIErrorUncategorized APIErrorCategory = ""
+	APIErrorValidation    APIErrorCategory = "validation"
+	APIErrorPermission    APIErrorCategory = "permission"
+	APIErrorQuota         APIErrorCategory = "quota"
+	APIErrorNotFound      APIErrorCategory = "not_found"
+	APIErrorConflict      APIErrorCategory = "conflict"
+)
+
+// Title returns a short human readable name for c, used in diagnostic
+// summaries.
+func (c APIErrorCategory) Title() string {
+	switch c {
+	case APIErrorValidation:
+		return "Validation Failed"
+	case APIErrorPermission:
+		return "Permission Denied"
+	case APIErrorQuota:
+		return "Quota Exceeded"
+	case APIErrorNotFound:
+		return "Not Found"
+	case APIErrorConflict:
+		return "Conflict"
+	case APIErrorUncategorized:
+	}
+
+	return ""
+}
+
 type GenericAPIError struct {
 	Code        string
 	Description string
 	Detail      string
+
+	// Category is derived from Code and, when known, the HTTP status.
+	Category APIErrorCategory
+
+	// Errors holds the individual messages of a validation error's
+	// error.detail.errors list, such as "Label can't be blank".
+	Errors []string
+
+	// RequestID is the X-Request-Id of the failed response, which Katapult
+	// support can use to trace the request.
+	RequestID string
 }
 
 func (e *GenericAPIError) Error() string {
@@ -24,12 +73,26 @@ func (e *GenericAPIError) Error() string {
 		r += ": " + e.Detail
 	}
 
+	if e.RequestID != "" {
+		r += " (request ID: " + e.RequestID + ")"
+	}
+
 	return r
 }
 
-func genericAPIError(err error, body []byte) error {
+// genericAPIError returns the API error described by body, or err when body
+// is not an API error. httpRes, when given, supplies the request ID and
+// HTTP status.
+func genericAPIError(err error, body []byte, httpRes *http.Response) error {
 	apiErr := parseGenericAPIError(body)
 	if apiErr != nil {
+		if httpRes != nil {
+			apiErr.RequestID = httpRes.Header.Get("X-Request-Id")
+			if apiErr.Category == APIErrorUncategorized {
+				apiErr.Category = apiErrorStatusCategory(httpRes.StatusCode)
+			}
+		}
+
 		return apiErr
 	}
 
@@ -47,6 +110,7 @@ func parseGenericAPIError(body []byte) *GenericAPIError {
 	err := &GenericAPIError{
 		Code:        code,
 		Description: gj.Get("error.description").String(),
+		Category:    apiErrorCodeCategory(code),
 	}
 
 	if detail := gj.Get("error.detail"); detail.Exists() {
@@ -68,7 +132,136 @@ func parseGenericAPIError(body []byte) *GenericAPIError {
 		}
 
 		err.Detail = strings.Join(values, ", ")
+
+		detail.Get("errors").ForEach(func(_, v gjson.Result) bool {
+			err.Errors = append(err.Errors, v.String())
+
+			return true
+		})
 	}
 
 	return err
 }
+
+func apiErrorCodeCategory(code string) APIErrorCategory {
+	switch {
+	case code == "validation_error",
+		strings.HasPrefix(code, "invalid_"),
+		strings.HasSuffix(code, "_invalid"):
+		return APIErrorValidation
+	case code == "permission_denied",
+		code == "access_denied",
+		code == "scope_not_granted",
+		strings.HasSuffix(code, "_forbidden"):
+		return APIErrorPermission
+	case strings.Contains(code, "quota"),
+		strings.HasSuffix(code, "_limit_reached"),
+		strings.HasSuffix(code, "_limit_exceeded"):
+		return APIErrorQuota
+	case code == "not_found", strings.HasSuffix(code, "_not_found"):
+		return APIErrorNotFound
+	case strings.HasSuffix(code, "_in_use"),
+		strings.HasSuffix(code, "_already_exists"),
+		strings.HasSuffix(code, "_conflict"):
+		return APIErrorConflict
+	}
+
+	return APIErrorUncategorized
+}
+
+func apiErrorStatusCategory(status int) APIErrorCategory {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return APIErrorValidation
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return APIErrorPermission
+	case http.StatusNotFound:
+		return APIErrorNotFound
+	case http.StatusConflict:
+		return APIErrorConflict
+	}
+
+	return APIErrorUncategorized
+}
+
+// addAPIError adds err to diags under summary. API errors get their category
+// appended to the summary, and each validation message which names an
+// attribute of schemaType is attached to that attribute, so Terraform points
+// at the offending line of configuration.
+func addAPIError(
+	diags *diag.Diagnostics,
+	summary string,
+	err error,
+	schemaType attr.Type,
+) {
+	addAPIErrorAt(diags, path.Empty(), summary, err, schemaType)
+}
+
+// addAPIErrorAt is addAPIError for an object nested at base, such as a rule
+// block, with schemaType being the type of that object.
+func addAPIErrorAt(
+	diags *diag.Diagnostics,
+	base path.Path,
+	summary string,
+	err error,
+	schemaType attr.Type,
+) {
+	var apiErr *GenericAPIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, err.Error())
+		return
+	}
+
+	if title := apiErr.Category.Title(); title != "" {
+		summary += ": " + title
+	}
+
+	var unmatched []string
+	for _, msg := range apiErr.Errors {
+		name := validationErrorAttribute(msg, schemaType)
+		if name == "" {
+			unmatched = append(unmatched, msg)
+			continue
+		}
+
+		detail := apiErr.Code + ": " + msg
+		if apiErr.RequestID != "" {
+			detail += " (request ID: " + apiErr.RequestID + ")"
+		}
+		diags.AddAttributeError(base.AtName(name), summary, detail)
+	}
+
+	if len(apiErr.Errors) == 0 || len(unmatched) > 0 {
+		diags.AddError(summary, err.Error())
+	}
+}
+
+// validationErrorAttribute returns the top-level attribute of schemaType
+// named at the start of msg, matching the humanized names the API uses such
+// as "Default ttl" for default_ttl and "Zone" for zone_id. The longest match
+// wins, and an empty string is returned when none match.
+func validationErrorAttribute(msg string, schemaType attr.Type) string {
+	objType, ok := schemaType.(types.ObjectType)
+	if !ok {
+		return ""
+	}
+
+	msg = strings.ToLower(msg)
+	var best, bestHuman string
+	for name := range objType.AttrTypes {
+		if name == "id" {
+			continue
+		}
+
+		human := strings.ReplaceAll(strings.TrimSuffix(name, "_id"), "_", " ")
+		if !strings.HasPrefix(msg, human+" ") {
+			continue
+		}
+		if len(human) > len(bestHuman) ||
+			(len(human) == len(bestHuman) && name < best) {
+			best, bestHuman = name, human
+		}
+	}
+
+	return best
+}
@@ -2,9 +2,15 @@ package v6provider
 
 import (
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_parseGenericAPIError(t *testing.T) {
@@ -24,6 +30,7 @@ func Test_parseGenericAPIError(t *testing.T) {
 			want: &GenericAPIError{
 				Code:        "not_found",
 				Description: "Resource not found",
+				Category:    APIErrorNotFound,
 			},
 		},
 		{
@@ -38,6 +45,7 @@ func Test_parseGenericAPIError(t *testing.T) {
 			want: &GenericAPIError{
 				Code:        "not_found",
 				Description: "Resource not found",
+				Category:    APIErrorNotFound,
 				Detail:      "this is, an array",
 			},
 		},
@@ -53,6 +61,7 @@ func Test_parseGenericAPIError(t *testing.T) {
 			want: &GenericAPIError{
 				Code:        "not_found",
 				Description: "Resource not found",
+				Category:    APIErrorNotFound,
 				Detail:      "data=none, scope=global",
 			},
 		},
@@ -105,6 +114,7 @@ func Test_genericAPIError(t *testing.T) {
 			wantErr: &GenericAPIError{
 				Code:        "not_found",
 				Description: "Resource not found",
+				Category:    APIErrorNotFound,
 			},
 		},
 		{
@@ -123,7 +133,7 @@ func Test_genericAPIError(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := genericAPIError(tt.err, tt.body)
+			got := genericAPIError(tt.err, tt.body, nil)
 
 			if tt.wantErr == nil {
 				assert.NoError(t, got)
@@ -138,3 +148,160 @@ func Test_genericAPIError(t *testing.T) {
 		})
 	}
 }
+
+func Test_genericAPIError_response(t *testing.T) {
+	body := []byte(`{
+		"error": {
+			"code": "validation_error",
+			"description": "A validation error occurred",
+			"detail": {"errors": ["Label can't be blank", "Busy"]}
+		}
+	}`)
+	httpRes := &http.Response{
+		StatusCode: http.StatusUnprocessableEntity,
+		Header:     http.Header{"X-Request-Id": []string{"req-123"}},
+	}
+
+	got := genericAPIError(errors.New("original error"), body, httpRes)
+
+	assert.Equal(t, &GenericAPIError{
+		Code:        "validation_error",
+		Description: "A validation error occurred",
+		Detail:      `errors=["Label can't be blank", "Busy"]`,
+		Category:    APIErrorValidation,
+		Errors:      []string{"Label can't be blank", "Busy"},
+		RequestID:   "req-123",
+	}, got)
+	assert.Equal(t,
+		`validation_error: A validation error occurred: `+
+			`errors=["Label can't be blank", "Busy"] (request ID: req-123)`,
+		got.Error(),
+	)
+}
+
+func Test_genericAPIError_categories(t *testing.T) {
+	tests := []struct {
+		code   string
+		status int
+		want   APIErrorCategory
+	}{
+		{code: "validation_error", want: APIErrorValidation},
+		{code: "permission_denied", want: APIErrorPermission},
+		{code: "ip_address_quota_exceeded", want: APIErrorQuota},
+		{code: "disk_not_found", want: APIErrorNotFound},
+		{code: "object_in_use", want: APIErrorConflict},
+		{code: "something_else", want: APIErrorUncategorized},
+		{
+			code:   "something_else",
+			status: http.StatusForbidden,
+			want:   APIErrorPermission,
+		},
+		{
+			code:   "something_else",
+			status: http.StatusConflict,
+			want:   APIErrorConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			var httpRes *http.Response
+			if tt.status != 0 {
+				httpRes = &http.Response{StatusCode: tt.status}
+			}
+
+			got := genericAPIError(
+				nil, []byte(`{"error":{"code":"`+tt.code+`"}}`), httpRes,
+			)
+
+			var apiErr *GenericAPIError
+			require.ErrorAs(t, got, &apiErr)
+			assert.Equal(t, tt.want, apiErr.Category)
+		})
+	}
+}
+
+func Test_addAPIError(t *testing.T) {
+	schemaType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"label":       types.StringType,
+		"default_ttl": types.Int64Type,
+		"zone_id":     types.StringType,
+	}}
+
+	t.Run("validation errors attached to attributes", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addAPIError(&diags, "Create Error", &GenericAPIError{
+			Code:      "validation_error",
+			Category:  APIErrorValidation,
+			Errors:    []string{"Default ttl is too short", "Zone is invalid"},
+			RequestID: "req-123",
+		}, schemaType)
+
+		assert.Equal(t, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("default_ttl"),
+				"Create Error: Validation Failed",
+				"validation_error: Default ttl is too short "+
+					"(request ID: req-123)",
+			),
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("zone_id"),
+				"Create Error: Validation Failed",
+				"validation_error: Zone is invalid (request ID: req-123)",
+			),
+		}, diags)
+	})
+
+	t.Run("unmatched validation errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		err := &GenericAPIError{
+			Code:     "validation_error",
+			Category: APIErrorValidation,
+			Errors:   []string{"Label can't be blank", "Busy"},
+		}
+
+		addAPIError(&diags, "Update Error", err, schemaType)
+
+		assert.Equal(t, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("label"),
+				"Update Error: Validation Failed",
+				"validation_error: Label can't be blank",
+			),
+			diag.NewErrorDiagnostic(
+				"Update Error: Validation Failed", err.Error(),
+			),
+		}, diags)
+	})
+
+	t.Run("nested object", func(t *testing.T) {
+		var diags diag.Diagnostics
+		base := path.Root("rule").AtListIndex(1)
+
+		addAPIErrorAt(&diags, base, "Create Error", &GenericAPIError{
+			Code:     "validation_error",
+			Category: APIErrorValidation,
+			Errors:   []string{"Label is taken"},
+		}, schemaType)
+
+		assert.Equal(t, diag.Diagnostics{
+			diag.NewAttributeErrorDiagnostic(
+				path.Root("rule").AtListIndex(1).AtName("label"),
+				"Create Error: Validation Failed",
+				"validation_error: Label is taken",
+			),
+		}, diags)
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+
+		addAPIError(&diags, "Create Error", errors.New("boom"), schemaType)
+
+		assert.Equal(t, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Create Error", "boom"),
+		}, diags)
+	})
+}
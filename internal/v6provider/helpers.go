@@ -37,7 +37,7 @@ func fetchAllVMDisks(
 				return nil, core.ErrNotFound
 			}
 			if res != nil {
-				return nil, genericAPIError(err, res.Body)
+				return nil, genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 			return nil, err
 		}
@@ -120,7 +120,7 @@ func waitForTaskCompletion(
 				&core.GetTaskParams{TaskId: &taskID})
 			if e != nil {
 				if res != nil {
-					e = genericAPIError(e, res.Body)
+					e = genericAPIError(e, res.Body, res.HTTPResponse)
 				}
 				return nil, "", e
 			}
@@ -172,7 +172,7 @@ func waitForDiskSize(
 			res, err := m.Core.GetDiskWithResponse(ctx, &core.GetDiskParams{DiskId: &diskID})
 			if err != nil {
 				if res != nil {
-					err = genericAPIError(err, res.Body)
+					err = genericAPIError(err, res.Body, res.HTTPResponse)
 				}
 				return nil, "", err
 			}
@@ -228,7 +228,7 @@ func waitForTrashObjectNotFound(
 					return 1, "not_found", nil
 				}
 				if res != nil {
-					err = genericAPIError(err, res.Body)
+					err = genericAPIError(err, res.Body, res.HTTPResponse)
 				}
 
 				return nil, "", err
@@ -0,0 +1,101 @@
+package v6provider
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// listResourceObject is a Katapult object found by a list resource.
+type listResourceObject struct {
+	ID          string
+	DisplayName string
+}
+
+// listResourceConfigModel is the configuration shared by every list resource.
+type listResourceConfigModel struct {
+	Organization types.String `tfsdk:"organization"`
+}
+
+// listResourceOrganization returns the organization a list resource lists
+// from, as configured by its organization argument.
+func listResourceOrganization(
+	ctx context.Context,
+	m *Meta,
+	req list.ListRequest,
+) (string, diag.Diagnostics) {
+	var conf listResourceConfigModel
+	if req.Config.Raw.IsNull() {
+		return m.organization(conf.Organization), nil
+	}
+
+	diags := req.Config.Get(ctx, &conf)
+
+	return m.organization(conf.Organization), diags
+}
+
+// listResourceResults streams a result for each object, up to the limit
+// requested by Terraform. When Terraform also asks for resource data, read is
+// called with a resource whose id attribute is set, in the same way an
+// imported resource is read.
+func listResourceResults(
+	ctx context.Context,
+	req list.ListRequest,
+	objects []listResourceObject,
+	read func(ctx context.Context, resource *tfsdk.Resource) diag.Diagnostics,
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, obj := range objects {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = obj.DisplayName
+			result.Diagnostics.Append(setIDIdentity(
+				ctx, result.Identity, types.StringValue(obj.ID),
+			)...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				result.Diagnostics.Append(result.Resource.SetAttribute(
+					ctx, path.Root("id"), obj.ID,
+				)...)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(read(ctx, result.Resource)...)
+				}
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readListResource reads the object a list result refers to into resource
+// using a resource's own read function.
+func readListResource[T any](
+	ctx context.Context,
+	resource *tfsdk.Resource,
+	read func(ctx context.Context, model *T) error,
+) diag.Diagnostics {
+	var model T
+	diags := resource.Get(ctx, &model)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := read(ctx, &model); err != nil {
+		diags.AddError("Read Error", err.Error())
+		return diags
+	}
+
+	diags.Append(resource.Set(ctx, &model)...)
+
+	return diags
+}
@@ -0,0 +1,157 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ list.ListResourceWithConfigure = (*DiskListResource)(nil)
+
+type DiskListResource struct {
+	M *Meta
+}
+
+func (l *DiskListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_disk"
+}
+
+func (l *DiskListResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	l.M = meta
+}
+
+func (l *DiskListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every disk in the " +
+			"provider organization, except the system disks owned by " +
+			"`katapult_virtual_machine`",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationListAttribute(),
+		},
+	}
+}
+
+func (l *DiskListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	org, diags := listResourceOrganization(ctx, l.M, req)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	disks, err := fetchAllOrganizationDisks(ctx, l.M, org)
+	if err == nil {
+		disks, err = excludeVirtualMachineSystemDisks(ctx, l.M, disks)
+	}
+	if err != nil {
+		diags.AddError("Disks Error", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects := make([]listResourceObject, 0, len(disks))
+	for _, disk := range disks {
+		id, ok := nonNilString(disk.Id)
+		if !ok {
+			continue
+		}
+		name, _ := nonNilString(disk.Name)
+		objects = append(objects, listResourceObject{
+			ID:          id,
+			DisplayName: name,
+		})
+	}
+
+	r := &DiskResource{M: l.M}
+	stream.Results = listResourceResults(ctx, req, objects,
+		func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+			return readListResource(ctx, res,
+				func(ctx context.Context, model *DiskResourceModel) error {
+					model.Organization = types.StringValue(org)
+
+					return r.diskRead(ctx, model.ID.ValueString(), model)
+				},
+			)
+		},
+	)
+}
+
+// excludeVirtualMachineSystemDisks removes the boot disk of every Virtual
+// Machine from disks, as those are managed through the system_disk attribute
+// of katapult_virtual_machine rather than as katapult_disk resources.
+func excludeVirtualMachineSystemDisks(
+	ctx context.Context,
+	m *Meta,
+	disks []core.GetOrganizationDisks200ResponseDisk,
+) ([]core.GetOrganizationDisks200ResponseDisk, error) {
+	bootDiskIDs := map[string]struct{}{}
+	checked := map[string]struct{}{}
+	for _, disk := range disks {
+		vmDisk, err := disk.VirtualMachineDisk.Get()
+		if err != nil || vmDisk.VirtualMachine == nil ||
+			vmDisk.VirtualMachine.Id == nil {
+			continue
+		}
+
+		vmID := *vmDisk.VirtualMachine.Id
+		if _, ok := checked[vmID]; ok {
+			continue
+		}
+		checked[vmID] = struct{}{}
+
+		attachments, err := fetchAllVMDisks(ctx, m, vmID)
+		if err != nil {
+			return nil, err
+		}
+		boot, authoritative := selectBootDiskAssignment(attachments, "")
+		if !authoritative || boot == nil || boot.Disk == nil ||
+			boot.Disk.Id == nil {
+			return nil, fmt.Errorf(
+				"could not identify one authoritative boot disk for "+
+					"Virtual Machine %s", vmID,
+			)
+		}
+		bootDiskIDs[*boot.Disk.Id] = struct{}{}
+	}
+
+	return slices.DeleteFunc(disks,
+		func(disk core.GetOrganizationDisks200ResponseDisk) bool {
+			id, _ := nonNilString(disk.Id)
+			_, boot := bootDiskIDs[id]
+			return boot
+		},
+	), nil
+}
@@ -0,0 +1,144 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ list.ListResourceWithConfigure = (*IPListResource)(nil)
+
+const ipAddressesPageSize = 100
+
+type IPListResource struct {
+	M *Meta
+}
+
+func (l *IPListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ip"
+}
+
+func (l *IPListResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	l.M = meta
+}
+
+func (l *IPListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every IP address in " +
+			"the provider organization",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationListAttribute(),
+		},
+	}
+}
+
+func (l *IPListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	org, diags := listResourceOrganization(ctx, l.M, req)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	ips, err := fetchAllOrganizationIPAddresses(ctx, l.M, org)
+	if err != nil {
+		diags.AddError("IP Addresses Error", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects := make([]listResourceObject, 0, len(ips))
+	for _, ip := range ips {
+		id, ok := nonNilString(ip.Id)
+		if !ok {
+			continue
+		}
+		address, _ := nonNilString(ip.Address)
+		objects = append(objects, listResourceObject{
+			ID:          id,
+			DisplayName: address,
+		})
+	}
+
+	r := &IPResource{M: l.M}
+	stream.Results = listResourceResults(ctx, req, objects,
+		func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+			return readListResource(ctx, res,
+				func(ctx context.Context, model *IPResourceModel) error {
+					model.Organization = types.StringValue(org)
+
+					return r.IPRead(ctx, model.ID.ValueString(), model)
+				},
+			)
+		},
+	)
+}
+
+func fetchAllOrganizationIPAddresses(
+	ctx context.Context,
+	m *Meta,
+	org string,
+) ([]core.GetOrganizationIPAddresses200ResponseIPAddresses, error) {
+	ips := []core.GetOrganizationIPAddresses200ResponseIPAddresses{}
+	for page := 1; ; page++ {
+		res, err := m.Core.GetOrganizationIpAddressesWithResponse(ctx,
+			&core.GetOrganizationIpAddressesParams{
+				OrganizationSubDomain: &org,
+				Page:                  &page,
+				PerPage:               ptr(ipAddressesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			return nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, fmt.Errorf(
+				"unexpected empty response listing IP addresses on page %d", page,
+			)
+		}
+
+		pageIPs := res.JSON200.IpAddresses
+		ips = append(ips, pageIPs...)
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(pageIPs), ipAddressesPageSize,
+		) {
+			break
+		}
+	}
+
+	return ips, nil
+}
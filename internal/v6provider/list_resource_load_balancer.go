@@ -0,0 +1,103 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = (*LoadBalancerListResource)(nil)
+
+type LoadBalancerListResource struct {
+	M *Meta
+}
+
+func (l *LoadBalancerListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_load_balancer"
+}
+
+func (l *LoadBalancerListResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	l.M = meta
+}
+
+func (l *LoadBalancerListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every load balancer " +
+			"in the provider organization",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationListAttribute(),
+		},
+	}
+}
+
+func (l *LoadBalancerListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	org, diags := listResourceOrganization(ctx, l.M, req)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	lbs, err := fetchAllOrganizationLoadBalancers(ctx, l.M, org)
+	if err != nil {
+		diags.AddError("Load Balancers Error", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects := make([]listResourceObject, 0, len(lbs))
+	for _, lb := range lbs {
+		id, ok := nonNilString(lb.Id)
+		if !ok {
+			continue
+		}
+		name, _ := nonNilString(lb.Name)
+		objects = append(objects, listResourceObject{
+			ID:          id,
+			DisplayName: name,
+		})
+	}
+
+	r := &LoadBalancerResource{M: l.M}
+	stream.Results = listResourceResults(ctx, req, objects,
+		func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+			return readListResource(ctx, res,
+				func(ctx context.Context, model *LoadBalancerResourceModel) error {
+					model.Organization = types.StringValue(org)
+
+					return r.LoadBalancerRead(ctx, model.ID.ValueString(), model)
+				},
+			)
+		},
+	)
+}
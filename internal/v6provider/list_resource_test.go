@@ -0,0 +1,177 @@
+package v6provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadBalancerListTestHandler(t *testing.T) http.HandlerFunc {
+	t.Helper()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/organizations/organization/load_balancers":
+			writeTestJSON(w, http.StatusOK, `{
+				"load_balancers": [
+					{"id": "lbaas_b", "name": "Second"},
+					{"id": "lbaas_a", "name": "First"}
+				],
+				"pagination": {"current_page": 1, "total_pages": 1, "per_page": 100}
+			}`)
+		case "/load_balancers/load_balancer":
+			id := r.URL.Query().Get("load_balancer[id]")
+			writeTestJSON(w, http.StatusOK, `{
+				"load_balancer": {
+					"id": "`+id+`",
+					"name": "LB `+id+`",
+					"https_redirect": true,
+					"resource_type": "virtual_machines",
+					"resource_ids": ["vm_a"],
+					"ip_address": {"address": "185.1.2.3"}
+				}
+			}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+}
+
+func loadBalancerListTestRequest(
+	t *testing.T,
+	includeResource bool,
+	limit int64,
+) list.ListRequest {
+	t.Helper()
+	ctx := context.Background()
+	r := &LoadBalancerResource{}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	return list.ListRequest{
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}
+}
+
+func TestLoadBalancerListResourceList(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	l := &LoadBalancerListResource{M: &Meta{
+		Core:             newVirtualMachineTestClient(t, loadBalancerListTestHandler(t)),
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	stream := &list.ListResultsStream{}
+	l.List(ctx, loadBalancerListTestRequest(t, true, 0), stream)
+
+	got := []string{}
+	for result := range stream.Results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics.Errors())
+
+		var identity IDIdentityModel
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+
+		var model LoadBalancerResourceModel
+		require.False(t, result.Resource.Get(ctx, &model).HasError())
+		assert.Equal(t, identity.ID, model.ID)
+		assert.Equal(t, types.StringValue("LB "+identity.ID.ValueString()), model.Name)
+		assert.Equal(t, types.StringValue("185.1.2.3"), model.IPAddress)
+
+		got = append(got, result.DisplayName+"="+identity.ID.ValueString())
+	}
+
+	assert.Equal(t, []string{"First=lbaas_a", "Second=lbaas_b"}, got)
+}
+
+func TestLoadBalancerListResourceListLimit(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	l := &LoadBalancerListResource{M: &Meta{
+		Core:             newVirtualMachineTestClient(t, loadBalancerListTestHandler(t)),
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+
+	stream := &list.ListResultsStream{}
+	l.List(ctx, loadBalancerListTestRequest(t, false, 1), stream)
+
+	count := 0
+	for result := range stream.Results {
+		require.False(t, result.Diagnostics.HasError(), result.Diagnostics.Errors())
+
+		var identity IDIdentityModel
+		require.False(t, result.Identity.Get(ctx, &identity).HasError())
+		assert.Equal(t, "lbaas_a", identity.ID.ValueString())
+		assert.True(t, result.Resource.Raw.IsNull())
+		count++
+	}
+
+	assert.Equal(t, 1, count)
+}
+
+func TestExcludeVirtualMachineSystemDisks(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		switch r.URL.Path {
+		case "/organizations/organization/disks":
+			writeTestJSON(w, http.StatusOK, `{
+				"disk": [
+					{
+						"id": "disk_boot",
+						"virtual_machine_disk": {"virtual_machine": {"id": "vm_a"}}
+					},
+					{
+						"id": "disk_data",
+						"virtual_machine_disk": {"virtual_machine": {"id": "vm_a"}}
+					},
+					{"id": "disk_detached", "virtual_machine_disk": null}
+				],
+				"pagination": {"current_page": 1, "total_pages": 1, "per_page": 200}
+			}`)
+		case "/virtual_machines/virtual_machine/disks":
+			require.Equal(t, "vm_a", r.URL.Query().Get("virtual_machine[id]"))
+			writeTestJSON(w, http.StatusOK, `{
+				"disks": [
+					{"boot": true, "disk": {"id": "disk_boot"}},
+					{"boot": false, "disk": {"id": "disk_data"}}
+				],
+				"pagination": {"current_page": 1, "total_pages": 1, "per_page": 30}
+			}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	m := &Meta{Core: client, confOrganization: "test-org", testMode: true}
+
+	disks, err := fetchAllOrganizationDisks(context.Background(), m, m.confOrganization)
+	require.NoError(t, err)
+	disks, err = excludeVirtualMachineSystemDisks(context.Background(), m, disks)
+	require.NoError(t, err)
+
+	ids := []string{}
+	for _, disk := range disks {
+		ids = append(ids, *disk.Id)
+	}
+	assert.Equal(t, []string{"disk_data", "disk_detached"}, ids)
+}
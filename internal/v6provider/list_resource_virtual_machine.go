@@ -0,0 +1,99 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ list.ListResourceWithConfigure = (*VirtualMachineListResource)(nil)
+
+type VirtualMachineListResource struct {
+	M *Meta
+}
+
+func (l *VirtualMachineListResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_virtual_machine"
+}
+
+func (l *VirtualMachineListResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError("Meta Error", "meta is not of type *Meta")
+		return
+	}
+
+	l.M = meta
+}
+
+func (l *VirtualMachineListResource) ListResourceConfigSchema(
+	_ context.Context,
+	_ list.ListResourceSchemaRequest,
+	resp *list.ListResourceSchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every Virtual Machine in the " +
+			"provider organization.",
+		Attributes: map[string]schema.Attribute{
+			organizationAttributeName: organizationListAttribute(),
+		},
+	}
+}
+
+func (l *VirtualMachineListResource) List(
+	ctx context.Context,
+	req list.ListRequest,
+	stream *list.ListResultsStream,
+) {
+	org, diags := listResourceOrganization(ctx, l.M, req)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	vms, err := fetchAllOrganizationVirtualMachines(ctx, l.M, org)
+	if err != nil {
+		diags.AddError("Virtual Machines Error", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects := make([]listResourceObject, 0, len(vms))
+	for _, vm := range vms {
+		name, _ := nonNilString(vm.Name)
+		objects = append(objects, listResourceObject{
+			ID:          *vm.Id,
+			DisplayName: name,
+		})
+	}
+
+	r := &VirtualMachineResource{M: l.M}
+	stream.Results = listResourceResults(ctx, req, objects,
+		func(ctx context.Context, res *tfsdk.Resource) diag.Diagnostics {
+			return readListResource(ctx, res,
+				func(ctx context.Context, model *VirtualMachineResourceModel) error {
+					model.Organization = types.StringValue(org)
+
+					return r.vmRead(ctx, model)
+				},
+			)
+		},
+	)
+}
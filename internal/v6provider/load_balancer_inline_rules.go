@@ -0,0 +1,505 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+const (
+	loadBalancerRuleBlockName = "rule"
+
+	// loadBalancerInlineRulesPrivateKey holds the ids of the rules managed
+	// through rule blocks. It is absent while rule blocks are not used.
+	loadBalancerInlineRulesPrivateKey = "load_balancer_inline_rules_v1"
+)
+
+// LoadBalancerInlineRuleModel is a rule block of katapult_load_balancer. It
+// has the attributes of katapult_load_balancer_rule, less load_balancer_id.
+type LoadBalancerInlineRuleModel struct {
+	ID                types.String `tfsdk:"id"`
+	Algorithm         types.String `tfsdk:"algorithm"`
+	DestinationPort   types.Int64  `tfsdk:"destination_port"`
+	ListenPort        types.Int64  `tfsdk:"listen_port"`
+	Protocol          types.String `tfsdk:"protocol"`
+	ProxyProtocol     types.Bool   `tfsdk:"proxy_protocol"`
+	CertificateIDs    types.Set    `tfsdk:"certificate_ids"`
+	BackendSSL        types.Bool   `tfsdk:"backend_ssl"`
+	PassthroughSSL    types.Bool   `tfsdk:"passthrough_ssl"`
+	CheckEnabled      types.Bool   `tfsdk:"check_enabled"`
+	CheckFall         types.Int64  `tfsdk:"check_fall"`
+	CheckInterval     types.Int64  `tfsdk:"check_interval"`
+	CheckHTTPStatuses types.String `tfsdk:"check_http_statuses"`
+	CheckPath         types.String `tfsdk:"check_path"`
+	CheckProtocol     types.String `tfsdk:"check_protocol"`
+	CheckRise         types.Int64  `tfsdk:"check_rise"`
+	CheckTimeout      types.Int64  `tfsdk:"check_timeout"`
+}
+
+func LoadBalancerInlineRuleType() types.ObjectType {
+	attrTypes := LoadBalancerRuleType().AttrTypes
+	delete(attrTypes, "load_balancer_id")
+
+	return types.ObjectType{AttrTypes: attrTypes}
+}
+
+func loadBalancerInlineRuleBlock() schema.ListNestedBlock {
+	attrs := LoadBalancerRuleSchemaAttributes()
+	delete(attrs, "load_balancer_id")
+
+	// Inline rules are matched to their prior state by listen port in
+	// ModifyPlan, so the per-index UseStateForUnknown modifiers of the
+	// standalone resource would copy values between unrelated rules.
+	for name, a := range attrs {
+		switch a := a.(type) {
+		case schema.StringAttribute:
+			a.PlanModifiers = nil
+			attrs[name] = a
+		case schema.Int64Attribute:
+			a.PlanModifiers = nil
+			attrs[name] = a
+		case schema.BoolAttribute:
+			a.PlanModifiers = nil
+			attrs[name] = a
+		case schema.SetAttribute:
+			a.PlanModifiers = nil
+			a.Default = setdefault.StaticValue(
+				types.SetValueMust(types.StringType, []attr.Value{}),
+			)
+			attrs[name] = a
+		}
+	}
+
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Rules of the load balancer. When one or more " +
+			"`rule` blocks are given, they are authoritative: rules are " +
+			"matched by `listen_port`, including rules which already " +
+			"existed, such as after an import, and rules managed by " +
+			"`rule` blocks which are no longer configured are deleted. " +
+			"Cannot be used together with `katapult_load_balancer_rule` " +
+			"resources for the same load balancer: the plan fails when " +
+			"the load balancer has rules which are neither configured " +
+			"nor managed by `rule` blocks. Removing every `rule` block " +
+			"stops managing rules without deleting them.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: attrs,
+		},
+	}
+}
+
+func (m LoadBalancerInlineRuleModel) ruleModel(
+	lbID string,
+) LoadBalancerRuleResourceModel {
+	return LoadBalancerRuleResourceModel{
+		ID:                m.ID,
+		LoadBalancerID:    types.StringValue(lbID),
+		Algorithm:         m.Algorithm,
+		DestinationPort:   m.DestinationPort,
+		ListenPort:        m.ListenPort,
+		Protocol:          m.Protocol,
+		ProxyProtocol:     m.ProxyProtocol,
+		CertificateIDs:    m.CertificateIDs,
+		BackendSSL:        m.BackendSSL,
+		PassthroughSSL:    m.PassthroughSSL,
+		CheckEnabled:      m.CheckEnabled,
+		CheckFall:         m.CheckFall,
+		CheckInterval:     m.CheckInterval,
+		CheckHTTPStatuses: m.CheckHTTPStatuses,
+		CheckPath:         m.CheckPath,
+		CheckProtocol:     m.CheckProtocol,
+		CheckRise:         m.CheckRise,
+		CheckTimeout:      m.CheckTimeout,
+	}
+}
+
+func newLoadBalancerInlineRuleModel(
+	r *LoadBalancerRuleResourceModel,
+) LoadBalancerInlineRuleModel {
+	return LoadBalancerInlineRuleModel{
+		ID:                r.ID,
+		Algorithm:         r.Algorithm,
+		DestinationPort:   r.DestinationPort,
+		ListenPort:        r.ListenPort,
+		Protocol:          r.Protocol,
+		ProxyProtocol:     r.ProxyProtocol,
+		CertificateIDs:    r.CertificateIDs,
+		BackendSSL:        r.BackendSSL,
+		PassthroughSSL:    r.PassthroughSSL,
+		CheckEnabled:      r.CheckEnabled,
+		CheckFall:         r.CheckFall,
+		CheckInterval:     r.CheckInterval,
+		CheckHTTPStatuses: r.CheckHTTPStatuses,
+		CheckPath:         r.CheckPath,
+		CheckProtocol:     r.CheckProtocol,
+		CheckRise:         r.CheckRise,
+		CheckTimeout:      r.CheckTimeout,
+	}
+}
+
+func loadBalancerInlineRules(
+	ctx context.Context,
+	list types.List,
+) ([]LoadBalancerInlineRuleModel, diag.Diagnostics) {
+	rules := []LoadBalancerInlineRuleModel{}
+	if list.IsNull() || list.IsUnknown() {
+		return rules, nil
+	}
+
+	diags := list.ElementsAs(ctx, &rules, false)
+
+	return rules, diags
+}
+
+func loadBalancerInlineRulesValue(
+	ctx context.Context,
+	rules []LoadBalancerInlineRuleModel,
+) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, LoadBalancerInlineRuleType(), rules)
+}
+
+// validateLoadBalancerInlineRules applies the standalone rule checks to each
+// rule block, and rejects rule blocks sharing a listen port.
+func validateLoadBalancerInlineRules(
+	diags *diag.Diagnostics,
+	rules []LoadBalancerInlineRuleModel,
+) {
+	var listeners []loadBalancerRuleListener
+	for i, rule := range rules {
+		ruleModel := rule.ruleModel("")
+		validateLoadBalancerRuleConfig(diags, &ruleModel)
+
+		if rule.ListenPort.IsUnknown() || rule.ListenPort.IsNull() ||
+			rule.Protocol.IsUnknown() {
+			continue
+		}
+
+		listener := loadBalancerRuleListener{
+			ListenPort: rule.ListenPort.ValueInt64(),
+			Protocol:   rule.Protocol.ValueString(),
+		}
+		if other, ok := loadBalancerRuleConflict(listener, listeners); ok {
+			addLoadBalancerRuleConflict(
+				diags, diag.SeverityError,
+				path.Root(loadBalancerRuleBlockName).AtListIndex(i),
+				"", listener, other,
+			)
+		}
+		listeners = append(listeners, listener)
+	}
+}
+
+// planLoadBalancerInlineRuleIDs sets the id of each planned rule to that of
+// the existing rule with the same listen port, or unknown for rules which
+// will be created. It reports whether any id changed.
+func planLoadBalancerInlineRuleIDs(
+	plan []LoadBalancerInlineRuleModel,
+	existing []loadBalancerRuleListener,
+) bool {
+	ids := make(map[int64]string, len(existing))
+	for _, rule := range existing {
+		ids[rule.ListenPort] = rule.ID
+	}
+
+	changed := false
+	for i, rule := range plan {
+		id := types.StringUnknown()
+		if known, ok := ids[rule.ListenPort.ValueInt64()]; ok &&
+			!rule.ListenPort.IsUnknown() {
+			id = types.StringValue(known)
+		}
+
+		if !rule.ID.Equal(id) {
+			plan[i].ID = id
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// unmanagedLoadBalancerRules returns the existing rules whose listen port is
+// not configured by any rule block, and which were not created or adopted
+// through rule blocks, as those belong to katapult_load_balancer_rule
+// resources or were added outside of Terraform.
+func unmanagedLoadBalancerRules(
+	plan []LoadBalancerInlineRuleModel,
+	existing []loadBalancerRuleListener,
+	managed []string,
+) []loadBalancerRuleListener {
+	planned := make(map[int64]bool, len(plan))
+	for _, rule := range plan {
+		planned[rule.ListenPort.ValueInt64()] = true
+	}
+	known := make(map[string]bool, len(managed))
+	for _, id := range managed {
+		known[id] = true
+	}
+
+	var unmanaged []loadBalancerRuleListener
+	for _, rule := range existing {
+		if !planned[rule.ListenPort] && !known[rule.ID] {
+			unmanaged = append(unmanaged, rule)
+		}
+	}
+
+	return unmanaged
+}
+
+// addUnmanagedLoadBalancerRulesError rejects rule blocks on load balancer
+// lbID while it has unmanaged rules, as rule blocks would delete them and
+// katapult_load_balancer_rule resources would create them again on every
+// apply.
+func addUnmanagedLoadBalancerRulesError(
+	diags *diag.Diagnostics,
+	lbID string,
+	unmanaged []loadBalancerRuleListener,
+) {
+	if len(unmanaged) == 0 {
+		return
+	}
+
+	rules := make([]string, 0, len(unmanaged))
+	for _, rule := range unmanaged {
+		rules = append(rules, fmt.Sprintf(
+			"%s (port %d)", rule.ID, rule.ListenPort,
+		))
+	}
+
+	diags.AddAttributeError(
+		path.Root(loadBalancerRuleBlockName),
+		"Unmanaged Load Balancer Rules",
+		fmt.Sprintf(
+			"Load balancer %s has rules which are not configured by any "+
+				"rule block: %s.\n\n"+
+				"Rule blocks cannot be used together with "+
+				"katapult_load_balancer_rule resources for the same load "+
+				"balancer. Move those rules into rule blocks, delete them, "+
+				"or remove the rule blocks to keep managing rules separately.",
+			lbID, strings.Join(rules, ", "),
+		),
+	)
+}
+
+// reconcileLoadBalancerInlineRules makes the rules of a load balancer match
+// plan. Planned rules are matched to the rules currently on the load balancer
+// by listen port: unmatched rules are deleted first, so their ports can be
+// reused, then changed rules are updated and new rules created.
+func reconcileLoadBalancerInlineRules(
+	ctx context.Context,
+	m *Meta,
+	lbID string,
+	plan []LoadBalancerInlineRuleModel,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	live, err := readLoadBalancerInlineRules(ctx, m, lbID, nil)
+	if err != nil {
+		addAPIError(&diags, "Load Balancer Rule Read Error", err, nil)
+		return diags
+	}
+
+	planned := make(map[int64]bool, len(plan))
+	for _, rule := range plan {
+		planned[rule.ListenPort.ValueInt64()] = true
+	}
+
+	existing := make(map[int64]LoadBalancerInlineRuleModel, len(live))
+	for _, rule := range live {
+		port := rule.ListenPort.ValueInt64()
+		if planned[port] {
+			existing[port] = rule
+			continue
+		}
+
+		res, err := m.Core.DeleteLoadBalancersRulesLoadBalancerRuleWithResponse(
+			ctx,
+			core.DeleteLoadBalancersRulesLoadBalancerRuleJSONRequestBody{
+				LoadBalancerRule: core.LoadBalancerRuleLookup{
+					Id: rule.ID.ValueStringPointer(),
+				},
+			},
+		)
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			addAPIError(&diags, "Load Balancer Rule Delete Error", err, nil)
+
+			return diags
+		}
+	}
+
+	for i, rule := range plan {
+		rulePath := path.Root(loadBalancerRuleBlockName).AtListIndex(i)
+		ruleModel := rule.ruleModel(lbID)
+
+		if prior, ok := existing[rule.ListenPort.ValueInt64()]; ok {
+			priorModel := prior.ruleModel(lbID)
+			args, d := buildLoadBalancerRuleUpdateArgs(
+				ctx, &ruleModel, &priorModel,
+			)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+			if args == (core.LoadBalancerRuleArguments{}) {
+				continue
+			}
+
+			res, err := m.Core.
+				PatchLoadBalancersRulesLoadBalancerRuleWithResponse(ctx,
+					core.PatchLoadBalancersRulesLoadBalancerRuleJSONRequestBody{
+						LoadBalancerRule: core.LoadBalancerRuleLookup{
+							Id: prior.ID.ValueStringPointer(),
+						},
+						Properties: args,
+					},
+				)
+			if err != nil {
+				if res != nil {
+					err = genericAPIError(err, res.Body, res.HTTPResponse)
+				}
+				addAPIErrorAt(
+					&diags, rulePath, "Load Balancer Rule Update Error", err,
+					LoadBalancerInlineRuleType(),
+				)
+
+				return diags
+			}
+
+			continue
+		}
+
+		args, d := buildLoadBalancerRuleCreateArgs(ctx, &ruleModel)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		res, err := m.Core.PostLoadBalancerRulesWithResponse(ctx,
+			core.PostLoadBalancerRulesJSONRequestBody{
+				LoadBalancer: core.LoadBalancerLookup{Id: &lbID},
+				Properties:   args,
+			},
+		)
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+			addAPIErrorAt(
+				&diags, rulePath, "Load Balancer Rule Create Error", err,
+				LoadBalancerInlineRuleType(),
+			)
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// readLoadBalancerInlineRules returns every rule of a load balancer. Rules
+// are ordered as their listen ports appear in known, followed by any other
+// rules by listen port, so the result lines up with the configuration.
+func readLoadBalancerInlineRules(
+	ctx context.Context,
+	m *Meta,
+	lbID string,
+	known []LoadBalancerInlineRuleModel,
+) ([]LoadBalancerInlineRuleModel, error) {
+	lbRules, err := getLBRules(ctx, m, lbID)
+	if err != nil {
+		return nil, err
+	}
+
+	byPort := make(map[int64]LoadBalancerInlineRuleModel, len(lbRules))
+	for _, lbr := range lbRules {
+		var ruleModel LoadBalancerRuleResourceModel
+		populateLoadBalancerRuleModel(&ruleModel, lbr)
+		byPort[ruleModel.ListenPort.ValueInt64()] =
+			newLoadBalancerInlineRuleModel(&ruleModel)
+	}
+
+	rules := make([]LoadBalancerInlineRuleModel, 0, len(byPort))
+	for _, rule := range known {
+		port := rule.ListenPort.ValueInt64()
+		if found, ok := byPort[port]; ok {
+			rules = append(rules, found)
+			delete(byPort, port)
+		}
+	}
+
+	rest := make([]int64, 0, len(byPort))
+	for port := range byPort {
+		rest = append(rest, port)
+	}
+	sort.Slice(rest, func(i, j int) bool { return rest[i] < rest[j] })
+	for _, port := range rest {
+		rules = append(rules, byPort[port])
+	}
+
+	return rules, nil
+}
+
+// managedLoadBalancerInlineRules returns the ids of the rules managed through
+// rule blocks, and whether rule blocks are in use at all.
+func managedLoadBalancerInlineRules(
+	ctx context.Context,
+	private interface {
+		GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+	},
+) ([]string, bool, diag.Diagnostics) {
+	encoded, diags := private.GetKey(ctx, loadBalancerInlineRulesPrivateKey)
+	if diags.HasError() || len(encoded) == 0 {
+		return nil, false, diags
+	}
+
+	var ids []string
+	if err := json.Unmarshal(encoded, &ids); err != nil {
+		diags.AddError(
+			"Load Balancer Private State Error",
+			"Cannot decode the rules managed by rule blocks: "+err.Error(),
+		)
+		return nil, false, diags
+	}
+
+	return ids, true, diags
+}
+
+// setManagedLoadBalancerInlineRules records rules as managed through rule
+// blocks. A nil slice records that rule blocks are no longer used.
+func setManagedLoadBalancerInlineRules(
+	ctx context.Context,
+	private interface {
+		SetKey(context.Context, string, []byte) diag.Diagnostics
+	},
+	rules []LoadBalancerInlineRuleModel,
+) diag.Diagnostics {
+	if rules == nil {
+		return private.SetKey(ctx, loadBalancerInlineRulesPrivateKey, nil)
+	}
+
+	ids := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID.ValueString())
+	}
+
+	var diags diag.Diagnostics
+	encoded, err := json.Marshal(ids)
+	if err != nil {
+		diags.AddError("Load Balancer Private State Error", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, loadBalancerInlineRulesPrivateKey, encoded)
+}
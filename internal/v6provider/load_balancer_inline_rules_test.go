@@ -0,0 +1,350 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testLoadBalancerInlineRule(
+	id string,
+	listenPort int64,
+	destinationPort int64,
+	protocol string,
+) LoadBalancerInlineRuleModel {
+	idValue := types.StringUnknown()
+	if id != "" {
+		idValue = types.StringValue(id)
+	}
+
+	return LoadBalancerInlineRuleModel{
+		ID:                idValue,
+		Algorithm:         types.StringValue("round_robin"),
+		DestinationPort:   types.Int64Value(destinationPort),
+		ListenPort:        types.Int64Value(listenPort),
+		Protocol:          types.StringValue(protocol),
+		ProxyProtocol:     types.BoolValue(false),
+		CertificateIDs:    types.SetValueMust(types.StringType, []attr.Value{}),
+		BackendSSL:        types.BoolValue(false),
+		PassthroughSSL:    types.BoolValue(false),
+		CheckEnabled:      types.BoolValue(false),
+		CheckFall:         types.Int64Value(2),
+		CheckInterval:     types.Int64Value(20),
+		CheckHTTPStatuses: types.StringValue("2"),
+		CheckPath:         types.StringValue("/"),
+		CheckProtocol:     types.StringValue("HTTP"),
+		CheckRise:         types.Int64Value(2),
+		CheckTimeout:      types.Int64Value(5),
+	}
+}
+
+func TestLoadBalancerInlineRuleType(t *testing.T) {
+	t.Parallel()
+
+	want := LoadBalancerRuleType().AttrTypes
+	delete(want, "load_balancer_id")
+
+	assert.Equal(t, want, LoadBalancerInlineRuleType().AttrTypes)
+	assert.Equal(t,
+		LoadBalancerInlineRuleType(),
+		loadBalancerInlineRuleBlock().NestedObject.Type(),
+	)
+}
+
+func TestPlanLoadBalancerInlineRuleIDs(t *testing.T) {
+	t.Parallel()
+
+	existing := []loadBalancerRuleListener{
+		{ID: "lbrule_http", ListenPort: 80, Protocol: "HTTP"},
+		{ID: "lbrule_https", ListenPort: 443, Protocol: "HTTPS"},
+	}
+	plan := []LoadBalancerInlineRuleModel{
+		testLoadBalancerInlineRule("", 443, 9443, "HTTPS"),
+		testLoadBalancerInlineRule("lbrule_http", 22, 22, "TCP"),
+	}
+
+	assert.True(t, planLoadBalancerInlineRuleIDs(plan, existing))
+	assert.Equal(t, types.StringValue("lbrule_https"), plan[0].ID)
+	assert.True(t, plan[1].ID.IsUnknown())
+
+	assert.False(t, planLoadBalancerInlineRuleIDs(plan, existing))
+}
+
+func TestUnmanagedLoadBalancerRules(t *testing.T) {
+	t.Parallel()
+
+	existing := []loadBalancerRuleListener{
+		{ID: "lbrule_http", ListenPort: 80, Protocol: "HTTP"},
+		{ID: "lbrule_https", ListenPort: 443, Protocol: "HTTPS"},
+		{ID: "lbrule_old", ListenPort: 8000, Protocol: "TCP"},
+		{ID: "lbrule_standalone", ListenPort: 22, Protocol: "TCP"},
+	}
+	plan := []LoadBalancerInlineRuleModel{
+		testLoadBalancerInlineRule("", 80, 8080, "HTTP"),
+		testLoadBalancerInlineRule("", 443, 8443, "HTTPS"),
+	}
+
+	unmanaged := unmanagedLoadBalancerRules(
+		plan, existing, []string{"lbrule_http", "lbrule_old"},
+	)
+	assert.Equal(t,
+		[]loadBalancerRuleListener{
+			{ID: "lbrule_standalone", ListenPort: 22, Protocol: "TCP"},
+		},
+		unmanaged,
+	)
+
+	var diags diag.Diagnostics
+	addUnmanagedLoadBalancerRulesError(&diags, "lb_test", unmanaged)
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.SeverityError, diags[0].Severity())
+	assert.Contains(t, diags[0].Detail(), "lbrule_standalone (port 22)")
+
+	diags = nil
+	addUnmanagedLoadBalancerRulesError(&diags, "lb_test", nil)
+	assert.Empty(t, diags)
+}
+
+func TestLoadBalancerModifyPlanRejectsUnmanagedRules(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		if r.URL.Path != "/load_balancers/load_balancer/rules" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			return
+		}
+
+		writeTestJSON(w, http.StatusOK, `{"pagination":{`+
+			`"current_page":1,"total_pages":1,"total":2,`+
+			`"per_page":30,"large_set":false},"load_balancer_rules":[`+
+			`{"id":"lbrule_http","listen_port":80,"protocol":"HTTP"},`+
+			`{"id":"lbrule_standalone","listen_port":22,"protocol":"TCP"}]}`)
+	})
+	r := &LoadBalancerResource{M: &Meta{Core: client, testMode: true}}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	newState := func(rules ...LoadBalancerInlineRuleModel) tfsdk.State {
+		value, diags := loadBalancerInlineRulesValue(
+			context.Background(), rules,
+		)
+		require.False(t, diags.HasError(), diags.Errors())
+
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		diags = state.Set(context.Background(), LoadBalancerResourceModel{
+			ID:                     types.StringValue("lb_test"),
+			Name:                   types.StringValue("web"),
+			VirtualMachineIDs:      types.SetNull(types.StringType),
+			VirtualMachineGroupIDs: types.SetNull(types.StringType),
+			TagIDs:                 types.SetNull(types.StringType),
+			IPAddress:              types.StringValue("192.0.2.1"),
+			HTTPSRedirect:          types.BoolValue(false),
+			Organization:           types.StringNull(),
+			DataCenter:             types.StringNull(),
+			Rules:                  value,
+		})
+		require.False(t, diags.HasError(), diags.Errors())
+
+		return state
+	}
+
+	state := newState(testLoadBalancerInlineRule("lbrule_http", 80, 8080, "HTTP"))
+	plan := tfsdk.Plan(newState(
+		testLoadBalancerInlineRule("lbrule_http", 80, 8081, "HTTP"),
+	))
+	resp := resource.ModifyPlanResponse{Plan: plan}
+
+	// Without private state, no rules are known to be managed by rule
+	// blocks, so the rule on port 22 belongs to something else.
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{
+		Config: tfsdk.Config(plan), Plan: plan, State: state,
+	}, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	require.Len(t, resp.Diagnostics.Errors(), 1)
+	pathDiag, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, path.Root("rule"), pathDiag.Path())
+	assert.Equal(t, "Unmanaged Load Balancer Rules", pathDiag.Summary())
+	assert.Contains(t, pathDiag.Detail(), "lbrule_standalone (port 22)")
+}
+
+func TestValidateLoadBalancerInlineRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []LoadBalancerInlineRuleModel{
+		testLoadBalancerInlineRule("", 80, 8080, "HTTP"),
+		testLoadBalancerInlineRule("", 443, 8443, "HTTPS"),
+		testLoadBalancerInlineRule("", 80, 8081, "HTTP"),
+		testLoadBalancerInlineRule("", 443, 8443, "TCP"),
+	}
+	for i := range rules {
+		rules[i].CertificateIDs = types.SetNull(types.StringType)
+	}
+
+	var diags diag.Diagnostics
+	validateLoadBalancerInlineRules(&diags, rules)
+
+	require.Len(t, diags, 2)
+
+	listenPortPath := path.Root("rule").AtListIndex(2).AtName("listen_port")
+	portDiag, ok := diags[0].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, listenPortPath, portDiag.Path())
+	assert.Equal(t, "Listen Port Conflict", portDiag.Summary())
+	assert.Equal(t,
+		"This load balancer already has another rule listening on port 80.",
+		portDiag.Detail(),
+	)
+
+	protocolPath := path.Root("rule").AtListIndex(3).AtName("protocol")
+	protocolDiag, ok := diags[1].(diag.DiagnosticWithPath)
+	require.True(t, ok)
+	assert.Equal(t, protocolPath, protocolDiag.Path())
+	assert.Equal(t, "Protocol Conflict", protocolDiag.Summary())
+}
+
+func TestReconcileLoadBalancerInlineRules(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Method string
+		Path   string
+		Body   map[string]any
+	}
+
+	live := []struct {
+		ID              string
+		ListenPort      int
+		DestinationPort int
+		Protocol        string
+	}{
+		{"lbrule_http", 80, 8080, "HTTP"},
+		{"lbrule_https", 443, 8443, "HTTPS"},
+		{"lbrule_tcp", 22, 22, "TCP"},
+	}
+
+	var mu sync.Mutex
+	var requests []request
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		if r.Method == http.MethodGet {
+			var summaries, details []string
+			for _, rule := range live {
+				body := fmt.Sprintf(
+					`{"id":%q,"algorithm":"round_robin",`+
+						`"destination_port":%d,"listen_port":%d,`+
+						`"protocol":%q,"proxy_protocol":false,`+
+						`"certificates":[],"backend_ssl":false,`+
+						`"passthrough_ssl":false,"check_enabled":false,`+
+						`"check_fall":2,"check_interval":20,`+
+						`"check_path":"/","check_protocol":"HTTP",`+
+						`"check_rise":2,"check_timeout":5,`+
+						`"check_http_statuses":"2",`+
+						`"load_balancer":{"id":"lb_test"}}`,
+					rule.ID, rule.DestinationPort, rule.ListenPort,
+					rule.Protocol,
+				)
+				summaries = append(summaries, body)
+				if r.URL.Query().Get("load_balancer_rule[id]") == rule.ID {
+					details = append(details, body)
+				}
+			}
+
+			switch r.URL.Path {
+			case "/load_balancers/load_balancer/rules":
+				writeTestJSON(w, http.StatusOK, `{"pagination":{`+
+					`"current_page":1,"total_pages":1,"total":3,`+
+					`"per_page":30,"large_set":false},`+
+					`"load_balancer_rules":[`+
+					strings.Join(summaries, ",")+`]}`)
+			case "/load_balancers/rules/load_balancer_rule":
+				require.Len(t, details, 1)
+				writeTestJSON(w, http.StatusOK,
+					`{"load_balancer_rule":`+details[0]+`}`)
+			default:
+				t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			}
+
+			return
+		}
+
+		raw, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		req := request{Method: r.Method, Path: r.URL.Path}
+		assert.NoError(t, json.Unmarshal(raw, &req.Body))
+
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+
+		writeTestJSON(w, http.StatusOK, `{}`)
+	})
+	m := &Meta{Core: client, testMode: true}
+
+	// The planned ids are unknown, as after an import: rules are matched to
+	// the live rules by listen port regardless.
+	plan := []LoadBalancerInlineRuleModel{
+		testLoadBalancerInlineRule("", 22, 22, "TCP"),
+		testLoadBalancerInlineRule("", 443, 9443, "HTTPS"),
+		testLoadBalancerInlineRule("", 8000, 8000, "TCP"),
+	}
+
+	diags := reconcileLoadBalancerInlineRules(
+		context.Background(), m, "lb_test", plan,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	require.Len(t, requests, 3)
+
+	assert.Equal(t, http.MethodDelete, requests[0].Method)
+	assert.Equal(t, "/load_balancers/rules/load_balancer_rule", requests[0].Path)
+	assert.Equal(t,
+		map[string]any{"id": "lbrule_http"},
+		requests[0].Body["load_balancer_rule"],
+	)
+
+	assert.Equal(t, http.MethodPatch, requests[1].Method)
+	assert.Equal(t, "/load_balancers/rules/load_balancer_rule", requests[1].Path)
+	assert.Equal(t,
+		map[string]any{"id": "lbrule_https"},
+		requests[1].Body["load_balancer_rule"],
+	)
+	assert.Equal(t,
+		map[string]any{"destination_port": float64(9443)},
+		requests[1].Body["properties"],
+	)
+
+	assert.Equal(t, http.MethodPost, requests[2].Method)
+	assert.Equal(t, "/load_balancers/load_balancer/rules", requests[2].Path)
+	assert.Equal(t,
+		map[string]any{"id": "lb_test"},
+		requests[2].Body["load_balancer"],
+	)
+	properties, ok := requests[2].Body["properties"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, float64(8000), properties["listen_port"])
+	assert.Equal(t, "TCP", properties["protocol"])
+}
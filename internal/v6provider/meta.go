@@ -3,7 +3,6 @@ package v6provider
 import (
 	"context"
 	"net/http"
-	"net/url"
 	"os"
 	"sync"
 	"time"
@@ -14,8 +13,38 @@ import (
 	core "github.com/krystal/go-katapult/next/core"
 
 	"github.com/krystal/go-katapult/namegenerator"
+	"github.com/krystal/terraform-provider-katapult/internal/httpconfig"
 )
 
+// ConnectionConfig holds the raw provider attributes controlling how the API
+// is reached. Empty values fall back to their environment variables.
+type ConnectionConfig struct {
+	APIURL             string
+	CACertFile         string
+	ProxyURL           string
+	InsecureSkipVerify *bool
+	Retry              httpconfig.RetrySettings
+}
+
+// httpConfig resolves c against the environment.
+func (c ConnectionConfig) httpConfig() httpconfig.Config {
+	apiURL := stringOrEnv(c.APIURL, "KATAPULT_API_URL")
+	if apiURL == "" {
+		// Legacy debug override of the API URL for internal testing.
+		apiURL = os.Getenv("KATAPULT_TF_DEBUG_API_URL")
+	}
+
+	return httpconfig.Config{
+		APIURL:     apiURL,
+		CACertFile: stringOrEnv(c.CACertFile, "KATAPULT_CA_CERT_FILE"),
+		ProxyURL:   stringOrEnv(c.ProxyURL, "KATAPULT_PROXY_URL"),
+		InsecureSkipVerify: boolOrEnv(
+			c.InsecureSkipVerify,
+			"KATAPULT_INSECURE_SKIP_VERIFY",
+		),
+	}
+}
+
 type Meta struct {
 	Core        core.ClientWithResponsesInterface
 	Logger      hclog.Logger
@@ -25,6 +54,12 @@ type Meta struct {
 	SkipTrashObjectPurge bool
 	testMode             bool
 
+	// DefaultTags holds the tag names of the provider's default_tags block,
+	// applied to every taggable resource.
+	DefaultTags        []string
+	defaultTagsMu      sync.Mutex
+	defaultTagsEnsured map[string]bool
+
 	// Raw provider attribute string values
 	confAPIKey       string
 	confDataCenter   string
@@ -90,6 +125,7 @@ func NewMeta(
 	logLevel string,
 	generatedNamePrefix string,
 	httpClient *http.Client,
+	conn ConnectionConfig,
 	version string,
 	terraformVersion string,
 ) (*Meta, error) {
@@ -130,25 +166,25 @@ func NewMeta(
 		m.GeneratedNamePrefix = defaultGeneratedNamePrefix
 	}
 
-	serverURL := &url.URL{
-		Scheme: "https",
-		Host:   "api.katapult.io",
-		Path:   "/core/v1",
-	}
+	httpConf := conn.httpConfig()
 
-	// Debug override of API URL for internal testing purposes.
-	if apiURL := os.Getenv("KATAPULT_TF_DEBUG_API_URL"); apiURL != "" {
-		u, err := url.Parse(apiURL)
-		if err != nil {
-			return nil, err
-		}
+	serverURL, err := httpConf.BaseURL()
+	if err != nil {
+		return nil, err
+	}
+	serverURL.Path = "/core/v1"
 
-		u.Path = "/core/v1"
+	httpClient, err = httpConf.HTTPClient(httpClient)
+	if err != nil {
+		return nil, err
+	}
 
-		serverURL = u
+	retry, err := conn.Retry.Resolve()
+	if err != nil {
+		return nil, err
 	}
 
-	rhc := newRetryableHTTPClient(httpClient, m.Logger)
+	rhc := newRetryableHTTPClient(httpClient, m.Logger, retry)
 	m.retryClient = rhc
 
 	coreClient, err := core.NewClientWithResponses(
@@ -82,14 +82,14 @@ func setupSharedObjectStorageAccount(
 	t.Helper()
 
 	_, err := getObjectStorageAccount(
-		tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+		tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 	)
 	switch {
 	case err == nil:
 		// Account already exists — leave it in place after the test run.
 	case errors.Is(err, core.ErrNotFound):
 		require.NoError(t, createObjectStorageAccount(
-			tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+			tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 		))
 		// Mark ownership before the waiter. Cleanup is already registered, so
 		// even a fatal waiter failure removes the account this run created.
@@ -99,7 +99,7 @@ func setupSharedObjectStorageAccount(
 	}
 
 	_, err = waitForObjectStorageAccountProvisioned(
-		tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+		tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion, 5*time.Minute,
 	)
 	require.NoError(t, err, "waiting for object storage account to provision")
 }
@@ -115,7 +115,7 @@ func teardownSharedObjectStorageAccount(
 	t.Helper()
 
 	if err := preflightObjectStorageAccountDelete(
-		tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+		tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 	); err != nil {
 		t.Errorf("object storage account preflight failed: %s", err)
 		return
@@ -11,14 +11,17 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var _ resource.ResourceWithIdentity = (*AddressListResource)(nil)
+
 type (
 	AddressListResource struct {
 		M *Meta
 	}
 
 	AddressListResourceModel struct {
-		ID   types.String `tfsdk:"id"`
-		Name types.String `tfsdk:"name"`
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		Organization types.String `tfsdk:"organization"`
 	}
 )
 
@@ -64,6 +67,7 @@ func (r *AddressListResource) Schema(
 			"name": schema.StringAttribute{
 				Required: true,
 			},
+			organizationAttributeName: organizationResourceAttribute(),
 		},
 	}
 }
@@ -80,17 +84,27 @@ func (r *AddressListResource) Create(
 		return
 	}
 
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
 	res, err := r.M.Core.PostOrganizationAddressListsWithResponse(ctx,
 		core.PostOrganizationAddressListsJSONRequestBody{
 			Organization: core.OrganizationLookup{
-				SubDomain: &r.M.confOrganization,
+				SubDomain: &org,
 			},
 			Properties: core.AddressListArguments{
 				Name: plan.Name.ValueStringPointer(),
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("create error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Address List Create Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -105,11 +119,15 @@ func (r *AddressListResource) Create(
 	id := *res.JSON201.AddressList.Id
 
 	if err := r.AddressListRead(ctx, id, &plan, &resp.State); err != nil {
-		resp.Diagnostics.AddError("Load Balancer Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Address List Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *AddressListResource) Read(
@@ -126,11 +144,15 @@ func (r *AddressListResource) Read(
 
 	err := r.AddressListRead(ctx, model.ID.ValueString(), &model, &resp.State)
 	if err != nil {
-		resp.Diagnostics.AddError("Address List Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Address List Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, model.ID)...)
 }
 
 func (r *AddressListResource) Update(
@@ -150,7 +172,7 @@ func (r *AddressListResource) Update(
 		return
 	}
 
-	_, err := r.M.Core.PatchAddressListWithResponse(ctx,
+	res, err := r.M.Core.PatchAddressListWithResponse(ctx,
 		core.PatchAddressListJSONRequestBody{
 			AddressList: core.AddressListLookup{
 				Id: state.ID.ValueStringPointer(),
@@ -160,17 +182,28 @@ func (r *AddressListResource) Update(
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("update error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Address List Update Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	err = r.AddressListRead(ctx, state.ID.ValueString(), &plan, &resp.State)
 	if err != nil {
-		resp.Diagnostics.AddError("Address List Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Address List Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *AddressListResource) Delete(
@@ -185,24 +218,41 @@ func (r *AddressListResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.DeleteAddressListWithResponse(ctx,
+	res, err := r.M.Core.DeleteAddressListWithResponse(ctx,
 		core.DeleteAddressListJSONRequestBody{
 			AddressList: core.AddressListLookup{
 				Id: state.ID.ValueStringPointer(),
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("delete error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Address List Delete Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 }
 
+func (r *AddressListResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the address list.")
+}
+
 func (r *AddressListResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 }
 
 func (r *AddressListResource) AddressListRead(
@@ -216,12 +266,16 @@ func (r *AddressListResource) AddressListRead(
 			AddressListId: &id,
 		})
 	if err != nil {
-		if res.JSON404 != nil {
+		if res != nil && res.JSON404 != nil {
 			state.RemoveResource(ctx)
 
 			return nil
 		}
 
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 
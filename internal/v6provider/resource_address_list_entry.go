@@ -11,6 +11,8 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var _ resource.ResourceWithIdentity = (*AddressListEntryResource)(nil)
+
 type (
 	AddressListEntryResource struct {
 		M *Meta
@@ -99,8 +101,14 @@ func (r *AddressListEntryResource) Create(
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("create error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
 
+		addAPIError(
+			&resp.Diagnostics, "Address List Entry Create Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -115,11 +123,15 @@ func (r *AddressListEntryResource) Create(
 	id := *res.JSON201.AddressListEntry.Id
 
 	if err := r.AddressListEntryRead(ctx, id, &plan, &resp.State); err != nil {
-		resp.Diagnostics.AddError("Load Balancer Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Address List Entry Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *AddressListEntryResource) Read(
@@ -137,11 +149,15 @@ func (r *AddressListEntryResource) Read(
 	id := model.ID.ValueString()
 	err := r.AddressListEntryRead(ctx, id, &model, &resp.State)
 	if err != nil {
-		resp.Diagnostics.AddError("Address List Entry Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Address List Entry Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, model.ID)...)
 }
 
 func (r *AddressListEntryResource) Update(
@@ -161,7 +177,7 @@ func (r *AddressListEntryResource) Update(
 		return
 	}
 
-	_, err := r.M.Core.PatchAddressListEntryWithResponse(ctx,
+	res, err := r.M.Core.PatchAddressListEntryWithResponse(ctx,
 		core.PatchAddressListEntryJSONRequestBody{
 			AddressListEntry: core.AddressListEntryLookup{
 				Id: state.ID.ValueStringPointer(),
@@ -172,19 +188,29 @@ func (r *AddressListEntryResource) Update(
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("update error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
 
+		addAPIError(
+			&resp.Diagnostics, "Address List Entry Update Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	id := state.ID.ValueString()
 	err = r.AddressListEntryRead(ctx, id, &plan, &resp.State)
 	if err != nil {
-		resp.Diagnostics.AddError("Address List Entry Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Address List Entry Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *AddressListEntryResource) Delete(
@@ -198,24 +224,41 @@ func (r *AddressListEntryResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.DeleteAddressListEntryWithResponse(ctx,
+	res, err := r.M.Core.DeleteAddressListEntryWithResponse(ctx,
 		core.DeleteAddressListEntryJSONRequestBody{
 			AddressListEntry: core.AddressListEntryLookup{
 				Id: model.ID.ValueStringPointer(),
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("delete error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Address List Entry Delete Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 }
 
+func (r *AddressListEntryResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the address list entry.")
+}
+
 func (r *AddressListEntryResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 }
 
 func (r *AddressListEntryResource) AddressListEntryRead(
@@ -229,12 +272,16 @@ func (r *AddressListEntryResource) AddressListEntryRead(
 			AddressListEntryId: &id,
 		})
 	if err != nil {
-		if res.JSON404 != nil {
+		if res != nil && res.JSON404 != nil {
 			state.RemoveResource(ctx)
 
 			return nil
 		}
 
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 
@@ -54,6 +54,24 @@ type (
 	}
 
 	DiskResourceModel struct {
+		ID                types.String   `tfsdk:"id"`
+		Name              types.String   `tfsdk:"name"`
+		SizeInGB          types.Int64    `tfsdk:"size_in_gb"`
+		InitialFileSystem types.String   `tfsdk:"initial_file_system"`
+		StorageSpeed      types.String   `tfsdk:"storage_speed"`
+		BusType           types.String   `tfsdk:"bus_type"`
+		IOProfileID       types.String   `tfsdk:"io_profile_id"`
+		ResizeMethod      types.String   `tfsdk:"resize_method"`
+		WWN               types.String   `tfsdk:"wwn"`
+		State             types.String   `tfsdk:"state"`
+		Organization      types.String   `tfsdk:"organization"`
+		DataCenter        types.String   `tfsdk:"data_center"`
+		Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	// diskResourceModelV0 is the version 0 state layout, which predates the
+	// organization and data_center overrides.
+	diskResourceModelV0 struct {
 		ID                types.String   `tfsdk:"id"`
 		Name              types.String   `tfsdk:"name"`
 		SizeInGB          types.Int64    `tfsdk:"size_in_gb"`
@@ -68,7 +86,11 @@ type (
 	}
 )
 
-var _ resource.ResourceWithModifyPlan = (*DiskResource)(nil)
+var (
+	_ resource.ResourceWithModifyPlan   = (*DiskResource)(nil)
+	_ resource.ResourceWithIdentity     = (*DiskResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*DiskResource)(nil)
+)
 
 type requiresReplaceAfterImportAdoptionModifier struct{}
 
@@ -217,6 +239,7 @@ func (r *DiskResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: diskMarkdownDescription,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -316,6 +339,8 @@ func (r *DiskResource) Schema(
 				Computed:            true,
 				MarkdownDescription: "Current state of the disk.",
 			},
+			organizationAttributeName: organizationResourceAttribute(),
+			dataCenterAttributeName:   dataCenterResourceAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -352,12 +377,16 @@ func (r *DiskResource) Create(
 
 	name := plan.Name.ValueString()
 	sizeInGB := int(plan.SizeInGB.ValueInt64())
+	org := r.M.organization(plan.Organization)
+	dataCenter := r.M.dataCenter(plan.DataCenter)
+	plan.Organization = types.StringValue(org)
+	plan.DataCenter = types.StringValue(dataCenter)
 
 	args := core.DiskArguments{
 		Name:     &name,
 		SizeInGb: &sizeInGB,
 		DataCenter: &core.DataCenterLookup{
-			Permalink: &r.M.confDataCenter,
+			Permalink: &dataCenter,
 		},
 	}
 
@@ -381,15 +410,17 @@ func (r *DiskResource) Create(
 	createRes, err := r.M.Core.PostOrganizationDisksWithResponse(ctx,
 		core.PostOrganizationDisksJSONRequestBody{
 			Organization: core.OrganizationLookup{
-				SubDomain: &r.M.confOrganization,
+				SubDomain: &org,
 			},
 			Properties: args,
 		})
 	if err != nil {
 		if createRes != nil {
-			err = genericAPIError(err, createRes.Body)
+			err = genericAPIError(err, createRes.Body, createRes.HTTPResponse)
 		}
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 	if createRes == nil || createRes.JSON201 == nil {
@@ -433,6 +464,7 @@ func (r *DiskResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *DiskResource) Read(
@@ -457,6 +489,7 @@ func (r *DiskResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *DiskResource) Update(
@@ -502,7 +535,9 @@ func (r *DiskResource) Update(
 	}
 
 	if err := r.patchDiskProperties(ctx, diskID, &plan, &state, timeout); err != nil {
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -515,7 +550,9 @@ func (r *DiskResource) Update(
 		} else {
 			resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 		}
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -531,6 +568,7 @@ func (r *DiskResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // patchDiskProperties applies mutable disk properties through the API operation
@@ -564,7 +602,7 @@ func (r *DiskResource) patchDiskProperties(
 			})
 		if err != nil {
 			if patchRes != nil {
-				return genericAPIError(err, patchRes.Body)
+				return genericAPIError(err, patchRes.Body, patchRes.HTTPResponse)
 			}
 			return err
 		}
@@ -598,7 +636,7 @@ func (r *DiskResource) updateDiskIOProfile(
 	)
 	if err != nil {
 		if profileRes != nil {
-			return genericAPIError(err, profileRes.Body)
+			return genericAPIError(err, profileRes.Body, profileRes.HTTPResponse)
 		}
 		return err
 	}
@@ -653,7 +691,7 @@ func (r *DiskResource) resizeDisk(
 		})
 	if err != nil {
 		if resizeRes != nil {
-			return genericAPIError(err, resizeRes.Body)
+			return genericAPIError(err, resizeRes.Body, resizeRes.HTTPResponse)
 		}
 		return err
 	}
@@ -697,7 +735,7 @@ func (r *DiskResource) Delete(
 			if isErrNotFoundOrInTrash(err, diskRes.JSON406) {
 				return
 			}
-			err = genericAPIError(err, diskRes.Body)
+			err = genericAPIError(err, diskRes.Body, diskRes.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
@@ -728,7 +766,7 @@ func (r *DiskResource) Delete(
 			if isErrNotFoundOrInTrash(err, delRes.JSON406) {
 				return
 			}
-			err = genericAPIError(err, delRes.Body)
+			err = genericAPIError(err, delRes.Body, delRes.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
@@ -744,17 +782,91 @@ func (r *DiskResource) Delete(
 	}
 }
 
+func (r *DiskResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the disk.")
+}
+
 func (r *DiskResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 	resp.Diagnostics.Append(resp.Private.SetKey(
 		ctx, diskImportInitialFileSystemPrivateKey, []byte("true"),
 	)...)
 }
 
+// UpgradeState migrates version 0 state, which older releases wrote without
+// every attribute of the current layout.
+func (r *DiskResource) UpgradeState(
+	ctx context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                  schema.StringAttribute{Computed: true},
+					"name":                schema.StringAttribute{Required: true},
+					"size_in_gb":          schema.Int64Attribute{Required: true},
+					"initial_file_system": schema.StringAttribute{Optional: true},
+					"storage_speed":       schema.StringAttribute{Optional: true},
+					"bus_type":            schema.StringAttribute{Optional: true},
+					"io_profile_id":       schema.StringAttribute{Optional: true},
+					"resize_method":       schema.StringAttribute{Optional: true},
+					"wwn":                 schema.StringAttribute{Computed: true},
+					stateAttributeName:    schema.StringAttribute{Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+						Update: true,
+						Delete: true,
+					}),
+				},
+			},
+			StateUpgrader: upgradeDiskStateV0,
+		},
+	}
+}
+
+func upgradeDiskStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior diskResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := DiskResourceModel{
+		ID:                prior.ID,
+		Name:              prior.Name,
+		SizeInGB:          prior.SizeInGB,
+		InitialFileSystem: prior.InitialFileSystem,
+		StorageSpeed:      prior.StorageSpeed,
+		BusType:           prior.BusType,
+		IOProfileID:       prior.IOProfileID,
+		ResizeMethod:      prior.ResizeMethod,
+		WWN:               prior.WWN,
+		State:             prior.State,
+		Timeouts:          prior.Timeouts,
+	}
+
+	if state.ResizeMethod.IsNull() {
+		state.ResizeMethod = types.StringValue("offline")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
 func (r *DiskResource) diskRead(
 	ctx context.Context,
 	id string,
@@ -767,7 +879,7 @@ func (r *DiskResource) diskRead(
 			if isErrNotFoundOrInTrash(err, res.JSON406) {
 				return core.ErrNotFound
 			}
-			return genericAPIError(err, res.Body)
+			return genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
@@ -829,7 +941,7 @@ func readStandaloneDiskRelationship(ctx context.Context, m *Meta, diskID string)
 			return obs, core.ErrNotFound
 		}
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return obs, err
 	}
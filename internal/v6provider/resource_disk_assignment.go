@@ -8,13 +8,16 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/krystal/go-katapult/next/core"
@@ -34,6 +37,13 @@ type DiskAssignmentResourceModel struct {
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
+// DiskAssignmentIdentityModel is the identity of a disk assignment: the
+// Virtual Machine and disk it relates.
+type DiskAssignmentIdentityModel struct {
+	VirtualMachineID types.String `tfsdk:"virtual_machine_id"`
+	DiskID           types.String `tfsdk:"disk_id"`
+}
+
 type diskAssignmentObservation struct {
 	vmState         core.VirtualMachineStateEnum
 	vmID            string
@@ -172,7 +182,9 @@ func (r *DiskAssignmentResource) Create(
 
 	obs, err := readDiskAssignmentObservation(ctx, r.M, vmID, diskID)
 	if err != nil {
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 	if obs.vmState != core.Started && obs.vmState != core.Stopped {
@@ -200,6 +212,7 @@ func (r *DiskAssignmentResource) Create(
 		plan.AttachmentState = types.StringNull()
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setDiskAssignmentIdentity(ctx, resp.Identity, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -211,9 +224,11 @@ func (r *DiskAssignmentResource) Create(
 		})
 	if err != nil {
 		if assignRes != nil {
-			err = genericAPIError(err, assignRes.Body)
+			err = genericAPIError(err, assignRes.Body, assignRes.HTTPResponse)
 		}
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 	if assignRes == nil || assignRes.JSON200 == nil {
@@ -226,8 +241,11 @@ func (r *DiskAssignmentResource) Create(
 		defer cancelRefresh()
 		if readErr := r.readIntoModel(refreshCtx, &plan); readErr == nil {
 			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.Append(setDiskAssignmentIdentity(ctx, resp.Identity, plan)...)
 		}
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 	if err = r.readIntoModel(ctx, &plan); err != nil {
@@ -235,6 +253,7 @@ func (r *DiskAssignmentResource) Create(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setDiskAssignmentIdentity(ctx, resp.Identity, plan)...)
 }
 
 func (r *DiskAssignmentResource) Read(
@@ -256,6 +275,7 @@ func (r *DiskAssignmentResource) Read(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setDiskAssignmentIdentity(ctx, resp.Identity, state)...)
 }
 
 func (r *DiskAssignmentResource) Update(
@@ -283,8 +303,11 @@ func (r *DiskAssignmentResource) Update(
 		defer cancelRefresh()
 		if readErr := r.readIntoModel(refreshCtx, &plan); readErr == nil {
 			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.Append(setDiskAssignmentIdentity(ctx, resp.Identity, plan)...)
 		}
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 	if err := r.readIntoModel(ctx, &plan); err != nil {
@@ -292,6 +315,7 @@ func (r *DiskAssignmentResource) Update(
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setDiskAssignmentIdentity(ctx, resp.Identity, plan)...)
 }
 
 //nolint:gocyclo,lll // Safety guards are deliberately kept in lifecycle order.
@@ -392,7 +416,7 @@ func (r *DiskAssignmentResource) Delete(
 		core.PostDiskUnassignJSONRequestBody{Disk: core.DiskLookup{Id: &diskID}})
 	if err != nil {
 		if unassignRes != nil {
-			err = genericAPIError(err, unassignRes.Body)
+			err = genericAPIError(err, unassignRes.Body, unassignRes.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
@@ -406,13 +430,41 @@ func (r *DiskAssignmentResource) Delete(
 	}
 }
 
+func (r *DiskAssignmentResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"virtual_machine_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the Virtual Machine the disk is assigned to.",
+			},
+			"disk_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the assigned disk.",
+			},
+		},
+	}
+}
+
 //nolint:lll // Preserve complete import diagnostics.
 func (r *DiskAssignmentResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	vmID, diskID, err := parseAssignmentID(req.ID)
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var identity DiskAssignmentIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = assignmentID(identity.VirtualMachineID.ValueString(), identity.DiskID.ValueString())
+	}
+	vmID, diskID, err := parseAssignmentID(importID)
 	if err != nil {
 		resp.Diagnostics.AddError("Import Error", err.Error())
 		return
@@ -449,6 +501,12 @@ func (r *DiskAssignmentResource) ImportState(
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attached"), attached)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attach_on_boot"), *obs.attachOnBoot)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attachment_state"), string(*obs.attachmentState))...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, DiskAssignmentIdentityModel{
+			VirtualMachineID: types.StringValue(vmID),
+			DiskID:           types.StringValue(diskID),
+		})...)
+	}
 }
 
 func (r *DiskAssignmentResource) readIntoModel(ctx context.Context, model *DiskAssignmentResourceModel) error {
@@ -524,6 +582,23 @@ func projectDiskAssignmentAttached(desired types.Bool, obs diskAssignmentObserva
 
 func assignmentID(vmID, diskID string) string { return vmID + "/" + diskID }
 
+// setDiskAssignmentIdentity records the endpoints of model as the identity of
+// a disk assignment.
+func setDiskAssignmentIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	model DiskAssignmentResourceModel,
+) diag.Diagnostics {
+	if identity == nil || model.VirtualMachineID.IsNull() || model.DiskID.IsNull() {
+		return nil
+	}
+
+	return identity.Set(ctx, DiskAssignmentIdentityModel{
+		VirtualMachineID: model.VirtualMachineID,
+		DiskID:           model.DiskID,
+	})
+}
+
 func parseAssignmentID(id string) (string, string, error) {
 	parts := strings.Split(id, "/")
 	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
@@ -543,7 +618,7 @@ func readDiskAssignmentObservation(ctx context.Context, m *Meta, vmID, diskID st
 			return obs, core.ErrNotFound
 		}
 		if vmRes != nil {
-			err = genericAPIError(err, vmRes.Body)
+			err = genericAPIError(err, vmRes.Body, vmRes.HTTPResponse)
 		}
 		return obs, err
 	}
@@ -558,7 +633,7 @@ func readDiskAssignmentObservation(ctx context.Context, m *Meta, vmID, diskID st
 			return obs, core.ErrNotFound
 		}
 		if diskRes != nil {
-			err = genericAPIError(err, diskRes.Body)
+			err = genericAPIError(err, diskRes.Body, diskRes.HTTPResponse)
 		}
 		return obs, err
 	}
@@ -691,7 +766,7 @@ func patchDiskAttachOnBoot(ctx context.Context, m *Meta, diskID string, value bo
 	})
 	if err != nil {
 		if res != nil {
-			return genericAPIError(err, res.Body)
+			return genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
@@ -706,7 +781,7 @@ func attachDiskAndWait(ctx context.Context, m *Meta, diskID string, timeout time
 		core.PostDiskAttachJSONRequestBody{Disk: core.DiskLookup{Id: &diskID}})
 	if err != nil {
 		if res != nil {
-			return genericAPIError(err, res.Body)
+			return genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
@@ -727,7 +802,7 @@ func detachDiskAndWait(ctx context.Context, m *Meta, diskID string, timeout time
 			}
 		}
 		if res != nil {
-			return genericAPIError(err, res.Body)
+			return genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
@@ -771,4 +846,7 @@ func waitForDiskAssignmentAbsent(
 	return nil
 }
 
-var _ resource.ResourceWithImportState = (*DiskAssignmentResource)(nil)
+var (
+	_ resource.ResourceWithImportState = (*DiskAssignmentResource)(nil)
+	_ resource.ResourceWithIdentity    = (*DiskAssignmentResource)(nil)
+)
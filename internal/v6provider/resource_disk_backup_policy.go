@@ -0,0 +1,552 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var (
+	_ resource.ResourceWithConfigValidators = (*DiskBackupPolicyResource)(nil)
+	_ resource.ResourceWithIdentity         = (*DiskBackupPolicyResource)(nil)
+)
+
+type (
+	DiskBackupPolicyResource struct {
+		M *Meta
+	}
+
+	DiskBackupPolicyResourceModel struct {
+		ID               types.String `tfsdk:"id"`
+		DiskID           types.String `tfsdk:"disk_id"`
+		VirtualMachineID types.String `tfsdk:"virtual_machine_id"`
+		Retention        types.Int64  `tfsdk:"retention"`
+		Schedule         types.Object `tfsdk:"schedule"`
+	}
+
+	DiskBackupPolicyScheduleModel struct {
+		Interval  types.String `tfsdk:"interval"`
+		Frequency types.Int64  `tfsdk:"frequency"`
+		Time      types.Int64  `tfsdk:"time"`
+		Minute    types.Int64  `tfsdk:"minute"`
+	}
+)
+
+var (
+	diskBackupPolicyScheduleAttrTypes = map[string]attr.Type{
+		"interval":  types.StringType,
+		"frequency": types.Int64Type,
+		"time":      types.Int64Type,
+		"minute":    types.Int64Type,
+	}
+
+	diskBackupPolicyIntervals = []string{
+		string(core.Hourly),
+		string(core.Daily),
+		string(core.Weekly),
+		string(core.Monthly),
+	}
+)
+
+const (
+	diskBackupPolicyIDDescription     = "The ID of the disk backup policy."
+	diskBackupPolicyDiskIDDescription = "The ID of the disk to back up."
+	diskBackupPolicyVMIDDescription   = "The ID of the virtual machine to " +
+		"back up. All disks attached to the virtual machine are included."
+	diskBackupPolicyRetentionDescription = "The number of backups to keep " +
+		"before the oldest is removed."
+	diskBackupPolicyIntervalDescription = "How often backups are taken " +
+		"(`hourly`, `daily`, `weekly`, or `monthly`)."
+	diskBackupPolicyFrequencyDescription = "The number of intervals " +
+		"between each backup."
+	diskBackupPolicyTimeDescription = "The hour of the day (0-23) that " +
+		"backups are taken at."
+	diskBackupPolicyMinuteDescription = "The minute past the hour (0-59) " +
+		"that backups are taken at."
+)
+
+func (r *DiskBackupPolicyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_disk_backup_policy"
+}
+
+func (r *DiskBackupPolicyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	r.M = meta
+}
+
+func (r *DiskBackupPolicyResource) ConfigValidators(
+	_ context.Context,
+) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("disk_id"),
+			path.MatchRoot("virtual_machine_id"),
+		),
+	}
+}
+
+func (r *DiskBackupPolicyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage a backup policy for a disk, or for all disks " +
+			"attached to a virtual machine.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: diskBackupPolicyIDDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"disk_id": schema.StringAttribute{
+				Optional:    true,
+				Description: diskBackupPolicyDiskIDDescription,
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_machine_id": schema.StringAttribute{
+				Optional:    true,
+				Description: diskBackupPolicyVMIDDescription,
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention": schema.Int64Attribute{
+				Required:    true,
+				Description: diskBackupPolicyRetentionDescription,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "When backups are taken.",
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.StringAttribute{
+						Required:            true,
+						Description:         diskBackupPolicyIntervalDescription,
+						MarkdownDescription: diskBackupPolicyIntervalDescription,
+						Validators: []validator.String{
+							stringvalidator.OneOf(diskBackupPolicyIntervals...),
+						},
+					},
+					"frequency": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Description: diskBackupPolicyFrequencyDescription,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"time": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Description: diskBackupPolicyTimeDescription,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 23),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"minute": schema.Int64Attribute{
+						Optional:    true,
+						Computed:    true,
+						Description: diskBackupPolicyMinuteDescription,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 59),
+						},
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DiskBackupPolicyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan DiskBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args, diags := buildDiskBackupPolicyArgs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id *string
+	if !plan.DiskID.IsNull() {
+		res, err := r.M.Core.PostDiskDiskBackupPoliciesWithResponse(ctx,
+			core.PostDiskDiskBackupPoliciesJSONRequestBody{
+				Disk: core.DiskLookup{
+					Id: plan.DiskID.ValueStringPointer(),
+				},
+				Properties: args,
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			addAPIError(
+				&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
+
+		id = res.JSON200.DiskBackupPolicy.Id
+	} else {
+		res, err := r.M.Core.PostVirtualMachineDiskBackupPoliciesWithResponse(ctx,
+			core.PostVirtualMachineDiskBackupPoliciesJSONRequestBody{
+				VirtualMachine: core.VirtualMachineLookup{
+					Id: plan.VirtualMachineID.ValueStringPointer(),
+				},
+				Properties: args,
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			addAPIError(
+				&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
+
+		id = res.JSON200.DiskBackupPolicy.Id
+	}
+
+	if id == nil {
+		resp.Diagnostics.AddError("Create Error", "missing ID in response")
+		return
+	}
+
+	plan.ID = types.StringPointerValue(id)
+
+	if err := r.DiskBackupPolicyRead(ctx, *id, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *DiskBackupPolicyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state DiskBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DiskBackupPolicyRead(ctx, state.ID.ValueString(), &state)
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			r.M.Logger.Info(
+				"Disk Backup Policy not found, removing from state",
+				"id", state.ID.ValueString(),
+			)
+
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *DiskBackupPolicyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan DiskBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DiskBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args, diags := buildDiskBackupPolicyArgs(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.M.Core.PatchDiskBackupPolicyWithResponse(ctx,
+		core.PatchDiskBackupPolicyJSONRequestBody{
+			DiskBackupPolicy: core.DiskBackupPolicyLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+			Properties: args,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+
+	err = r.DiskBackupPolicyRead(ctx, state.ID.ValueString(), &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *DiskBackupPolicyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state DiskBackupPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.M.Core.DeleteDiskBackupPolicyWithResponse(ctx,
+		core.DeleteDiskBackupPolicyJSONRequestBody{
+			DiskBackupPolicy: core.DiskBackupPolicyLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+		})
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return
+		}
+
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+}
+
+func (r *DiskBackupPolicyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the disk backup policy.")
+}
+
+func (r *DiskBackupPolicyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+func (r *DiskBackupPolicyResource) DiskBackupPolicyRead(
+	ctx context.Context,
+	id string,
+	model *DiskBackupPolicyResourceModel,
+) error {
+	res, err := r.M.Core.GetDiskBackupPolicyWithResponse(ctx,
+		&core.GetDiskBackupPolicyParams{
+			DiskBackupPolicyId: &id,
+		})
+	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return err
+	}
+
+	policy := res.JSON200.DiskBackupPolicy
+
+	diskID, vmID, err := diskBackupPolicyTargetIDs(policy.Target)
+	if err != nil {
+		return err
+	}
+
+	model.ID = types.StringPointerValue(policy.Id)
+	model.DiskID = diskID
+	model.VirtualMachineID = vmID
+	model.Retention = intPointerValue(policy.Retention)
+
+	schedule := DiskBackupPolicyScheduleModel{
+		Interval:  types.StringNull(),
+		Frequency: types.Int64Null(),
+		Time:      types.Int64Null(),
+		Minute:    types.Int64Null(),
+	}
+	if s := policy.Schedule; s != nil {
+		schedule.Interval = stringerPointerValue(s.Interval)
+		schedule.Frequency = intPointerValue(s.Frequency)
+		schedule.Time = intPointerValue(s.Time)
+		schedule.Minute = intPointerValue(s.Minute)
+	}
+
+	obj, diags := types.ObjectValueFrom(
+		ctx, diskBackupPolicyScheduleAttrTypes, schedule,
+	)
+	if diags.HasError() {
+		return fmt.Errorf("failed to build schedule: %v", diags.Errors())
+	}
+
+	model.Schedule = obj
+
+	return nil
+}
+
+func buildDiskBackupPolicyArgs(
+	ctx context.Context,
+	model DiskBackupPolicyResourceModel,
+) (core.DiskBackupPolicyArguments, diag.Diagnostics) {
+	args := core.DiskBackupPolicyArguments{
+		Retention: ptr(int(model.Retention.ValueInt64())),
+	}
+
+	var schedule DiskBackupPolicyScheduleModel
+	diags := model.Schedule.As(ctx, &schedule, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return args, diags
+	}
+
+	interval := core.ScheduleIntervalTypeEnum(schedule.Interval.ValueString())
+	args.Schedule = &core.ScheduleArguments{
+		Interval: &interval,
+	}
+
+	if !schedule.Frequency.IsNull() && !schedule.Frequency.IsUnknown() {
+		args.Schedule.Frequency = ptr(int(schedule.Frequency.ValueInt64()))
+	}
+	if !schedule.Time.IsNull() && !schedule.Time.IsUnknown() {
+		args.Schedule.Time = ptr(int(schedule.Time.ValueInt64()))
+	}
+	if !schedule.Minute.IsNull() && !schedule.Minute.IsUnknown() {
+		args.Schedule.Minute = ptr(int(schedule.Minute.ValueInt64()))
+	}
+
+	return args, diags
+}
+
+// diskBackupPolicyTargetIDs returns the disk or virtual machine ID a backup
+// policy applies to. The API returns the target as an untagged union, so
+// virtual machines are told apart from disks by their hostname.
+func diskBackupPolicyTargetIDs(
+	target *core.DiskBackupPolicyTarget,
+) (types.String, types.String, error) {
+	if target == nil || target.Target == nil {
+		return types.StringNull(), types.StringNull(), nil
+	}
+
+	raw, err := target.Target.MarshalJSON()
+	if err != nil {
+		return types.StringNull(), types.StringNull(), err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return types.StringNull(), types.StringNull(), err
+	}
+
+	_, hasFQDN := fields["fqdn"]
+	_, hasHostname := fields["hostname"]
+	if hasFQDN || hasHostname {
+		vm, err := target.Target.AsVirtualMachine()
+		if err != nil {
+			return types.StringNull(), types.StringNull(), err
+		}
+
+		return types.StringNull(), types.StringPointerValue(vm.Id), nil
+	}
+
+	disk, err := target.Target.AsDisk()
+	if err != nil {
+		return types.StringNull(), types.StringNull(), err
+	}
+
+	return types.StringPointerValue(disk.Id), types.StringNull(), nil
+}
@@ -0,0 +1,456 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jimeh/undent"
+	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccKatapultDiskBackupPolicy_update(t *testing.T) {
+	tt := newTestTools(t)
+
+	name := tt.ResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckKatapultDiskBackupPolicyDestroy(tt),
+			testAccCheckKatapultDiskDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: undent.Stringf(`
+					resource "katapult_disk" "data" {
+						name       = "%s"
+						size_in_gb = 20
+					}
+
+					resource "katapult_disk_backup_policy" "data" {
+						disk_id   = katapult_disk.data.id
+						retention = 7
+
+						schedule = {
+							interval = "daily"
+							time     = 3
+						}
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultDiskBackupPolicyExists(
+						tt, "katapult_disk_backup_policy.data",
+					),
+					resource.TestCheckResourceAttrPair(
+						"katapult_disk_backup_policy.data", "disk_id",
+						"katapult_disk.data", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_disk_backup_policy.data", "retention", "7",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_disk_backup_policy.data",
+						"schedule.interval", "daily",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_disk_backup_policy.data", "schedule.time", "3",
+					),
+				),
+			},
+			{
+				Config: undent.Stringf(`
+					resource "katapult_disk" "data" {
+						name       = "%s"
+						size_in_gb = 20
+					}
+
+					resource "katapult_disk_backup_policy" "data" {
+						disk_id   = katapult_disk.data.id
+						retention = 24
+
+						schedule = {
+							interval = "hourly"
+							minute   = 30
+						}
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultDiskBackupPolicyExists(
+						tt, "katapult_disk_backup_policy.data",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_disk_backup_policy.data", "retention", "24",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_disk_backup_policy.data",
+						"schedule.interval", "hourly",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_disk_backup_policy.data",
+						"schedule.minute", "30",
+					),
+				),
+			},
+			{
+				ResourceName:      "katapult_disk_backup_policy.data",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestDiskBackupPolicyResourceCreate(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]any
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		switch {
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/disks/disk/disk_backup_policies":
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &body))
+
+			writeTestJSON(w, http.StatusOK, `{
+				"disk_backup_policy": {"id": "dbp_test"}
+			}`)
+		case r.Method == http.MethodGet &&
+			r.URL.Path == "/disk_backup_policies/disk_backup_policy":
+			assert.Equal(
+				t, "dbp_test",
+				r.URL.Query().Get("disk_backup_policy[id]"),
+			)
+
+			writeTestJSON(w, http.StatusOK, `{
+				"disk_backup_policy": {
+					"id": "dbp_test",
+					"retention": 7,
+					"schedule": {
+						"interval": "daily",
+						"frequency": 1,
+						"time": 3,
+						"minute": 0,
+						"next_invocation_at": 1767236400
+					},
+					"target": {
+						"target": {"id": "disk_test", "name": "data"}
+					},
+					"total_size": 0
+				}
+			}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			writeTestJSON(w, http.StatusNotFound, `{}`)
+		}
+	})
+
+	r := &DiskBackupPolicyResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	plan := DiskBackupPolicyResourceModel{
+		ID:        types.StringUnknown(),
+		DiskID:    types.StringValue("disk_test"),
+		Retention: types.Int64Value(7),
+		Schedule: diskBackupPolicyTestSchedule(
+			"daily", types.Int64Unknown(), types.Int64Value(3),
+		),
+	}
+	state := diskBackupPolicyTestState(t, r, plan)
+
+	resp := frameworkresource.CreateResponse{
+		State: tfsdk.State{Schema: state.Schema},
+	}
+	r.Create(
+		context.Background(),
+		frameworkresource.CreateRequest{
+			Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	assert.Equal(t, map[string]any{
+		"disk": map[string]any{"id": "disk_test"},
+		"properties": map[string]any{
+			"retention": float64(7),
+			"schedule": map[string]any{
+				"interval": "daily",
+				"time":     float64(3),
+			},
+		},
+	}, body)
+
+	var got DiskBackupPolicyResourceModel
+	diags := resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, DiskBackupPolicyResourceModel{
+		ID:               types.StringValue("dbp_test"),
+		DiskID:           types.StringValue("disk_test"),
+		VirtualMachineID: types.StringNull(),
+		Retention:        types.Int64Value(7),
+		Schedule: types.ObjectValueMust(
+			diskBackupPolicyScheduleAttrTypes,
+			map[string]attr.Value{
+				"interval":  types.StringValue("daily"),
+				"frequency": types.Int64Value(1),
+				"time":      types.Int64Value(3),
+				"minute":    types.Int64Value(0),
+			},
+		),
+	}, got)
+}
+
+func TestDiskBackupPolicyResourceReadVirtualMachineTarget(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusOK, `{
+			"disk_backup_policy": {
+				"id": "dbp_test",
+				"retention": 24,
+				"schedule": {"interval": "hourly", "frequency": 1, "minute": 30},
+				"target": {
+					"target": {
+						"id": "vm_test",
+						"hostname": "web-1",
+						"fqdn": "web-1.example.com"
+					}
+				}
+			}
+		}`)
+	})
+	r := &DiskBackupPolicyResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := diskBackupPolicyTestState(t, r, DiskBackupPolicyResourceModel{
+		ID: types.StringValue("dbp_test"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got DiskBackupPolicyResourceModel
+	diags := resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.True(t, got.DiskID.IsNull())
+	assert.Equal(t, "vm_test", got.VirtualMachineID.ValueString())
+	assert.Equal(t, int64(24), got.Retention.ValueInt64())
+	assert.True(t, got.Schedule.Equal(types.ObjectValueMust(
+		diskBackupPolicyScheduleAttrTypes,
+		map[string]attr.Value{
+			"interval":  types.StringValue("hourly"),
+			"frequency": types.Int64Value(1),
+			"time":      types.Int64Null(),
+			"minute":    types.Int64Value(30),
+		},
+	)))
+}
+
+func TestDiskBackupPolicyResourceReadRemovesMissingResource(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusNotFound, `{
+			"error": {
+				"code": "disk_backup_policy_not_found",
+				"description": "No disk backup policy was found"
+			}
+		}`)
+	})
+	r := &DiskBackupPolicyResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := diskBackupPolicyTestState(t, r, DiskBackupPolicyResourceModel{
+		ID: types.StringValue("dbp_missing"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.True(
+		t, resp.State.Raw.IsNull(),
+		"missing disk backup policy should be removed from state",
+	)
+}
+
+func TestDiskBackupPolicyTargetIDs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		target string
+		diskID types.String
+		vmID   types.String
+	}{
+		{
+			name:   "disk",
+			target: `{"target": {"id": "disk_test", "size_in_gb": 10}}`,
+			diskID: types.StringValue("disk_test"),
+			vmID:   types.StringNull(),
+		},
+		{
+			name:   "virtual machine",
+			target: `{"target": {"id": "vm_test", "fqdn": "a.example.com"}}`,
+			diskID: types.StringNull(),
+			vmID:   types.StringValue("vm_test"),
+		},
+		{
+			name:   "missing",
+			target: `{}`,
+			diskID: types.StringNull(),
+			vmID:   types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var target core.DiskBackupPolicyTarget
+			require.NoError(t, json.Unmarshal([]byte(tt.target), &target))
+
+			diskID, vmID, err := diskBackupPolicyTargetIDs(&target)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.diskID, diskID)
+			assert.Equal(t, tt.vmID, vmID)
+		})
+	}
+}
+
+//
+// Helpers
+//
+
+func testAccCheckKatapultDiskBackupPolicyExists(
+	tt *testTools,
+	res string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+
+		resp, err := tt.Meta.Core.GetDiskBackupPolicyWithResponse(tt.Ctx,
+			&core.GetDiskBackupPolicyParams{
+				DiskBackupPolicyId: &rs.Primary.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		policy := resp.JSON200.DiskBackupPolicy
+
+		return resource.TestCheckResourceAttr(res, "id", *policy.Id)(s)
+	}
+}
+
+func testAccCheckKatapultDiskBackupPolicyDestroy(
+	tt *testTools,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "katapult_disk_backup_policy" {
+				continue
+			}
+
+			_, err := tt.Meta.Core.GetDiskBackupPolicyWithResponse(tt.Ctx,
+				&core.GetDiskBackupPolicyParams{
+					DiskBackupPolicyId: &rs.Primary.ID,
+				},
+			)
+			if err == nil {
+				return fmt.Errorf(
+					"katapult_disk_backup_policy %s was not destroyed",
+					rs.Primary.ID,
+				)
+			}
+			if !errors.Is(err, core.ErrNotFound) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func diskBackupPolicyTestSchedule(
+	interval string,
+	frequency types.Int64,
+	hour types.Int64,
+) types.Object {
+	return types.ObjectValueMust(
+		diskBackupPolicyScheduleAttrTypes,
+		map[string]attr.Value{
+			"interval":  types.StringValue(interval),
+			"frequency": frequency,
+			"time":      hour,
+			"minute":    types.Int64Unknown(),
+		},
+	)
+}
+
+func diskBackupPolicyTestState(
+	t *testing.T,
+	r *DiskBackupPolicyResource,
+	model DiskBackupPolicyResourceModel,
+) tfsdk.State {
+	t.Helper()
+
+	if model.Schedule.IsNull() {
+		model.Schedule = types.ObjectNull(diskBackupPolicyScheduleAttrTypes)
+	}
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(
+		context.Background(),
+		frameworkresource.SchemaRequest{},
+		schemaResp,
+	)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return state
+}
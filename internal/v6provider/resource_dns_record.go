@@ -0,0 +1,826 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ resource.ResourceWithIdentity = (*DNSRecordResource)(nil)
+
+type (
+	DNSRecordResource struct {
+		M *Meta
+	}
+
+	DNSRecordResourceModel struct {
+		ID       types.String `tfsdk:"id"`
+		ZoneID   types.String `tfsdk:"zone_id"`
+		Name     types.String `tfsdk:"name"`
+		FullName types.String `tfsdk:"full_name"`
+		Type     types.String `tfsdk:"type"`
+		TTL      types.Int64  `tfsdk:"ttl"`
+		Priority types.Int64  `tfsdk:"priority"`
+		Content  types.String `tfsdk:"content"`
+		SRV      types.Object `tfsdk:"srv"`
+		CAA      types.Object `tfsdk:"caa"`
+	}
+
+	DNSRecordSRVModel struct {
+		Weight types.Int64  `tfsdk:"weight"`
+		Port   types.Int64  `tfsdk:"port"`
+		Target types.String `tfsdk:"target"`
+	}
+
+	DNSRecordCAAModel struct {
+		Flag  types.Int64  `tfsdk:"flag"`
+		Tag   types.String `tfsdk:"tag"`
+		Value types.String `tfsdk:"value"`
+	}
+)
+
+var dnsRecordTypes = []string{
+	string(core.DNSRecordTypesEnumA),
+	string(core.DNSRecordTypesEnumAAAA),
+	string(core.DNSRecordTypesEnumCNAME),
+	string(core.DNSRecordTypesEnumMX),
+	string(core.DNSRecordTypesEnumTXT),
+	string(core.DNSRecordTypesEnumSRV),
+	string(core.DNSRecordTypesEnumCAA),
+}
+
+const (
+	dnsRecordIDDescription     = "The ID of the DNS record."
+	dnsRecordZoneIDDescription = "The ID of the DNS zone the record " +
+		"belongs to."
+	dnsRecordNameDescription = "The name of the record, relative to the " +
+		"zone. Leave empty for the apex of the zone."
+	dnsRecordFullNameDescription = "The fully qualified name of the record."
+	dnsRecordTTLDescription      = "The TTL of the record in seconds. If " +
+		"not set, the default TTL of the zone is used."
+	dnsRecordPriorityDescription = "The priority of the record. Required " +
+		"for `MX` and `SRV` records, and not allowed for other types."
+	dnsRecordSRVDescription = "The content of a `SRV` record. Required " +
+		"for, and only allowed on, `SRV` records."
+	dnsRecordCAADescription = "The content of a `CAA` record. Required " +
+		"for, and only allowed on, `CAA` records."
+)
+
+var (
+	dnsRecordTypeDescription = "The type of the record. One of " +
+		"`" + strings.Join(dnsRecordTypes, "`, `") + "`."
+	dnsRecordContentDescription = "The content of the record. An IPv4 " +
+		"address for `A` records, an IPv6 address for `AAAA` records, a " +
+		"hostname for `CNAME` and `MX` records, and the text for `TXT` " +
+		"records. Not allowed for `SRV` and `CAA` records."
+
+	dnsRecordSRVAttrTypes = map[string]attr.Type{
+		"weight": types.Int64Type,
+		"port":   types.Int64Type,
+		"target": types.StringType,
+	}
+	dnsRecordCAAAttrTypes = map[string]attr.Type{
+		"flag":  types.Int64Type,
+		"tag":   types.StringType,
+		"value": types.StringType,
+	}
+)
+
+func (r *DNSRecordResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *DNSRecordResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	r.M = meta
+}
+
+func (r *DNSRecordResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         dnsRecordIDDescription,
+				MarkdownDescription: dnsRecordIDDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Required:            true,
+				Description:         dnsRecordZoneIDDescription,
+				MarkdownDescription: dnsRecordZoneIDDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         dnsRecordNameDescription,
+				MarkdownDescription: dnsRecordNameDescription,
+			},
+			"full_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         dnsRecordFullNameDescription,
+				MarkdownDescription: dnsRecordFullNameDescription,
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         dnsRecordTypeDescription,
+				MarkdownDescription: dnsRecordTypeDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dnsRecordTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         dnsRecordTTLDescription,
+				MarkdownDescription: dnsRecordTTLDescription,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				Optional:            true,
+				Description:         dnsRecordPriorityDescription,
+				MarkdownDescription: dnsRecordPriorityDescription,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Description:         dnsRecordContentDescription,
+				MarkdownDescription: dnsRecordContentDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"srv": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         dnsRecordSRVDescription,
+				MarkdownDescription: dnsRecordSRVDescription,
+				Attributes: map[string]schema.Attribute{
+					"weight": schema.Int64Attribute{
+						Required:    true,
+						Description: "The weight of the record.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 65535),
+						},
+					},
+					"port": schema.Int64Attribute{
+						Required:    true,
+						Description: "The port of the service.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 65535),
+						},
+					},
+					"target": schema.StringAttribute{
+						Required:    true,
+						Description: "The hostname providing the service.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+			"caa": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         dnsRecordCAADescription,
+				MarkdownDescription: dnsRecordCAADescription,
+				Attributes: map[string]schema.Attribute{
+					"flag": schema.Int64Attribute{
+						Required:    true,
+						Description: "The flag of the record, usually 0.",
+						Validators: []validator.Int64{
+							int64validator.Between(0, 255),
+						},
+					},
+					"tag": schema.StringAttribute{
+						Required: true,
+						Description: "The property tag of the record. One " +
+							"of `issue`, `issuewild`, or `iodef`.",
+						MarkdownDescription: "The property tag of the " +
+							"record. One of `issue`, `issuewild`, or `iodef`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("issue", "issuewild", "iodef"),
+						},
+					},
+					"value": schema.StringAttribute{
+						Required:    true,
+						Description: "The value of the property.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSRecordResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.IsUnknown() || data.Type.IsNull() {
+		return
+	}
+
+	recordType := data.Type.ValueString()
+
+	switch recordType {
+	case "SRV", "CAA":
+		if !data.Content.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf(
+					"content cannot be set for %s records, use the %s "+
+						"attribute instead",
+					recordType, strings.ToLower(recordType),
+				),
+			)
+		}
+	default:
+		if data.Content.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("content is required for %s records", recordType),
+			)
+		}
+	}
+
+	if recordType == "SRV" && data.SRV.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("srv"),
+			"Missing Attribute Configuration",
+			"srv is required for SRV records",
+		)
+	}
+	if recordType != "SRV" && !data.SRV.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("srv"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("srv cannot be set for %s records", recordType),
+		)
+	}
+
+	if recordType == "CAA" && data.CAA.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("caa"),
+			"Missing Attribute Configuration",
+			"caa is required for CAA records",
+		)
+	}
+	if recordType != "CAA" && !data.CAA.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("caa"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("caa cannot be set for %s records", recordType),
+		)
+	}
+
+	switch recordType {
+	case "MX", "SRV":
+		if data.Priority.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("priority"),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("priority is required for %s records", recordType),
+			)
+		}
+	default:
+		if !data.Priority.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("priority"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf(
+					"priority cannot be set for %s records", recordType,
+				),
+			)
+		}
+	}
+
+	if recordType == "CNAME" && !data.Name.IsUnknown() &&
+		data.Name.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Invalid Attribute Value",
+			"CNAME records cannot be created at the apex of a zone",
+		)
+	}
+
+	if data.Content.IsNull() || data.Content.IsUnknown() {
+		return
+	}
+
+	content := data.Content.ValueString()
+	ip := net.ParseIP(content)
+
+	switch recordType {
+	case "A":
+		if ip == nil || ip.To4() == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid Attribute Value",
+				fmt.Sprintf(
+					"content must be an IPv4 address for A records, got: %s",
+					content,
+				),
+			)
+		}
+	case "AAAA":
+		if ip == nil || ip.To4() != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid Attribute Value",
+				fmt.Sprintf(
+					"content must be an IPv6 address for AAAA records, "+
+						"got: %s",
+					content,
+				),
+			)
+		}
+	case "CNAME", "MX":
+		if ip != nil || strings.ContainsAny(content, " /:") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"Invalid Attribute Value",
+				fmt.Sprintf(
+					"content must be a hostname for %s records, got: %s",
+					recordType, content,
+				),
+			)
+		}
+	}
+}
+
+func (r *DNSRecordResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args, diags := buildDNSRecordArgs(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	args.Type = (*core.DNSRecordTypesEnum)(plan.Type.ValueStringPointer())
+
+	res, err := r.M.Core.PostDnsZoneRecordsWithResponse(ctx,
+		core.PostDnsZoneRecordsJSONRequestBody{
+			DnsZone: core.DNSZoneLookup{
+				Id: plan.ZoneID.ValueStringPointer(),
+			},
+			Properties: args,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	id := res.JSON200.DnsRecord.Id
+	plan.ID = types.StringPointerValue(id)
+
+	if err := r.DNSRecordRead(ctx, *id, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *DNSRecordResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state DNSRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DNSRecordRead(ctx, state.ID.ValueString(), &state)
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			r.M.Logger.Info(
+				"DNS Record not found, removing from state",
+				"id", state.ID.ValueString(),
+			)
+
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *DNSRecordResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DNSRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args, diags := buildDNSRecordArgs(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.M.Core.PatchDnsRecordWithResponse(ctx,
+		core.PatchDnsRecordJSONRequestBody{
+			DnsRecord: core.DNSRecordLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+			Properties: args,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	if err := r.DNSRecordRead(ctx, state.ID.ValueString(), &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *DNSRecordResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state DNSRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.M.Core.DeleteDnsRecordWithResponse(ctx,
+		core.DeleteDnsRecordJSONRequestBody{
+			DnsRecord: core.DNSRecordLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+		})
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return
+		}
+
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+}
+
+func (r *DNSRecordResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the DNS record.")
+}
+
+func (r *DNSRecordResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// DNSRecordRead populates the model from the API. The zone ID is not
+// returned by the API, so it is left as is in the model.
+func (r *DNSRecordResource) DNSRecordRead(
+	ctx context.Context,
+	id string,
+	model *DNSRecordResourceModel,
+) error {
+	res, err := r.M.Core.GetDnsRecordWithResponse(ctx,
+		&core.GetDnsRecordParams{
+			DnsRecordId: &id,
+		})
+	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return err
+	}
+
+	record := res.JSON200.DnsRecord
+
+	model.ID = types.StringPointerValue(record.Id)
+	model.FullName = types.StringPointerValue(record.FullName)
+
+	name, _ := record.Name.Get()
+	model.Name = types.StringValue(name)
+
+	if record.Type != nil {
+		model.Type = types.StringValue(string(*record.Type))
+	}
+
+	if ttl, err := record.Ttl.Get(); err == nil {
+		model.TTL = types.Int64Value(int64(ttl))
+	} else {
+		model.TTL = types.Int64Null()
+	}
+
+	model.Priority = types.Int64Null()
+	switch model.Type.ValueString() {
+	case "MX", "SRV":
+		if priority, err := record.Priority.Get(); err == nil {
+			model.Priority = types.Int64Value(int64(priority))
+		}
+	}
+
+	model.Content, model.SRV, model.CAA = flattenDNSRecordContent(
+		model.Type.ValueString(), model.Content, record,
+	)
+
+	return nil
+}
+
+func buildDNSRecordArgs(
+	ctx context.Context,
+	model *DNSRecordResourceModel,
+) (core.DNSRecordArguments, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	args := core.DNSRecordArguments{
+		Name:    ptr(model.Name.ValueString()),
+		Content: &core.DNSRecordContentArguments{},
+	}
+
+	if !model.TTL.IsNull() && !model.TTL.IsUnknown() {
+		args.Ttl = ptr(int(model.TTL.ValueInt64()))
+	}
+
+	if !model.Priority.IsNull() && !model.Priority.IsUnknown() {
+		args.Priority = ptr(int(model.Priority.ValueInt64()))
+	}
+
+	content := model.Content.ValueStringPointer()
+
+	switch model.Type.ValueString() {
+	case "A":
+		args.Content.A = &core.DNSRecordContentArgumentsForA{
+			IpAddress: content,
+		}
+	case "AAAA":
+		args.Content.AAAA = &core.DNSRecordContentArgumentsForAAAA{
+			IpAddress: content,
+		}
+	case "CNAME":
+		args.Content.CNAME = &core.DNSRecordContentArgumentsForCNAME{
+			Hostname: content,
+		}
+	case "MX":
+		args.Content.MX = &core.DNSRecordContentArgumentsForMX{
+			Hostname: content,
+		}
+	case "TXT":
+		args.Content.TXT = &core.DNSRecordContentArgumentsForTXT{
+			Content: content,
+		}
+	case "SRV":
+		var srv DNSRecordSRVModel
+		diags.Append(model.SRV.As(ctx, &srv, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return args, diags
+		}
+
+		args.Content.SRV = &core.DNSRecordContentArgumentsForSRV{
+			Weight: ptr(strconv.FormatInt(srv.Weight.ValueInt64(), 10)),
+			Port:   ptr(strconv.FormatInt(srv.Port.ValueInt64(), 10)),
+			Target: srv.Target.ValueStringPointer(),
+		}
+	case "CAA":
+		var caa DNSRecordCAAModel
+		diags.Append(model.CAA.As(ctx, &caa, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return args, diags
+		}
+
+		args.Content.CAA = &core.DNSRecordContentArgumentsForCAA{
+			Flag:  ptr(strconv.FormatInt(caa.Flag.ValueInt64(), 10)),
+			Tag:   caa.Tag.ValueStringPointer(),
+			Value: caa.Value.ValueStringPointer(),
+		}
+	default:
+		diags.AddAttributeError(
+			path.Root("type"),
+			"Unsupported Record Type",
+			fmt.Sprintf(
+				"records of type %s cannot be managed by this resource",
+				model.Type.ValueString(),
+			),
+		)
+	}
+
+	return args, diags
+}
+
+// flattenDNSRecordContent returns the content, srv, and caa attribute values
+// for a record. Hostnames which only differ from the prior content by a
+// trailing dot keep the prior value.
+func flattenDNSRecordContent(
+	recordType string,
+	prior types.String,
+	record core.DNSRecord,
+) (types.String, types.Object, types.Object) {
+	content := types.StringNull()
+	srv := types.ObjectNull(dnsRecordSRVAttrTypes)
+	caa := types.ObjectNull(dnsRecordCAAAttrTypes)
+
+	var attrs core.DNSRecordContentAttributes
+	if record.ContentAttributes != nil {
+		attrs = *record.ContentAttributes
+	}
+
+	value := ""
+	switch recordType {
+	case "A":
+		if a, err := attrs.A.Get(); err == nil {
+			value, _ = a.IpAddress.Get()
+		}
+	case "AAAA":
+		if a, err := attrs.AAAA.Get(); err == nil {
+			value, _ = a.IpAddress.Get()
+		}
+	case "CNAME":
+		if c, err := attrs.CNAME.Get(); err == nil {
+			value, _ = c.Hostname.Get()
+		}
+	case "MX":
+		if mx, err := attrs.MX.Get(); err == nil {
+			value, _ = mx.Hostname.Get()
+		}
+	case "TXT":
+		if txt, err := attrs.TXT.Get(); err == nil {
+			value, _ = txt.Content.Get()
+		}
+	case "SRV":
+		if s, err := attrs.SRV.Get(); err == nil {
+			weight, _ := s.Weight.Get()
+			port, _ := s.Port.Get()
+			target, _ := s.Target.Get()
+
+			srv = types.ObjectValueMust(dnsRecordSRVAttrTypes,
+				map[string]attr.Value{
+					"weight": parseDNSRecordInt(weight),
+					"port":   parseDNSRecordInt(port),
+					"target": types.StringValue(target),
+				})
+		}
+
+		return content, srv, caa
+	case "CAA":
+		if c, err := attrs.CAA.Get(); err == nil {
+			flag, _ := c.Flag.Get()
+			tag, _ := c.Tag.Get()
+			val, _ := c.Value.Get()
+
+			caa = types.ObjectValueMust(dnsRecordCAAAttrTypes,
+				map[string]attr.Value{
+					"flag":  parseDNSRecordInt(flag),
+					"tag":   types.StringValue(tag),
+					"value": types.StringValue(val),
+				})
+		}
+
+		return content, srv, caa
+	}
+
+	if value == "" && record.Content != nil {
+		value = *record.Content
+	}
+	if value == "" {
+		return content, srv, caa
+	}
+
+	if strings.TrimSuffix(prior.ValueString(), ".") ==
+		strings.TrimSuffix(value, ".") && !prior.IsNull() {
+		return prior, srv, caa
+	}
+
+	return types.StringValue(value), srv, caa
+}
+
+func parseDNSRecordInt(v string) types.Int64 {
+	i, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(i)
+}
@@ -0,0 +1,545 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jimeh/undent"
+	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccKatapultDNSRecord_update(t *testing.T) {
+	tt := newTestTools(t)
+
+	zone := tt.ResourceName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckKatapultDNSRecordDestroy(tt),
+			testAccCheckKatapultDNSZoneDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: undent.Stringf(`
+					resource "katapult_dns_zone" "main" {
+						name = "%s"
+					}
+
+					resource "katapult_dns_record" "www" {
+						zone_id = katapult_dns_zone.main.id
+						name    = "www"
+						type    = "A"
+						content = "192.0.2.1"
+					}`,
+					zone,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultDNSRecordExists(
+						tt, "katapult_dns_record.www",
+					),
+					resource.TestCheckResourceAttrPair(
+						"katapult_dns_record.www", "zone_id",
+						"katapult_dns_zone.main", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_record.www", "name", "www",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_record.www", "content", "192.0.2.1",
+					),
+				),
+			},
+			{
+				Config: undent.Stringf(`
+					resource "katapult_dns_zone" "main" {
+						name = "%s"
+					}
+
+					resource "katapult_dns_record" "www" {
+						zone_id = katapult_dns_zone.main.id
+						name    = "web"
+						type    = "A"
+						content = "192.0.2.2"
+						ttl     = 600
+					}`,
+					zone,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultDNSRecordExists(
+						tt, "katapult_dns_record.www",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_record.www", "name", "web",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_record.www", "content", "192.0.2.2",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_record.www", "ttl", "600",
+					),
+				),
+			},
+			{
+				ResourceName:      "katapult_dns_record.www",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return the zone of a record.
+				ImportStateVerifyIgnore: []string{"zone_id"},
+			},
+		},
+	})
+}
+
+func TestDNSRecordResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := types.ObjectValueMust(dnsRecordSRVAttrTypes, map[string]attr.Value{
+		"weight": types.Int64Value(10),
+		"port":   types.Int64Value(5060),
+		"target": types.StringValue("sip.example.com"),
+	})
+	caa := types.ObjectValueMust(dnsRecordCAAAttrTypes, map[string]attr.Value{
+		"flag":  types.Int64Value(0),
+		"tag":   types.StringValue("issue"),
+		"value": types.StringValue("letsencrypt.org"),
+	})
+
+	tests := []struct {
+		name   string
+		model  DNSRecordResourceModel
+		errors []string
+	}{
+		{
+			name: "valid A record",
+			model: DNSRecordResourceModel{
+				Name:    types.StringValue("www"),
+				Type:    types.StringValue("A"),
+				Content: types.StringValue("192.0.2.10"),
+			},
+		},
+		{
+			name: "A record with IPv6 address",
+			model: DNSRecordResourceModel{
+				Type:    types.StringValue("A"),
+				Content: types.StringValue("2001:db8::1"),
+			},
+			errors: []string{
+				"content must be an IPv4 address for A records, " +
+					"got: 2001:db8::1",
+			},
+		},
+		{
+			name: "AAAA record with IPv4 address",
+			model: DNSRecordResourceModel{
+				Type:    types.StringValue("AAAA"),
+				Content: types.StringValue("192.0.2.10"),
+			},
+			errors: []string{
+				"content must be an IPv6 address for AAAA records, " +
+					"got: 192.0.2.10",
+			},
+		},
+		{
+			name: "unknown content is not validated",
+			model: DNSRecordResourceModel{
+				Type:    types.StringValue("A"),
+				Content: types.StringUnknown(),
+			},
+		},
+		{
+			name: "TXT record without content",
+			model: DNSRecordResourceModel{
+				Type: types.StringValue("TXT"),
+			},
+			errors: []string{"content is required for TXT records"},
+		},
+		{
+			name: "CNAME at the apex",
+			model: DNSRecordResourceModel{
+				Type:    types.StringValue("CNAME"),
+				Content: types.StringValue("example.com"),
+			},
+			errors: []string{
+				"CNAME records cannot be created at the apex of a zone",
+			},
+		},
+		{
+			name: "MX record without priority",
+			model: DNSRecordResourceModel{
+				Type:    types.StringValue("MX"),
+				Content: types.StringValue("mx.example.com"),
+			},
+			errors: []string{"priority is required for MX records"},
+		},
+		{
+			name: "MX record with IP address",
+			model: DNSRecordResourceModel{
+				Type:     types.StringValue("MX"),
+				Priority: types.Int64Value(10),
+				Content:  types.StringValue("192.0.2.10"),
+			},
+			errors: []string{
+				"content must be a hostname for MX records, got: 192.0.2.10",
+			},
+		},
+		{
+			name: "A record with priority",
+			model: DNSRecordResourceModel{
+				Type:     types.StringValue("A"),
+				Priority: types.Int64Value(10),
+				Content:  types.StringValue("192.0.2.10"),
+			},
+			errors: []string{"priority cannot be set for A records"},
+		},
+		{
+			name: "valid SRV record",
+			model: DNSRecordResourceModel{
+				Name:     types.StringValue("_sip._tcp"),
+				Type:     types.StringValue("SRV"),
+				Priority: types.Int64Value(10),
+				SRV:      srv,
+			},
+		},
+		{
+			name: "SRV record with content and no srv",
+			model: DNSRecordResourceModel{
+				Type:     types.StringValue("SRV"),
+				Priority: types.Int64Value(10),
+				Content:  types.StringValue("sip.example.com"),
+			},
+			errors: []string{
+				"content cannot be set for SRV records, use the srv " +
+					"attribute instead",
+				"srv is required for SRV records",
+			},
+		},
+		{
+			name: "valid CAA record",
+			model: DNSRecordResourceModel{
+				Type: types.StringValue("CAA"),
+				CAA:  caa,
+			},
+		},
+		{
+			name: "TXT record with caa",
+			model: DNSRecordResourceModel{
+				Type:    types.StringValue("TXT"),
+				Content: types.StringValue("v=spf1 -all"),
+				CAA:     caa,
+			},
+			errors: []string{"caa cannot be set for TXT records"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &DNSRecordResource{}
+			config := dnsRecordTestConfig(t, r, tt.model)
+
+			resp := frameworkresource.ValidateConfigResponse{}
+			r.ValidateConfig(
+				context.Background(),
+				frameworkresource.ValidateConfigRequest{Config: config},
+				&resp,
+			)
+
+			var got []string
+			for _, d := range resp.Diagnostics.Errors() {
+				got = append(got, d.Detail())
+			}
+
+			assert.Equal(t, tt.errors, got)
+		})
+	}
+}
+
+func TestDNSRecordResourceCreateSRV(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]any
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		switch {
+		case r.Method == http.MethodPost &&
+			r.URL.Path == "/dns_zones/dns_zone/records":
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(data, &body))
+
+			writeTestJSON(w, http.StatusOK, `{
+				"dns_record": {"id": "dnsrec_test"}
+			}`)
+		case r.Method == http.MethodGet &&
+			r.URL.Path == "/dns_records/dns_record":
+			writeTestJSON(w, http.StatusOK, `{
+				"dns_record": {
+					"id": "dnsrec_test",
+					"name": "_sip._tcp",
+					"full_name": "_sip._tcp.example.com",
+					"type": "SRV",
+					"ttl": null,
+					"priority": 10,
+					"content": "10 5060 sip.example.com",
+					"content_attributes": {
+						"SRV": {
+							"weight": "10",
+							"port": "5060",
+							"target": "sip.example.com"
+						}
+					}
+				}
+			}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			writeTestJSON(w, http.StatusNotFound, `{}`)
+		}
+	})
+
+	r := &DNSRecordResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	plan := DNSRecordResourceModel{
+		ID:       types.StringUnknown(),
+		ZoneID:   types.StringValue("dnszone_test"),
+		Name:     types.StringValue("_sip._tcp"),
+		FullName: types.StringUnknown(),
+		Type:     types.StringValue("SRV"),
+		TTL:      types.Int64Unknown(),
+		Priority: types.Int64Value(10),
+		SRV: types.ObjectValueMust(dnsRecordSRVAttrTypes, map[string]attr.Value{
+			"weight": types.Int64Value(10),
+			"port":   types.Int64Value(5060),
+			"target": types.StringValue("sip.example.com"),
+		}),
+	}
+	state := dnsRecordTestState(t, r, plan)
+
+	resp := frameworkresource.CreateResponse{
+		State: tfsdk.State{Schema: state.Schema},
+	}
+	r.Create(
+		context.Background(),
+		frameworkresource.CreateRequest{
+			Plan: tfsdk.Plan{Schema: state.Schema, Raw: state.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	assert.Equal(t, map[string]any{
+		"dns_zone": map[string]any{"id": "dnszone_test"},
+		"properties": map[string]any{
+			"name":     "_sip._tcp",
+			"priority": float64(10),
+			"type":     "SRV",
+			"content": map[string]any{
+				"SRV": map[string]any{
+					"weight": "10",
+					"port":   "5060",
+					"target": "sip.example.com",
+				},
+			},
+		},
+	}, body)
+
+	var got DNSRecordResourceModel
+	diags := resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "dnsrec_test", got.ID.ValueString())
+	assert.Equal(t, "dnszone_test", got.ZoneID.ValueString())
+	assert.Equal(t, "_sip._tcp.example.com", got.FullName.ValueString())
+	assert.True(t, got.TTL.IsNull())
+	assert.True(t, got.Content.IsNull())
+	assert.True(t, got.SRV.Equal(plan.SRV))
+}
+
+func TestDNSRecordResourceReadRemovesMissingResource(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusNotFound, `{
+			"error": {
+				"code": "dns_record_not_found",
+				"description": "No DNS record was found"
+			}
+		}`)
+	})
+	r := &DNSRecordResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := dnsRecordTestState(t, r, DNSRecordResourceModel{
+		ID: types.StringValue("dnsrec_missing"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.True(
+		t, resp.State.Raw.IsNull(),
+		"missing DNS record should be removed from state",
+	)
+}
+
+func TestFlattenDNSRecordContentKeepsTrailingDot(t *testing.T) {
+	t.Parallel()
+
+	content, srv, caa := flattenDNSRecordContent(
+		"CNAME",
+		types.StringValue("target.example.com."),
+		dnsRecordFromJSON(t, `{
+			"type": "CNAME",
+			"content": "target.example.com",
+			"content_attributes": {
+				"CNAME": {"hostname": "target.example.com"}
+			}
+		}`),
+	)
+
+	assert.Equal(t, "target.example.com.", content.ValueString())
+	assert.True(t, srv.IsNull())
+	assert.True(t, caa.IsNull())
+
+	content, _, _ = flattenDNSRecordContent(
+		"CNAME",
+		types.StringValue("old.example.com"),
+		dnsRecordFromJSON(t, `{
+			"type": "CNAME",
+			"content_attributes": {
+				"CNAME": {"hostname": "new.example.com"}
+			}
+		}`),
+	)
+
+	assert.Equal(t, "new.example.com", content.ValueString())
+}
+
+//
+// Helpers
+//
+
+func testAccCheckKatapultDNSRecordExists(
+	tt *testTools,
+	res string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+
+		resp, err := tt.Meta.Core.GetDnsRecordWithResponse(tt.Ctx,
+			&core.GetDnsRecordParams{DnsRecordId: &rs.Primary.ID},
+		)
+		if err != nil {
+			return err
+		}
+
+		record := resp.JSON200.DnsRecord
+
+		return resource.TestCheckResourceAttr(res, "id", *record.Id)(s)
+	}
+}
+
+func testAccCheckKatapultDNSRecordDestroy(
+	tt *testTools,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "katapult_dns_record" {
+				continue
+			}
+
+			_, err := tt.Meta.Core.GetDnsRecordWithResponse(tt.Ctx,
+				&core.GetDnsRecordParams{DnsRecordId: &rs.Primary.ID},
+			)
+			if err == nil {
+				return fmt.Errorf(
+					"katapult_dns_record %s was not destroyed", rs.Primary.ID,
+				)
+			}
+			if !errors.Is(err, core.ErrNotFound) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func dnsRecordFromJSON(t *testing.T, body string) core.DNSRecord {
+	t.Helper()
+
+	var record core.DNSRecord
+	require.NoError(t, json.Unmarshal([]byte(body), &record))
+
+	return record
+}
+
+func dnsRecordTestState(
+	t *testing.T,
+	r *DNSRecordResource,
+	model DNSRecordResourceModel,
+) tfsdk.State {
+	t.Helper()
+
+	if model.SRV.IsNull() {
+		model.SRV = types.ObjectNull(dnsRecordSRVAttrTypes)
+	}
+	if model.CAA.IsNull() {
+		model.CAA = types.ObjectNull(dnsRecordCAAAttrTypes)
+	}
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(
+		context.Background(),
+		frameworkresource.SchemaRequest{},
+		schemaResp,
+	)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return state
+}
+
+func dnsRecordTestConfig(
+	t *testing.T,
+	r *DNSRecordResource,
+	model DNSRecordResourceModel,
+) tfsdk.Config {
+	t.Helper()
+
+	state := dnsRecordTestState(t, r, model)
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
@@ -0,0 +1,338 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ resource.ResourceWithIdentity = (*DNSZoneResource)(nil)
+
+type (
+	DNSZoneResource struct {
+		M *Meta
+	}
+
+	DNSZoneResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		DefaultTTL   types.Int64  `tfsdk:"default_ttl"`
+		Verified     types.Bool   `tfsdk:"verified"`
+		Organization types.String `tfsdk:"organization"`
+	}
+)
+
+const (
+	dnsZoneIDDescription   = "The ID of the DNS zone."
+	dnsZoneNameDescription = "The name of the DNS zone, e.g. `example.com`."
+	dnsZoneTTLDescription  = "The TTL in seconds used by records in the " +
+		"zone which do not set their own TTL."
+	dnsZoneVerifiedDescription = "Whether the zone has been verified. " +
+		"Records in unverified zones are not served until the domain is " +
+		"delegated to Katapult's nameservers and the zone is verified."
+)
+
+func (r *DNSZoneResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *DNSZoneResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	r.M = meta
+}
+
+func (r *DNSZoneResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         dnsZoneIDDescription,
+				MarkdownDescription: dnsZoneIDDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         dnsZoneNameDescription,
+				MarkdownDescription: dnsZoneNameDescription,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         dnsZoneTTLDescription,
+				MarkdownDescription: dnsZoneTTLDescription,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified": schema.BoolAttribute{
+				Computed:            true,
+				Description:         dnsZoneVerifiedDescription,
+				MarkdownDescription: dnsZoneVerifiedDescription,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			organizationAttributeName: organizationResourceAttribute(),
+		},
+	}
+}
+
+func (r *DNSZoneResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan DNSZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := core.DNSZoneArguments{
+		Name: plan.Name.ValueStringPointer(),
+	}
+
+	if !plan.DefaultTTL.IsNull() && !plan.DefaultTTL.IsUnknown() {
+		args.DefaultTtl = ptr(int(plan.DefaultTTL.ValueInt64()))
+	}
+
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
+	res, err := r.M.Core.PostOrganizationDnsZonesWithResponse(ctx,
+		core.PostOrganizationDnsZonesJSONRequestBody{
+			Organization: core.OrganizationLookup{
+				SubDomain: &org,
+			},
+			Properties: args,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	id := res.JSON201.DnsZone.Id
+	plan.ID = types.StringPointerValue(id)
+
+	if err := r.DNSZoneRead(ctx, *id, &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *DNSZoneResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state DNSZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DNSZoneRead(ctx, state.ID.ValueString(), &state)
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			r.M.Logger.Info(
+				"DNS Zone not found, removing from state",
+				"id", state.ID.ValueString(),
+			)
+
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *DNSZoneResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan DNSZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DNSZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DefaultTTL.IsUnknown() && !plan.DefaultTTL.Equal(state.DefaultTTL) {
+		res, err := r.M.Core.PatchDnsZoneWithResponse(ctx,
+			core.PatchDnsZoneJSONRequestBody{
+				DnsZone: core.DNSZoneLookup{
+					Id: state.ID.ValueStringPointer(),
+				},
+				Properties: core.DNSZoneArguments{
+					DefaultTtl: ptr(int(plan.DefaultTTL.ValueInt64())),
+				},
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
+	}
+
+	if err := r.DNSZoneRead(ctx, state.ID.ValueString(), &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *DNSZoneResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state DNSZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.M.Core.DeleteDnsZoneWithResponse(ctx,
+		core.DeleteDnsZoneJSONRequestBody{
+			DnsZone: core.DNSZoneLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+		})
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return
+		}
+
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+}
+
+func (r *DNSZoneResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the DNS zone.")
+}
+
+func (r *DNSZoneResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+func (r *DNSZoneResource) DNSZoneRead(
+	ctx context.Context,
+	id string,
+	model *DNSZoneResourceModel,
+) error {
+	res, err := r.M.Core.GetDnsZoneWithResponse(ctx,
+		&core.GetDnsZoneParams{
+			DnsZoneId: &id,
+		})
+	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return err
+	}
+
+	zone := res.JSON200.DnsZone
+
+	model.ID = types.StringPointerValue(zone.Id)
+	model.Name = types.StringPointerValue(zone.Name)
+	model.Verified = types.BoolValue(zone.Verified != nil && *zone.Verified)
+
+	if zone.DefaultTtl != nil {
+		model.DefaultTTL = types.Int64Value(int64(*zone.DefaultTtl))
+	} else {
+		model.DefaultTTL = types.Int64Null()
+	}
+
+	return nil
+}
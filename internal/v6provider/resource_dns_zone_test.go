@@ -0,0 +1,292 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jimeh/undent"
+	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() { //nolint:gochecknoinits
+	resource.AddTestSweepers("katapult_dns_zone", &resource.Sweeper{
+		Name: "katapult_dns_zone",
+		F:    testSweepDNSZones,
+	})
+}
+
+func testSweepDNSZones(_ string) error {
+	m := sweepMeta()
+	ctx := context.TODO()
+
+	var zones []core.DNSZone
+	totalPages := 2
+	for pageNum := 1; pageNum <= totalPages; pageNum++ {
+		res, err := m.Core.GetOrganizationDnsZonesWithResponse(ctx,
+			&core.GetOrganizationDnsZonesParams{
+				OrganizationId: &m.confOrganization,
+				Page:           &pageNum,
+			})
+		if err != nil {
+			if errors.Is(err, core.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		if res == nil || res.JSON200 == nil {
+			return fmt.Errorf("unexpected empty response listing DNS zones")
+		}
+
+		resp := res.JSON200
+
+		totalPages, _ = resp.Pagination.TotalPages.Get()
+		zones = append(zones, resp.DnsZones...)
+	}
+
+	for _, zone := range zones {
+		if zone.Name == nil || zone.Id == nil {
+			continue
+		}
+		if !strings.HasPrefix(*zone.Name, testAccResourceNamePrefix) {
+			continue
+		}
+
+		m.Logger.Info("deleting DNS zone", "id", *zone.Id, "name", *zone.Name)
+		_, err := m.Core.DeleteDnsZoneWithResponse(ctx,
+			core.DeleteDnsZoneJSONRequestBody{
+				DnsZone: core.DNSZoneLookup{
+					Id: zone.Id,
+				},
+			})
+		if err != nil && !errors.Is(err, core.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAccKatapultDNSZone_update(t *testing.T) {
+	tt := newTestTools(t)
+
+	name := tt.ResourceName() + ".example.com"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             testAccCheckKatapultDNSZoneDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: undent.Stringf(`
+					resource "katapult_dns_zone" "main" {
+						name        = "%s"
+						default_ttl = 300
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultDNSZoneExists(
+						tt, "katapult_dns_zone.main",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_zone.main", "name", name,
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_zone.main", "default_ttl", "300",
+					),
+				),
+			},
+			{
+				Config: undent.Stringf(`
+					resource "katapult_dns_zone" "main" {
+						name        = "%s"
+						default_ttl = 3600
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultDNSZoneExists(
+						tt, "katapult_dns_zone.main",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_zone.main", "name", name,
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_dns_zone.main", "default_ttl", "3600",
+					),
+				),
+			},
+			{
+				ResourceName:      "katapult_dns_zone.main",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API does not return the owning organization, so it is
+				// not known after import.
+				ImportStateVerifyIgnore: []string{"organization"},
+			},
+		},
+	})
+}
+
+func TestDNSZoneResourceRead(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, "dnszone_test", r.URL.Query().Get("dns_zone[id]"))
+
+		writeTestJSON(w, http.StatusOK, `{
+			"dns_zone": {
+				"id": "dnszone_test",
+				"name": "example.com",
+				"default_ttl": 3600,
+				"verified": false
+			}
+		}`)
+	})
+	r := &DNSZoneResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := dnsZoneTestState(t, r, DNSZoneResourceModel{
+		ID: types.StringValue("dnszone_test"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got DNSZoneResourceModel
+	diags := resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, DNSZoneResourceModel{
+		ID:         types.StringValue("dnszone_test"),
+		Name:       types.StringValue("example.com"),
+		DefaultTTL: types.Int64Value(3600),
+		Verified:   types.BoolValue(false),
+	}, got)
+}
+
+func TestDNSZoneResourceReadRemovesMissingResource(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusNotFound, `{
+			"error": {
+				"code": "dns_zone_not_found",
+				"description": "No DNS zone was found"
+			}
+		}`)
+	})
+	r := &DNSZoneResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := dnsZoneTestState(t, r, DNSZoneResourceModel{
+		ID: types.StringValue("dnszone_missing"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.True(
+		t, resp.State.Raw.IsNull(),
+		"missing DNS zone should be removed from state",
+	)
+}
+
+//
+// Helpers
+//
+
+func testAccCheckKatapultDNSZoneExists(
+	tt *testTools,
+	res string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+
+		resp, err := tt.Meta.Core.GetDnsZoneWithResponse(tt.Ctx,
+			&core.GetDnsZoneParams{DnsZoneId: &rs.Primary.ID},
+		)
+		if err != nil {
+			return err
+		}
+
+		zone := resp.JSON200.DnsZone
+
+		return resource.TestCheckResourceAttr(res, "id", *zone.Id)(s)
+	}
+}
+
+func testAccCheckKatapultDNSZoneDestroy(
+	tt *testTools,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "katapult_dns_zone" {
+				continue
+			}
+
+			_, err := tt.Meta.Core.GetDnsZoneWithResponse(tt.Ctx,
+				&core.GetDnsZoneParams{DnsZoneId: &rs.Primary.ID},
+			)
+			if err == nil {
+				return fmt.Errorf(
+					"katapult_dns_zone %s was not destroyed", rs.Primary.ID,
+				)
+			}
+			if !errors.Is(err, core.ErrNotFound) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func dnsZoneTestState(
+	t *testing.T,
+	r *DNSZoneResource,
+	model DNSZoneResourceModel,
+) tfsdk.State {
+	t.Helper()
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(
+		context.Background(),
+		frameworkresource.SchemaRequest{},
+		schemaResp,
+	)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return state
+}
@@ -23,6 +23,11 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var (
+	_ resource.ResourceWithIdentity  = (*FileStorageVolumeResource)(nil)
+	_ resource.ResourceWithMoveState = (*FileStorageVolumeResource)(nil)
+)
+
 type (
 	FileStorageVolumeResource struct {
 		M *Meta
@@ -33,8 +38,19 @@ type (
 		Name         types.String   `tfsdk:"name"`
 		Associations types.Set      `tfsdk:"associations"`
 		NFSLocation  types.String   `tfsdk:"nfs_location"`
+		Organization types.String   `tfsdk:"organization"`
+		DataCenter   types.String   `tfsdk:"data_center"`
 		Timeouts     timeouts.Value `tfsdk:"timeouts"`
 	}
+
+	// legacyFileStorageVolumeResourceModel is the state layout of
+	// katapult_legacy_file_storage_volume.
+	legacyFileStorageVolumeResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		Associations types.Set    `tfsdk:"associations"`
+		NFSLocation  types.String `tfsdk:"nfs_location"`
+	}
 )
 
 func (r FileStorageVolumeResource) Metadata(
@@ -129,8 +145,11 @@ The File Storage Volume resource allows you to manage File Storage Volumes in Ka
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
+			dataCenterAttributeName:   dataCenterResourceAttribute(),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
+				Update: true,
 				Delete: true,
 			}),
 		},
@@ -168,15 +187,20 @@ func (r *FileStorageVolumeResource) Create(
 		return
 	}
 
+	org := r.M.organization(plan.Organization)
+	dataCenter := r.M.dataCenter(plan.DataCenter)
+	plan.Organization = types.StringValue(org)
+	plan.DataCenter = types.StringValue(dataCenter)
+
 	res, err := r.M.Core.PostOrganizationFileStorageVolumesWithResponse(
 		ctx,
 		core.PostOrganizationFileStorageVolumesJSONRequestBody{
 			Organization: core.OrganizationLookup{
-				SubDomain: &r.M.confOrganization,
+				SubDomain: &org,
 			},
 			Properties: core.FileStorageVolumeArguments{
 				DataCenter: &core.DataCenterLookup{
-					Permalink: &r.M.confDataCenter,
+					Permalink: &dataCenter,
 				},
 				Name:         plan.Name.ValueStringPointer(),
 				Associations: &associations,
@@ -184,9 +208,13 @@ func (r *FileStorageVolumeResource) Create(
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"FileStorageVolumeCreate Error",
-			"Error creating file storage volume: "+err.Error(),
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "FileStorageVolumeCreate Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
@@ -201,11 +229,12 @@ func (r *FileStorageVolumeResource) Create(
 		ctx, r.M, create, 2*time.Second, res.JSON201.FileStorageVolume.Id,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error waiting for file storage "+
-				"volume to become ready.",
-			err.Error(),
+		addAPIError(
+			&resp.Diagnostics,
+			"Error waiting for file storage volume to become ready.", err,
+			resp.State.Schema.Type(),
 		)
+		return
 	}
 
 	if err := r.FileStorageVolumeRead(
@@ -214,14 +243,15 @@ func (r *FileStorageVolumeResource) Create(
 		&plan,
 		&resp.State,
 	); err != nil {
-		resp.Diagnostics.AddError(
-			"FileStorageVolumeRead Error",
-			"Error reading file storage volume: "+err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "FileStorageVolumeRead Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *FileStorageVolumeResource) Read(
@@ -240,13 +270,15 @@ func (r *FileStorageVolumeResource) Read(
 		ctx, state.ID.ValueStringPointer(), state, &resp.State,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"FileStorageVolumeRead Error",
-			"Error reading file storage volume: "+err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "FileStorageVolumeRead Error", err,
+			resp.State.Schema.Type(),
 		)
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *FileStorageVolumeResource) Update(
@@ -304,27 +336,38 @@ func (r *FileStorageVolumeResource) Update(
 			Properties:        args,
 		})
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"FileStorageVolumeUpdate Error",
-			"Error updating file storage volume: "+err.Error(),
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "FileStorageVolumeUpdate Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	fsv := res.JSON200.FileStorageVolume
 
+	update, diags := plan.Timeouts.Update(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err = waitForFileStorageVolumeToBeReady(
 		ctx,
 		r.M,
-		20*time.Minute,
+		update,
 		5*time.Second,
 		fsv.Id)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error waiting for file storage "+
-				"volume to become ready.",
-			err.Error(),
+		addAPIError(
+			&resp.Diagnostics,
+			"Error waiting for file storage volume to become ready.", err,
+			resp.State.Schema.Type(),
 		)
+		return
 	}
 
 	if err := r.FileStorageVolumeRead(
@@ -333,14 +376,15 @@ func (r *FileStorageVolumeResource) Update(
 		&plan,
 		&resp.State,
 	); err != nil {
-		resp.Diagnostics.AddError(
-			"FileStorageVolumeRead Error",
-			"Error reading file storage volume: "+err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "FileStorageVolumeRead Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *FileStorageVolumeResource) Delete(
@@ -389,11 +433,15 @@ func (r *FileStorageVolumeResource) Delete(
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Failed to lookup file storage volume details.",
-			err.Error(),
-		)
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
 
+		addAPIError(
+			&resp.Diagnostics,
+			"Failed to lookup file storage volume details.", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -423,10 +471,13 @@ func (r *FileStorageVolumeResource) Delete(
 			})
 
 		if patchErr != nil && !isErrNotFoundOrInTrash(patchErr, res.JSON406) {
-			resp.Diagnostics.AddError("Failed to rename file storage "+
-				"volume before moving to trash.",
-				patchErr.Error())
+			patchErr = genericAPIError(patchErr, res.Body, res.HTTPResponse)
 
+			addAPIError(
+				&resp.Diagnostics,
+				"Failed to rename file storage volume before moving to trash.",
+				patchErr, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -437,7 +488,12 @@ func (r *FileStorageVolumeResource) Delete(
 		})
 
 	if err != nil && !isErrNotFoundOrInTrash(err, delRes.JSON406) {
-		resp.Diagnostics.AddError("FileStorageVolumeDelete Error", err.Error())
+		err = genericAPIError(err, delRes.Body, delRes.HTTPResponse)
+
+		addAPIError(
+			&resp.Diagnostics, "FileStorageVolumeDelete Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -455,12 +511,85 @@ func (r *FileStorageVolumeResource) Delete(
 	}
 }
 
+func (r *FileStorageVolumeResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the file storage volume.")
+}
+
 func (r *FileStorageVolumeResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// MoveState moves katapult_legacy_file_storage_volume state to
+// katapult_file_storage_volume. Legacy timeouts are not carried over, as
+// they are read from configuration.
+func (r *FileStorageVolumeResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Required: true},
+					"associations": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"nfs_location": schema.StringAttribute{Computed: true},
+				},
+			},
+			StateMover: moveLegacyFileStorageVolumeState,
+		},
+	}
+}
+
+func moveLegacyFileStorageVolumeState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+) {
+	if !movedFromLegacy(req, "katapult_legacy_file_storage_volume") {
+		return
+	}
+
+	var prior legacyFileStorageVolumeResourceModel
+	if !getLegacySourceState(ctx, req, resp, &prior) {
+		return
+	}
+
+	state := FileStorageVolumeResourceModel{
+		ID:           prior.ID,
+		Name:         prior.Name,
+		Associations: prior.Associations,
+		NFSLocation:  prior.NFSLocation,
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+	if state.Associations.IsNull() {
+		state.Associations = types.SetValueMust(
+			types.StringType, []attr.Value{},
+		)
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+	resp.Diagnostics.Append(
+		setIDIdentity(ctx, resp.TargetIdentity, state.ID)...,
+	)
 }
 
 func (r *FileStorageVolumeResource) FileStorageVolumeRead(
@@ -480,6 +609,10 @@ func (r *FileStorageVolumeResource) FileStorageVolumeRead(
 			return nil
 		}
 
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 
@@ -528,7 +661,7 @@ func waitForFileStorageVolumeToBeReady(
 				})
 			if err != nil {
 				if res != nil {
-					err = genericAPIError(err, res.Body)
+					err = genericAPIError(err, res.Body, res.HTTPResponse)
 				}
 
 				return nil, "", err
@@ -0,0 +1,41 @@
+package v6provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IDIdentityModel is the identity of resources identified by their Katapult
+// ID alone.
+type IDIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       description,
+			},
+		},
+	}
+}
+
+// setIDIdentity records id as the identity of a resource. Responses without
+// identity data, such as those built directly in tests, are left untouched.
+func setIDIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	id types.String,
+) diag.Diagnostics {
+	if identity == nil || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+
+	return identity.Set(ctx, IDIdentityModel{ID: id})
+}
@@ -0,0 +1,302 @@
+package v6provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testResourceIdentity(
+	t *testing.T,
+	r resource.ResourceWithIdentity,
+	value any,
+) *tfsdk.ResourceIdentity {
+	t.Helper()
+	ctx := context.Background()
+
+	var resp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	identity := &tfsdk.ResourceIdentity{
+		Schema: resp.IdentitySchema,
+		Raw: tftypes.NewValue(
+			resp.IdentitySchema.Type().TerraformType(ctx), nil,
+		),
+	}
+	if value != nil {
+		diags := identity.Set(ctx, value)
+		require.False(t, diags.HasError(), diags.Errors())
+	}
+
+	return identity
+}
+
+func testImportStateResponse(
+	t *testing.T,
+	r resource.ResourceWithIdentity,
+) *resource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	return &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: resp.Schema,
+			Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: testResourceIdentity(t, r, nil),
+	}
+}
+
+func TestImportableResourcesHaveIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	for _, factory := range (&KatapultProvider{}).Resources(ctx) {
+		r := factory()
+		if _, ok := r.(resource.ResourceWithImportState); !ok {
+			continue
+		}
+
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{
+			ProviderTypeName: "katapult",
+		}, &meta)
+
+		t.Run(meta.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			ir, ok := r.(resource.ResourceWithIdentity)
+			require.True(t, ok, "importable resource has no identity schema")
+
+			var resp resource.IdentitySchemaResponse
+			ir.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &resp)
+			require.False(t, resp.Diagnostics.HasError())
+			require.NotEmpty(t, resp.IdentitySchema.Attributes)
+
+			required := 0
+			for name, attr := range resp.IdentitySchema.Attributes {
+				s, ok := attr.(identityschema.StringAttribute)
+				require.True(t, ok, name)
+				assert.True(t, s.RequiredForImport || s.OptionalForImport, name)
+				assert.NotEmpty(t, s.Description, name)
+				if s.RequiredForImport {
+					required++
+				}
+			}
+			assert.NotZero(t, required, "identity has no required attribute")
+		})
+	}
+}
+
+func TestObjectStorageBucketImportStateByIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := &ObjectStorageBucketResource{}
+
+	resp := testImportStateResponse(t, r)
+	r.ImportState(ctx, resource.ImportStateRequest{
+		Identity: testResourceIdentity(t, r, ObjectStorageBucketIdentityModel{
+			Name:   types.StringValue("my-bucket"),
+			Region: types.StringValue("uk-lon-1"),
+		}),
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var state ObjectStorageBucketResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "my-bucket", state.Name.ValueString())
+	assert.Equal(t, "uk-lon-1", state.Region.ValueString())
+
+	var identity ObjectStorageBucketIdentityModel
+	require.False(t, resp.Identity.Get(ctx, &identity).HasError())
+	assert.Equal(t, "my-bucket", identity.Name.ValueString())
+	assert.Equal(t, "uk-lon-1", identity.Region.ValueString())
+}
+
+func TestObjectStorageBucketImportStateByIDSetsIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := &ObjectStorageBucketResource{}
+
+	resp := testImportStateResponse(t, r)
+	r.ImportState(ctx, resource.ImportStateRequest{
+		ID:       "my-bucket/uk-lon-1",
+		Identity: testResourceIdentity(t, r, nil),
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var identity ObjectStorageBucketIdentityModel
+	require.False(t, resp.Identity.Get(ctx, &identity).HasError())
+	assert.Equal(t, "my-bucket", identity.Name.ValueString())
+	assert.Equal(t, "uk-lon-1", identity.Region.ValueString())
+}
+
+func TestObjectStorageBucketImportStateRejectsIncompleteIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := &ObjectStorageBucketResource{}
+
+	resp := testImportStateResponse(t, r)
+	r.ImportState(ctx, resource.ImportStateRequest{
+		Identity: testResourceIdentity(t, r, ObjectStorageBucketIdentityModel{
+			Name:   types.StringValue("my-bucket"),
+			Region: types.StringNull(),
+		}),
+	}, resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, "Invalid Import ID", resp.Diagnostics.Errors()[0].Summary())
+}
+
+func TestObjectStorageAccountImportStateByIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := &ObjectStorageAccountResource{}
+
+	resp := testImportStateResponse(t, r)
+	r.ImportState(ctx, resource.ImportStateRequest{
+		Identity: testResourceIdentity(t, r, ObjectStorageAccountIdentityModel{
+			Organization: types.StringNull(),
+			Region:       types.StringValue("uk-lon-1"),
+		}),
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var region, org types.String
+	require.False(t, resp.State.GetAttribute(
+		ctx, path.Root(objectStorageRegionAttributeName), &region,
+	).HasError())
+	require.False(t, resp.State.GetAttribute(
+		ctx, path.Root(organizationAttributeName), &org,
+	).HasError())
+	assert.Equal(t, "uk-lon-1", region.ValueString())
+	assert.True(t, org.IsNull())
+}
+
+func TestObjectStorageAccountImportStateWithOrganization(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		id       string
+		identity *ObjectStorageAccountIdentityModel
+		confOrg  string
+		wantOrg  string
+	}{
+		{
+			name:    "id with organization",
+			id:      "acme/uk-lon-1",
+			confOrg: "default-org",
+			wantOrg: "acme",
+		},
+		{
+			name:    "id without organization",
+			id:      "uk-lon-1",
+			confOrg: "default-org",
+			wantOrg: "default-org",
+		},
+		{
+			name: "identity with organization",
+			identity: &ObjectStorageAccountIdentityModel{
+				Organization: types.StringValue("acme"),
+				Region:       types.StringValue("uk-lon-1"),
+			},
+			confOrg: "default-org",
+			wantOrg: "acme",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r := &ObjectStorageAccountResource{
+				M: &Meta{confOrganization: tt.confOrg},
+			}
+
+			req := resource.ImportStateRequest{
+				ID:       tt.id,
+				Identity: testResourceIdentity(t, r, nil),
+			}
+			if tt.identity != nil {
+				req.Identity = testResourceIdentity(t, r, *tt.identity)
+			}
+
+			resp := testImportStateResponse(t, r)
+			r.ImportState(ctx, req, resp)
+			require.False(
+				t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors(),
+			)
+
+			var org, region types.String
+			require.False(t, resp.State.GetAttribute(
+				ctx, path.Root(organizationAttributeName), &org,
+			).HasError())
+			require.False(t, resp.State.GetAttribute(
+				ctx, path.Root(objectStorageRegionAttributeName), &region,
+			).HasError())
+			assert.Equal(t, tt.wantOrg, org.ValueString())
+			assert.Equal(t, "uk-lon-1", region.ValueString())
+
+			var identity ObjectStorageAccountIdentityModel
+			require.False(t, resp.Identity.Get(ctx, &identity).HasError())
+			assert.Equal(t, tt.wantOrg, identity.Organization.ValueString())
+			assert.Equal(t, "uk-lon-1", identity.Region.ValueString())
+		})
+	}
+}
+
+func TestObjectStorageAccountImportStateRejectsInvalidID(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []string{" ", "acme/", "/uk-lon-1", "a/b/c"} {
+		t.Run(id, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			r := &ObjectStorageAccountResource{}
+
+			resp := testImportStateResponse(t, r)
+			r.ImportState(ctx, resource.ImportStateRequest{
+				ID:       id,
+				Identity: testResourceIdentity(t, r, nil),
+			}, resp)
+
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(
+				t, "Invalid Import ID", resp.Diagnostics.Errors()[0].Summary(),
+			)
+		})
+	}
+}
+
+func TestSetDiskAssignmentIdentity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := &DiskAssignmentResource{}
+	identity := testResourceIdentity(t, r, nil)
+
+	diags := setDiskAssignmentIdentity(ctx, identity, DiskAssignmentResourceModel{
+		VirtualMachineID: types.StringValue("vm_one"),
+		DiskID:           types.StringValue("disk_one"),
+	})
+	require.False(t, diags.HasError(), diags.Errors())
+
+	var got DiskAssignmentIdentityModel
+	require.False(t, identity.Get(ctx, &got).HasError())
+	assert.Equal(t, "vm_one", got.VirtualMachineID.ValueString())
+	assert.Equal(t, "disk_one", got.DiskID.ValueString())
+
+	assert.Nil(t, setDiskAssignmentIdentity(ctx, nil, DiskAssignmentResourceModel{}))
+}
@@ -21,6 +21,11 @@ import (
 	core "github.com/krystal/go-katapult/next/core"
 )
 
+var (
+	_ resource.ResourceWithIdentity  = (*IPResource)(nil)
+	_ resource.ResourceWithMoveState = (*IPResource)(nil)
+)
+
 type (
 	IPResource struct {
 		M *Meta
@@ -37,6 +42,21 @@ type (
 		Label           types.String `tfsdk:"label"`
 		AllocationType  types.String `tfsdk:"allocation_type"`
 		AllocationID    types.String `tfsdk:"allocation_id"`
+		Organization    types.String `tfsdk:"organization"`
+		DataCenter      types.String `tfsdk:"data_center"`
+	}
+
+	legacyIPResourceModel struct {
+		ID              types.String `tfsdk:"id"`
+		NetworkID       types.String `tfsdk:"network_id"`
+		Version         types.Int64  `tfsdk:"version"`
+		Address         types.String `tfsdk:"address"`
+		AddressWithMask types.String `tfsdk:"address_with_mask"`
+		ReverseDNS      types.String `tfsdk:"reverse_dns"`
+		VIP             types.Bool   `tfsdk:"vip"`
+		Label           types.String `tfsdk:"label"`
+		AllocationType  types.String `tfsdk:"allocation_type"`
+		AllocationID    types.String `tfsdk:"allocation_id"`
 	}
 )
 
@@ -156,10 +176,26 @@ func (r IPResource) Schema(
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
+			dataCenterAttributeName:   ipDataCenterAttribute(),
 		},
 	}
 }
 
+// ipDataCenterAttribute is the data_center argument of katapult_ip. IP
+// addresses belong to a network, so the data center only selects the
+// default network used when network_id is not set.
+func ipDataCenterAttribute() schema.StringAttribute {
+	attr := dataCenterResourceAttribute()
+	attr.MarkdownDescription = "Permalink of the data center whose " +
+		"default network the IP address is allocated from when " +
+		"`network_id` is not set. Defaults to the provider's " +
+		"`data_center`. Changing this replaces the resource; removing " +
+		"it keeps the resource where it is."
+
+	return attr
+}
+
 func (r *IPResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -178,15 +214,25 @@ func (r *IPResource) Create(
 
 	if netID := plan.NetworkID.ValueString(); netID != "" {
 		networkID = &netID
+		if plan.DataCenter.IsUnknown() {
+			plan.DataCenter = types.StringNull()
+		}
 	} else {
+		dataCenter := r.M.dataCenter(plan.DataCenter)
+		plan.DataCenter = types.StringValue(dataCenter)
+
 		res, err := r.M.Core.GetDataCenterDefaultNetworkWithResponse(ctx,
 			&core.GetDataCenterDefaultNetworkParams{
-				DataCenterPermalink: &r.M.confDataCenter,
+				DataCenterPermalink: &dataCenter,
 			})
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Default Network Error",
-				err.Error(),
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			addAPIError(
+				&resp.Diagnostics, "Default Network Error", err,
+				resp.State.Schema.Type(),
 			)
 			return
 		}
@@ -194,9 +240,12 @@ func (r *IPResource) Create(
 		networkID = res.JSON200.Network.Id
 	}
 
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
 	args := core.PostOrganizationIpAddressesJSONRequestBody{
 		Organization: core.OrganizationLookup{
-			SubDomain: &r.M.confOrganization,
+			SubDomain: &org,
 		},
 		Network: core.NetworkLookup{
 			Id: networkID,
@@ -211,19 +260,14 @@ func (r *IPResource) Create(
 
 	res, err := r.M.Core.PostOrganizationIpAddressesWithResponse(ctx, args)
 	if err != nil {
-		// If we have a response, we can use the body to get the error
-		// message. If we don't have a response, we can use the error
-		// message.
 		if res != nil {
-			resp.Diagnostics.AddError(
-				"IP Address Create Error",
-				string(res.Body))
-		} else {
-			resp.Diagnostics.AddError(
-				"IP Address Create Error",
-				err.Error())
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
+		addAPIError(
+			&resp.Diagnostics, "IP Address Create Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -234,12 +278,16 @@ func (r *IPResource) Create(
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 
 	if err := r.IPRead(ctx, *id, &plan); err != nil {
-		resp.Diagnostics.AddError("IP Address Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "IP Address Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *IPResource) Read(
@@ -264,12 +312,16 @@ func (r *IPResource) Read(
 			return
 		}
 
-		resp.Diagnostics.AddError("IP Address Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "IP Address Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *IPResource) Update(
@@ -308,19 +360,30 @@ func (r *IPResource) Update(
 		args.Label = plan.Label.ValueStringPointer()
 	}
 
-	_, err := r.M.Core.PatchIpAddressWithResponse(ctx, args)
+	res, err := r.M.Core.PatchIpAddressWithResponse(ctx, args)
 	if err != nil {
-		resp.Diagnostics.AddError("IP Address Update Error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "IP Address Update Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	if err := r.IPRead(ctx, id, &plan); err != nil {
-		resp.Diagnostics.AddError("IP Address Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "IP Address Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *IPResource) Delete(
@@ -335,14 +398,21 @@ func (r *IPResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.DeleteIpAddressWithResponse(ctx,
+	res, err := r.M.Core.DeleteIpAddressWithResponse(ctx,
 		core.DeleteIpAddressJSONRequestBody{
 			IpAddress: core.IPAddressLookup{
 				Id: state.ID.ValueStringPointer(),
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("IP Address Delete Error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "IP Address Delete Error", err,
+			resp.State.Schema.Type(),
+		)
 	}
 }
 
@@ -357,6 +427,10 @@ func (r *IPResource) IPRead(
 		},
 	)
 	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 
@@ -387,13 +461,23 @@ func (r *IPResource) IPRead(
 	return nil
 }
 
+func (r *IPResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the IP address.")
+}
+
 func (r *IPResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	if strings.HasPrefix(req.ID, "ip_") {
-		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if req.ID == "" || strings.HasPrefix(req.ID, "ip_") {
+		resource.ImportStatePassthroughWithIdentity(
+			ctx, path.Root("id"), path.Root("id"), req, resp,
+		)
 		return
 	}
 
@@ -401,7 +485,14 @@ func (r *IPResource) ImportState(
 		IpAddressAddress: &req.ID,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("IP Address Import Error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "IP Address Import Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -414,6 +505,71 @@ func (r *IPResource) ImportState(
 	)
 }
 
+// MoveState moves katapult_legacy_ip state to katapult_ip.
+func (r *IPResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.StringAttribute{Computed: true},
+					"network_id":        schema.StringAttribute{Optional: true},
+					"version":           schema.Int64Attribute{Optional: true},
+					"address":           schema.StringAttribute{Computed: true},
+					"address_with_mask": schema.StringAttribute{Computed: true},
+					"reverse_dns":       schema.StringAttribute{Computed: true},
+					"vip":               schema.BoolAttribute{Optional: true},
+					"label":             schema.StringAttribute{Optional: true},
+					"allocation_type":   schema.StringAttribute{Computed: true},
+					"allocation_id":     schema.StringAttribute{Computed: true},
+				},
+			},
+			StateMover: moveLegacyIPState,
+		},
+	}
+}
+
+func moveLegacyIPState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+) {
+	if !movedFromLegacy(req, "katapult_legacy_ip") {
+		return
+	}
+
+	var prior legacyIPResourceModel
+	if !getLegacySourceState(ctx, req, resp, &prior) {
+		return
+	}
+
+	state := IPResourceModel{
+		ID:              prior.ID,
+		NetworkID:       prior.NetworkID,
+		Version:         prior.Version,
+		Address:         prior.Address,
+		AddressWithMask: prior.AddressWithMask,
+		ReverseDNS:      prior.ReverseDNS,
+		VIP:             prior.VIP,
+		Label:           prior.Label,
+		AllocationType:  prior.AllocationType,
+		AllocationID:    prior.AllocationID,
+		Organization:    types.StringNull(),
+		DataCenter:      types.StringNull(),
+	}
+
+	if state.Version.IsNull() {
+		state.Version = types.Int64Value(4)
+	}
+	state.VIP = types.BoolValue(state.VIP.ValueBool())
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+	resp.Diagnostics.Append(
+		setIDIdentity(ctx, resp.TargetIdentity, state.ID)...,
+	)
+}
+
 func unflattenIPVersion(ver int64) core.IPAddressVersionEnum {
 	switch ver {
 	case 6:
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,6 +20,12 @@ import (
 	core "github.com/krystal/go-katapult/next/core"
 )
 
+var (
+	_ resource.ResourceWithIdentity       = (*LoadBalancerResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*LoadBalancerResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*LoadBalancerResource)(nil)
+)
+
 type (
 	LoadBalancerResource struct {
 		M *Meta
@@ -32,6 +39,9 @@ type (
 		TagIDs                 types.Set    `tfsdk:"tag_ids"`
 		IPAddress              types.String `tfsdk:"ip_address"`
 		HTTPSRedirect          types.Bool   `tfsdk:"https_redirect"`
+		Organization           types.String `tfsdk:"organization"`
+		DataCenter             types.String `tfsdk:"data_center"`
+		Rules                  types.List   `tfsdk:"rule"`
 	}
 )
 
@@ -156,10 +166,128 @@ func (r LoadBalancerResource) Schema(
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
+			dataCenterAttributeName:   dataCenterResourceAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			loadBalancerRuleBlockName: loadBalancerInlineRuleBlock(),
 		},
 	}
 }
 
+func (r LoadBalancerResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var rules types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(
+		ctx, path.Root(loadBalancerRuleBlockName), &rules,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inline, diags := loadBalancerInlineRules(ctx, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateLoadBalancerInlineRules(&resp.Diagnostics, inline)
+}
+
+// ModifyPlan matches rule blocks to the rules on the load balancer by listen
+// port and carries their IDs over, so only rules which will be created are
+// planned with an unknown ID. Rules which are not configured, and were not
+// managed by rule blocks before, most likely belong to
+// katapult_load_balancer_rule resources, so the plan is rejected rather than
+// letting rule blocks delete them. State is used when the rules cannot be
+// listed.
+func (r *LoadBalancerResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planRules, stateRules types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(
+		ctx, path.Root(loadBalancerRuleBlockName), &planRules,
+	)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(
+		ctx, path.Root(loadBalancerRuleBlockName), &stateRules,
+	)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, diags := loadBalancerInlineRules(ctx, planRules)
+	resp.Diagnostics.Append(diags...)
+	state, diags := loadBalancerInlineRules(ctx, stateRules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(plan) == 0 {
+		return
+	}
+
+	existing := make([]loadBalancerRuleListener, 0, len(state))
+	for _, rule := range state {
+		existing = append(existing, loadBalancerRuleListener{
+			ID:         rule.ID.ValueString(),
+			ListenPort: rule.ListenPort.ValueInt64(),
+			Protocol:   rule.Protocol.ValueString(),
+		})
+	}
+
+	if r.M != nil {
+		var lbID types.String
+		resp.Diagnostics.Append(
+			req.State.GetAttribute(ctx, path.Root("id"), &lbID)...,
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		live, err := listLoadBalancerRuleListeners(
+			ctx, r.M, lbID.ValueString(),
+		)
+		if err != nil {
+			r.M.Logger.Debug(
+				"using state to plan load balancer rule blocks",
+				"load_balancer_id", lbID.ValueString(),
+				"error", err,
+			)
+		} else {
+			existing = live
+
+			managed, _, diags := managedLoadBalancerInlineRules(
+				ctx, req.Private,
+			)
+			resp.Diagnostics.Append(diags...)
+			addUnmanagedLoadBalancerRulesError(
+				&resp.Diagnostics, lbID.ValueString(),
+				unmanagedLoadBalancerRules(plan, existing, managed),
+			)
+		}
+	}
+
+	if !planLoadBalancerInlineRuleIDs(plan, existing) {
+		return
+	}
+
+	planRules, diags = loadBalancerInlineRulesValue(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(
+		ctx, path.Root(loadBalancerRuleBlockName), planRules,
+	)...)
+}
+
 func (r *LoadBalancerResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -181,9 +309,14 @@ func (r *LoadBalancerResource) Create(
 		t = core.VirtualMachines
 	}
 
+	org := r.M.organization(plan.Organization)
+	dataCenter := r.M.dataCenter(plan.DataCenter)
+	plan.Organization = types.StringValue(org)
+	plan.DataCenter = types.StringValue(dataCenter)
+
 	args := core.PostOrganizationLoadBalancersJSONRequestBody{
 		Organization: core.OrganizationLookup{
-			SubDomain: &r.M.confOrganization,
+			SubDomain: &org,
 		},
 		Properties: core.LoadBalancerArguments{
 			Name:          &name,
@@ -191,7 +324,7 @@ func (r *LoadBalancerResource) Create(
 			ResourceIds:   &ids,
 			HttpsRedirect: plan.HTTPSRedirect.ValueBoolPointer(),
 			DataCenter: &core.DataCenterLookup{
-				Permalink: &r.M.confDataCenter,
+				Permalink: &dataCenter,
 			},
 		},
 	}
@@ -199,18 +332,65 @@ func (r *LoadBalancerResource) Create(
 	res, err := r.M.Core.
 		PostOrganizationLoadBalancersWithResponse(ctx, args)
 	if err != nil {
-		resp.Diagnostics.AddError("Load Balancer Create Error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Load Balancer Create Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	id := *res.JSON201.LoadBalancer.Id
 
+	planRules, diags := loadBalancerInlineRules(ctx, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.LoadBalancerRead(ctx, id, &plan); err != nil {
-		resp.Diagnostics.AddError("Load Balancer Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Load Balancer Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
+	if len(planRules) > 0 {
+		// Save the load balancer before creating its rules, so it is not
+		// lost from state if a rule fails to be created.
+		created := plan
+		created.Rules = types.ListValueMust(LoadBalancerInlineRuleType(), nil)
+		resp.Diagnostics.Append(resp.State.Set(ctx, created)...)
+		resp.Diagnostics.Append(
+			setIDIdentity(ctx, resp.Identity, created.ID)...,
+		)
+		if resp.Private != nil {
+			resp.Diagnostics.Append(setManagedLoadBalancerInlineRules(
+				ctx, resp.Private, []LoadBalancerInlineRuleModel{},
+			)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		rules, diags := r.applyInlineRules(ctx, id, &plan, planRules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if resp.Private != nil {
+			resp.Diagnostics.Append(
+				setManagedLoadBalancerInlineRules(ctx, resp.Private, rules)...,
+			)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *LoadBalancerResource) Read(
@@ -237,12 +417,48 @@ func (r *LoadBalancerResource) Read(
 			return
 		}
 
-		resp.Diagnostics.AddError("Load Balancer Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Load Balancer Read Error", err,
+			resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	stateRules, diags := loadBalancerInlineRules(ctx, state.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, managed, diags := managedLoadBalancerInlineRules(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Rules are only refreshed while managed through rule blocks, so rules
+	// managed by katapult_load_balancer_rule resources are left alone.
+	if managed || len(stateRules) > 0 {
+		rules, err := readLoadBalancerInlineRules(
+			ctx, r.M, state.ID.ValueString(), stateRules,
+		)
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics, "Load Balancer Rule Read Error", err, nil,
+			)
+			return
+		}
+
+		state.Rules, diags = loadBalancerInlineRulesValue(ctx, rules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *LoadBalancerResource) Update(
@@ -289,19 +505,54 @@ func (r *LoadBalancerResource) Update(
 		args.Properties.ResourceIds = &ids
 	}
 
-	_, err := r.M.Core.PatchLoadBalancerWithResponse(ctx, args)
+	res, err := r.M.Core.PatchLoadBalancerWithResponse(ctx, args)
 	if err != nil {
-		resp.Diagnostics.AddError("Load Balancer Update Error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Load Balancer Update Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	if err := r.LoadBalancerRead(ctx, id, &plan); err != nil {
-		resp.Diagnostics.AddError("Load Balancer Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Load Balancer Read Error", err,
+			resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	planRules, diags := loadBalancerInlineRules(ctx, plan.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	switch {
+	case len(planRules) > 0:
+		rules, diags := r.applyInlineRules(ctx, id, &plan, planRules)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if resp.Private != nil {
+			resp.Diagnostics.Append(
+				setManagedLoadBalancerInlineRules(ctx, resp.Private, rules)...,
+			)
+		}
+	case resp.Private != nil:
+		resp.Diagnostics.Append(
+			setManagedLoadBalancerInlineRules(ctx, resp.Private, nil)...,
+		)
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *LoadBalancerResource) Delete(
@@ -316,23 +567,40 @@ func (r *LoadBalancerResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.DeleteLoadBalancerWithResponse(ctx,
+	res, err := r.M.Core.DeleteLoadBalancerWithResponse(ctx,
 		core.DeleteLoadBalancerJSONRequestBody{
 			LoadBalancer: core.LoadBalancerLookup{
 				Id: state.ID.ValueStringPointer(),
 			},
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("Load Balancer Delete Error", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Load Balancer Delete Error", err,
+			resp.State.Schema.Type(),
+		)
 	}
 }
 
+func (r *LoadBalancerResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the load balancer.")
+}
+
 func (r *LoadBalancerResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 }
 
 func (r *LoadBalancerResource) LoadBalancerRead(
@@ -346,6 +614,10 @@ func (r *LoadBalancerResource) LoadBalancerRead(
 			LoadBalancerId: &id,
 		})
 	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 
@@ -361,9 +633,39 @@ func (r *LoadBalancerResource) LoadBalancerRead(
 
 	populateLoadBalancerTargets(model, *lb.ResourceType, *lb.ResourceIds)
 
+	if model.Rules.IsNull() || model.Rules.IsUnknown() {
+		model.Rules = types.ListValueMust(LoadBalancerInlineRuleType(), nil)
+	}
+
 	return nil
 }
 
+// applyInlineRules reconciles the rules of load balancer id with planRules,
+// and sets the rules of model to the result, which is also returned.
+func (r *LoadBalancerResource) applyInlineRules(
+	ctx context.Context,
+	id string,
+	model *LoadBalancerResourceModel,
+	planRules []LoadBalancerInlineRuleModel,
+) ([]LoadBalancerInlineRuleModel, diag.Diagnostics) {
+	diags := reconcileLoadBalancerInlineRules(ctx, r.M, id, planRules)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	rules, err := readLoadBalancerInlineRules(ctx, r.M, id, planRules)
+	if err != nil {
+		addAPIError(&diags, "Load Balancer Rule Read Error", err, nil)
+		return nil, diags
+	}
+
+	var d diag.Diagnostics
+	model.Rules, d = loadBalancerInlineRulesValue(ctx, rules)
+	diags.Append(d...)
+
+	return rules, diags
+}
+
 func populateLoadBalancerTargets(
 	model *LoadBalancerResourceModel,
 	t core.LoadBalancerResourceTypesEnum,
@@ -3,6 +3,7 @@ package v6provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -26,6 +27,12 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var (
+	_ resource.ResourceWithIdentity     = (*LoadBalancerRuleResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*LoadBalancerRuleResource)(nil)
+	_ resource.ResourceWithModifyPlan   = (*LoadBalancerRuleResource)(nil)
+)
+
 type (
 	LoadBalancerRuleResource struct {
 		M *Meta
@@ -205,7 +212,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			Default:  int64default.StaticInt64(2),
 			Validators: []validator.Int64{
 				int64validator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 				int64validator.AtLeast(1),
 			},
@@ -219,7 +226,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			Default:  int64default.StaticInt64(20),
 			Validators: []validator.Int64{
 				int64validator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 				int64validator.AtLeast(1),
 			},
@@ -233,7 +240,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			Default:  stringdefault.StaticString("2"),
 			Validators: []validator.String{
 				stringvalidator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 			},
 			PlanModifiers: []planmodifier.String{
@@ -246,7 +253,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			Default:  stringdefault.StaticString("/"),
 			Validators: []validator.String{
 				stringvalidator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 				stringvalidator.LengthAtLeast(1),
 			},
@@ -262,7 +269,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			),
 			Validators: []validator.String{
 				stringvalidator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 				stringvalidator.OneOf(
 					string(core.LoadBalancerRuleCheckProtocolEnumHTTP),
@@ -279,7 +286,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			Default:  int64default.StaticInt64(2),
 			Validators: []validator.Int64{
 				int64validator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 			},
 			PlanModifiers: []planmodifier.Int64{
@@ -292,7 +299,7 @@ func LoadBalancerRuleSchemaAttributes() map[string]schema.Attribute {
 			Default:  int64default.StaticInt64(5),
 			Validators: []validator.Int64{
 				int64validator.AlsoRequires(
-					path.MatchRoot("check_enabled"),
+					path.MatchRelative().AtParent().AtName("check_enabled"),
 				),
 			},
 			PlanModifiers: []planmodifier.Int64{
@@ -308,6 +315,16 @@ func (r LoadBalancerRuleResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a rule of a load balancer. Rules " +
+			"cannot be combined with `rule` blocks on the same " +
+			"`katapult_load_balancer`.\n\n" +
+			"Planning warns when `listen_port` is already used by " +
+			"another rule on the load balancer, as applying fails " +
+			"unless that rule is deleted or moved to another port " +
+			"first. Conflicts between rules which are all being " +
+			"created in the same apply are not checked when planning, " +
+			"and are only reported by the API when applying.",
+		Version:    1,
 		Attributes: LoadBalancerRuleSchemaAttributes(),
 	}
 }
@@ -325,12 +342,21 @@ func (r LoadBalancerRuleResource) ValidateConfig(
 		return
 	}
 
+	validateLoadBalancerRuleConfig(&resp.Diagnostics, &data)
+}
+
+// validateLoadBalancerRuleConfig checks the settings of a single rule which
+// only apply to some protocols. It is shared by standalone and inline rules.
+func validateLoadBalancerRuleConfig(
+	diags *diag.Diagnostics,
+	data *LoadBalancerRuleResourceModel,
+) {
 	proto := data.Protocol.ValueString()
 	checkProto := data.CheckProtocol.ValueString()
 
 	if data.CheckPath.ValueStringPointer() != nil {
 		if checkProto != "HTTP" {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"check_path",
 				"check_path cannot be set if check_protocol is not HTTP",
 			)
@@ -339,7 +365,7 @@ func (r LoadBalancerRuleResource) ValidateConfig(
 
 	if data.CheckHTTPStatuses.ValueStringPointer() != nil {
 		if checkProto != "HTTP" {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"check_http_statuses",
 				"check_http_statuses cannot be set if "+
 					"check_protocol is not HTTP",
@@ -348,20 +374,86 @@ func (r LoadBalancerRuleResource) ValidateConfig(
 	}
 
 	if !data.CertificateIDs.IsNull() && proto != "HTTPS" {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"certificate_ids",
 			"certificate_ids cannot be set if protocol is not HTTPS",
 		)
 	}
 
 	if data.PassthroughSSL.ValueBool() && proto != "HTTPS" {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"passthrough_ssl",
 			"passthrough_ssl cannot be set if protocol is not HTTPS",
 		)
 	}
 }
 
+// ModifyPlan warns about a listen port already used by another rule on the
+// same load balancer, which the API would otherwise only reject at apply
+// time. Plans of other resources are not visible here, so the other rule
+// may be deleted, replaced or moved to another port in the same apply: the
+// conflict is reported as a warning rather than blocking such moves. The
+// check is best effort: it is skipped when the load balancer's rules cannot
+// be listed, such as when the load balancer is yet to be created, and rules
+// which are all being created in the same apply are not checked.
+func (r *LoadBalancerRuleResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() || r.M == nil {
+		return
+	}
+
+	var plan LoadBalancerRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.LoadBalancerID.IsUnknown() || plan.ListenPort.IsUnknown() ||
+		plan.Protocol.IsUnknown() {
+		return
+	}
+
+	var selfID string
+	if !req.State.Raw.IsNull() {
+		var state LoadBalancerRuleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.LoadBalancerID.Equal(state.LoadBalancerID) &&
+			plan.ListenPort.Equal(state.ListenPort) &&
+			plan.Protocol.Equal(state.Protocol) {
+			return
+		}
+		selfID = state.ID.ValueString()
+	}
+
+	lbID := plan.LoadBalancerID.ValueString()
+	existing, err := listLoadBalancerRuleListeners(ctx, r.M, lbID)
+	if err != nil {
+		r.M.Logger.Debug(
+			"skipping load balancer rule conflict check",
+			"load_balancer_id", lbID,
+			"error", err,
+		)
+		return
+	}
+
+	rule := loadBalancerRuleListener{
+		ID:         selfID,
+		ListenPort: plan.ListenPort.ValueInt64(),
+		Protocol:   plan.Protocol.ValueString(),
+	}
+	if other, ok := loadBalancerRuleConflict(rule, existing); ok {
+		addLoadBalancerRuleConflict(
+			&resp.Diagnostics, diag.SeverityWarning, path.Empty(), lbID,
+			rule, other,
+		)
+	}
+}
+
 func (r *LoadBalancerRuleResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -390,9 +482,13 @@ func (r *LoadBalancerRuleResource) Create(
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"LoadBalancerRule Create Error",
-			"Error creating LoadBalancerRule: "+err.Error(),
+		if lbrRes != nil {
+			err = genericAPIError(err, lbrRes.Body, lbrRes.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "LoadBalancerRule Create Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
@@ -403,14 +499,15 @@ func (r *LoadBalancerRuleResource) Create(
 		*lbr.Id,
 		&plan,
 	); err != nil {
-		resp.Diagnostics.AddError(
-			"LoadBalancerRule Read Error",
-			"Error reading LoadBalancerRule: "+err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "LoadBalancerRule Read Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *LoadBalancerRuleResource) Read(
@@ -435,14 +532,15 @@ func (r *LoadBalancerRuleResource) Read(
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"LoadBalancerRule Read Error",
-			"Error reading LoadBalancerRule: "+err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "LoadBalancerRule Read Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *LoadBalancerRuleResource) Update(
@@ -473,7 +571,7 @@ func (r *LoadBalancerRuleResource) Update(
 		return
 	}
 
-	_, err := r.M.Core.
+	res, err := r.M.Core.
 		PatchLoadBalancersRulesLoadBalancerRuleWithResponse(ctx,
 			core.PatchLoadBalancersRulesLoadBalancerRuleJSONRequestBody{
 				LoadBalancerRule: core.LoadBalancerRuleLookup{
@@ -483,9 +581,13 @@ func (r *LoadBalancerRuleResource) Update(
 			},
 		)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"LoadBalancerRule Update Error",
-			"Error updating LoadBalancerRule: "+err.Error(),
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "LoadBalancerRule Update Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
@@ -495,14 +597,15 @@ func (r *LoadBalancerRuleResource) Update(
 	}
 
 	if err = r.LoadBalancerRuleRead(ctx, id, &plan); err != nil {
-		resp.Diagnostics.AddError(
-			"LoadBalancerRule Read Error",
-			"Error reading LoadBalancerRule: "+err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "LoadBalancerRule Read Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *LoadBalancerRuleResource) Delete(
@@ -516,7 +619,7 @@ func (r *LoadBalancerRuleResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.
+	res, err := r.M.Core.
 		DeleteLoadBalancersRulesLoadBalancerRuleWithResponse(ctx,
 			core.DeleteLoadBalancersRulesLoadBalancerRuleJSONRequestBody{
 				LoadBalancerRule: core.LoadBalancerRuleLookup{
@@ -525,19 +628,122 @@ func (r *LoadBalancerRuleResource) Delete(
 			},
 		)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"LoadBalancerRule Delete Error",
-			"Error deleting LoadBalancerRule: "+err.Error(),
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "LoadBalancerRule Delete Error", err,
+			resp.State.Schema.Type(),
 		)
 	}
 }
 
+func (r *LoadBalancerRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the load balancer rule.")
+}
+
 func (r *LoadBalancerRuleResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// UpgradeState migrates version 0 state, which older releases wrote without
+// every attribute of the current layout.
+func (r *LoadBalancerRuleResource) UpgradeState(
+	_ context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":               schema.StringAttribute{Computed: true},
+					"load_balancer_id": schema.StringAttribute{Required: true},
+					"algorithm":        schema.StringAttribute{Optional: true},
+					"destination_port": schema.Int64Attribute{Required: true},
+					"listen_port":      schema.Int64Attribute{Required: true},
+					"protocol":         schema.StringAttribute{Required: true},
+					"proxy_protocol":   schema.BoolAttribute{Optional: true},
+					"certificate_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"backend_ssl":         schema.BoolAttribute{Optional: true},
+					"passthrough_ssl":     schema.BoolAttribute{Optional: true},
+					"check_enabled":       schema.BoolAttribute{Optional: true},
+					"check_fall":          schema.Int64Attribute{Optional: true},
+					"check_interval":      schema.Int64Attribute{Optional: true},
+					"check_http_statuses": schema.StringAttribute{Optional: true},
+					"check_path":          schema.StringAttribute{Optional: true},
+					"check_protocol":      schema.StringAttribute{Optional: true},
+					"check_rise":          schema.Int64Attribute{Optional: true},
+					"check_timeout":       schema.Int64Attribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeLoadBalancerRuleStateV0,
+		},
+	}
+}
+
+func upgradeLoadBalancerRuleStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var state LoadBalancerRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill attributes missing from older state with their schema defaults,
+	// so the upgrade alone does not plan an update.
+	if state.Algorithm.IsNull() {
+		state.Algorithm = types.StringValue(string(core.RoundRobin))
+	}
+	state.ProxyProtocol = types.BoolValue(state.ProxyProtocol.ValueBool())
+	state.BackendSSL = types.BoolValue(state.BackendSSL.ValueBool())
+	state.PassthroughSSL = types.BoolValue(state.PassthroughSSL.ValueBool())
+	state.CheckEnabled = types.BoolValue(state.CheckEnabled.ValueBool())
+	if state.CertificateIDs.IsNull() {
+		state.CertificateIDs = types.SetValueMust(
+			types.StringType, []attr.Value{},
+		)
+	}
+	if state.CheckFall.IsNull() {
+		state.CheckFall = types.Int64Value(2)
+	}
+	if state.CheckInterval.IsNull() {
+		state.CheckInterval = types.Int64Value(20)
+	}
+	if state.CheckHTTPStatuses.IsNull() {
+		state.CheckHTTPStatuses = types.StringValue("2")
+	}
+	if state.CheckPath.IsNull() {
+		state.CheckPath = types.StringValue("/")
+	}
+	if state.CheckProtocol.IsNull() {
+		state.CheckProtocol = types.StringValue(
+			string(core.LoadBalancerRuleCheckProtocolEnumHTTP),
+		)
+	}
+	if state.CheckRise.IsNull() {
+		state.CheckRise = types.Int64Value(2)
+	}
+	if state.CheckTimeout.IsNull() {
+		state.CheckTimeout = types.Int64Value(5)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *LoadBalancerRuleResource) LoadBalancerRuleRead(
@@ -551,11 +757,22 @@ func (r *LoadBalancerRuleResource) LoadBalancerRuleRead(
 		},
 	)
 	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 
-	lbr := res.JSON200.LoadBalancerRule
+	populateLoadBalancerRuleModel(model, res.JSON200.LoadBalancerRule)
+
+	return nil
+}
 
+func populateLoadBalancerRuleModel(
+	model *LoadBalancerRuleResourceModel,
+	lbr core.GetLoadBalancersRulesLoadBalancerRule200ResponseLoadBalancerRule,
+) {
 	model.ID = types.StringPointerValue(lbr.Id)
 	model.LoadBalancerID = types.StringPointerValue(lbr.LoadBalancer.Id)
 	model.Algorithm = types.StringValue(string(*lbr.Algorithm))
@@ -581,12 +798,122 @@ func (r *LoadBalancerRuleResource) LoadBalancerRuleRead(
 
 	checkHTTPStatuses, _ := lbr.CheckHttpStatuses.Get()
 	model.CheckHTTPStatuses = types.StringValue(string(checkHTTPStatuses))
-
-	return nil
 }
 
 // helpers
 
+// loadBalancerRuleListener is the port and protocol a load balancer rule
+// listens on. ID is empty for rules which are yet to be created.
+type loadBalancerRuleListener struct {
+	ID         string
+	ListenPort int64
+	Protocol   string
+}
+
+// listLoadBalancerRuleListeners returns the listen port and protocol of every
+// rule on a load balancer.
+func listLoadBalancerRuleListeners(
+	ctx context.Context,
+	m *Meta,
+	lbID string,
+) ([]loadBalancerRuleListener, error) {
+	rules, err := listLBRules(ctx, m, lbID)
+	if err != nil {
+		return nil, err
+	}
+
+	listeners := make([]loadBalancerRuleListener, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Id == nil || rule.ListenPort == nil || rule.Protocol == nil {
+			continue
+		}
+		listeners = append(listeners, loadBalancerRuleListener{
+			ID:         *rule.Id,
+			ListenPort: int64(*rule.ListenPort),
+			Protocol:   string(*rule.Protocol),
+		})
+	}
+
+	return listeners, nil
+}
+
+// loadBalancerRuleConflict returns the first of others listening on the same
+// port as rule, skipping rule itself.
+func loadBalancerRuleConflict(
+	rule loadBalancerRuleListener,
+	others []loadBalancerRuleListener,
+) (loadBalancerRuleListener, bool) {
+	for _, other := range others {
+		if rule.ID != "" && other.ID == rule.ID {
+			continue
+		}
+		if other.ListenPort == rule.ListenPort {
+			return other, true
+		}
+	}
+
+	return loadBalancerRuleListener{}, false
+}
+
+// addLoadBalancerRuleConflict reports rule clashing with other. The
+// diagnostic is attached to listen_port under base when both use the same
+// protocol, and to protocol when a second protocol is requested on an
+// occupied port. An empty lbID refers to the load balancer being planned.
+// Warnings are used when other is a live rule, which may be removed from the
+// port in the same apply.
+func addLoadBalancerRuleConflict(
+	diags *diag.Diagnostics,
+	severity diag.Severity,
+	base path.Path,
+	lbID string,
+	rule loadBalancerRuleListener,
+	other loadBalancerRuleListener,
+) {
+	otherName := "another rule"
+	if other.ID != "" {
+		otherName = "rule " + other.ID
+	}
+
+	lbName := "load balancer " + lbID
+	if lbID == "" {
+		lbName = "this load balancer"
+	}
+
+	add := diags.AddAttributeError
+	suffix := ""
+	if severity == diag.SeverityWarning {
+		add = diags.AddAttributeWarning
+		suffix = " Applying will fail unless " + otherName + " is " +
+			"deleted or moved to another port first."
+	}
+
+	if strings.EqualFold(rule.Protocol, other.Protocol) {
+		add(
+			base.AtName("listen_port"),
+			"Listen Port Conflict",
+			fmt.Sprintf(
+				"%s already has %s listening on port %d.%s",
+				strings.ToUpper(lbName[:1])+lbName[1:], otherName,
+				rule.ListenPort, suffix,
+			),
+		)
+
+		return
+	}
+
+	add(
+		base.AtName("protocol"),
+		"Protocol Conflict",
+		fmt.Sprintf(
+			"Port %d of %s is already used by %s for %s, "+
+				"so it cannot also serve %s.%s",
+			rule.ListenPort, lbName, otherName,
+			strings.ToUpper(other.Protocol), strings.ToUpper(rule.Protocol),
+			suffix,
+		),
+	)
+}
+
 func convertCertificateModelsToCertificateLookups(
 	ctx context.Context,
 	set basetypes.SetValue,
@@ -6,10 +6,13 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jimeh/undent"
 	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
 )
 
 // Test with minimal required configuration.
@@ -666,3 +669,92 @@ func testAccCheckKatapultLoadBalancerRuleAttrs(
 		return resource.ComposeAggregateTestCheckFunc(tfs...)(s)
 	}
 }
+
+func TestLoadBalancerRuleConflict(t *testing.T) {
+	existing := []loadBalancerRuleListener{
+		{ID: "lbrule_http", ListenPort: 80, Protocol: "HTTP"},
+		{ID: "lbrule_tcp", ListenPort: 5432, Protocol: "TCP"},
+	}
+
+	tests := []struct {
+		name     string
+		rule     loadBalancerRuleListener
+		wantDiag diag.Diagnostic
+	}{
+		{
+			name: "free port",
+			rule: loadBalancerRuleListener{ListenPort: 443, Protocol: "HTTPS"},
+		},
+		{
+			name: "existing rule itself",
+			rule: loadBalancerRuleListener{
+				ID: "lbrule_http", ListenPort: 80, Protocol: "HTTP",
+			},
+		},
+		{
+			name: "same protocol",
+			rule: loadBalancerRuleListener{ListenPort: 80, Protocol: "HTTP"},
+			wantDiag: diag.NewAttributeErrorDiagnostic(
+				path.Root("listen_port"),
+				"Listen Port Conflict",
+				"Load balancer lb_1 already has rule lbrule_http "+
+					"listening on port 80.",
+			),
+		},
+		{
+			name: "other protocol",
+			rule: loadBalancerRuleListener{
+				ID: "lbrule_new", ListenPort: 5432, Protocol: "HTTP",
+			},
+			wantDiag: diag.NewAttributeErrorDiagnostic(
+				path.Root("protocol"),
+				"Protocol Conflict",
+				"Port 5432 of load balancer lb_1 is already used by rule "+
+					"lbrule_tcp for TCP, so it cannot also serve HTTP.",
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+
+			other, ok := loadBalancerRuleConflict(tt.rule, existing)
+			if ok {
+				addLoadBalancerRuleConflict(
+					&diags, diag.SeverityError, path.Empty(), "lb_1",
+					tt.rule, other,
+				)
+			}
+
+			if tt.wantDiag == nil {
+				assert.False(t, ok)
+				assert.Empty(t, diags)
+
+				return
+			}
+			assert.Equal(t, diag.Diagnostics{tt.wantDiag}, diags)
+		})
+	}
+}
+
+func TestAddLoadBalancerRuleConflictWarning(t *testing.T) {
+	var diags diag.Diagnostics
+
+	addLoadBalancerRuleConflict(
+		&diags, diag.SeverityWarning, path.Empty(), "lb_1",
+		loadBalancerRuleListener{ListenPort: 80, Protocol: "HTTP"},
+		loadBalancerRuleListener{
+			ID: "lbrule_http", ListenPort: 80, Protocol: "HTTP",
+		},
+	)
+
+	assert.Equal(t, diag.Diagnostics{
+		diag.NewAttributeWarningDiagnostic(
+			path.Root("listen_port"),
+			"Listen Port Conflict",
+			"Load balancer lb_1 already has rule lbrule_http listening "+
+				"on port 80. Applying will fail unless rule lbrule_http "+
+				"is deleted or moved to another port first.",
+		),
+	}, diags)
+}
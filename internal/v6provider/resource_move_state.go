@@ -0,0 +1,47 @@
+package v6provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// movedFromLegacy reports whether req moves state from typeName, one of the
+// katapult_legacy_* resources served by the SDKv2 half of this provider.
+// State from other providers is left to other state movers.
+func movedFromLegacy(req resource.MoveStateRequest, typeName string) bool {
+	if req.SourceTypeName != typeName {
+		return false
+	}
+
+	return strings.HasSuffix(req.SourceProviderAddress, "/katapult")
+}
+
+// getLegacySourceState decodes the source state of a move from a legacy
+// resource into target. It reports false, with an error diagnostic, when the
+// state does not match the legacy schema.
+func getLegacySourceState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+	target any,
+) bool {
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf(
+				"The state of %s (schema version %d) does not match the "+
+					"layout this provider can move from.",
+				req.SourceTypeName, req.SourceSchemaVersion,
+			),
+		)
+
+		return false
+	}
+
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, target)...)
+
+	return !resp.Diagnostics.HasError()
+}
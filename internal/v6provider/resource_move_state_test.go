@@ -0,0 +1,386 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLegacyProviderAddress = "registry.terraform.io/krystal/katapult"
+
+type testMoveStateResource interface {
+	resource.ResourceWithMoveState
+	resource.ResourceWithIdentity
+}
+
+// testMoveState runs the first state mover of r against source, whose
+// attributes are set on a state built from the mover's source schema.
+func testMoveState(
+	t *testing.T,
+	r testMoveStateResource,
+	sourceTypeName string,
+	source map[string]attr.Value,
+) *resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	movers := r.MoveState(ctx)
+	require.Len(t, movers, 1)
+
+	sourceState := &tfsdk.State{
+		Schema: movers[0].SourceSchema,
+		Raw: tftypes.NewValue(
+			movers[0].SourceSchema.Type().TerraformType(ctx), nil,
+		),
+	}
+	for name, value := range source {
+		diags := sourceState.SetAttribute(ctx, path.Root(name), value)
+		require.False(t, diags.HasError(), diags.Errors())
+	}
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	var readReq resource.ReadRequest
+	var readResp resource.ReadResponse
+	initializeResourcePrivateState(t, &readReq, &readResp)
+
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(
+				schemaResp.Schema.Type().TerraformType(ctx), nil,
+			),
+		},
+		TargetIdentity: testResourceIdentity(t, r, nil),
+		TargetPrivate:  readResp.Private,
+	}
+	movers[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: testLegacyProviderAddress,
+		SourceTypeName:        sourceTypeName,
+		SourceState:           sourceState,
+	}, resp)
+
+	return resp
+}
+
+func testMovedID(t *testing.T, resp *resource.MoveStateResponse) string {
+	t.Helper()
+
+	var identity IDIdentityModel
+	require.False(t, resp.TargetIdentity.Get(context.Background(), &identity).HasError())
+
+	return identity.ID.ValueString()
+}
+
+func testStringSet(values ...string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func TestMoveStateIgnoresOtherSources(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		address  string
+		typeName string
+	}{
+		{
+			name:     "other provider",
+			address:  "registry.terraform.io/hashicorp/null",
+			typeName: "katapult_legacy_ip",
+		},
+		{
+			name:     "other resource",
+			address:  testLegacyProviderAddress,
+			typeName: "katapult_legacy_virtual_machine",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &IPResource{}
+			var resp resource.MoveStateResponse
+			r.MoveState(ctx)[0].StateMover(ctx, resource.MoveStateRequest{
+				SourceProviderAddress: tt.address,
+				SourceTypeName:        tt.typeName,
+			}, &resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.True(t, resp.TargetState.Raw.IsNull())
+		})
+	}
+}
+
+func TestMoveStateRejectsUndecodableSource(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	r := &VirtualMachineGroupResource{}
+	var resp resource.MoveStateResponse
+	r.MoveState(ctx)[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: testLegacyProviderAddress,
+		SourceTypeName:        "katapult_legacy_virtual_machine_group",
+		SourceSchemaVersion:   3,
+	}, &resp)
+
+	require.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t,
+		"Unable to Move Resource State", resp.Diagnostics.Errors()[0].Summary(),
+	)
+}
+
+func TestIPMoveStateFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	resp := testMoveState(t, &IPResource{}, "katapult_legacy_ip",
+		map[string]attr.Value{
+			"id":         types.StringValue("ip_one"),
+			"network_id": types.StringValue("netw_one"),
+			"address":    types.StringValue("192.0.2.10"),
+			"label":      types.StringValue("web"),
+		},
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var state IPResourceModel
+	require.False(t, resp.TargetState.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "ip_one", state.ID.ValueString())
+	assert.Equal(t, "192.0.2.10", state.Address.ValueString())
+	assert.Equal(t, "web", state.Label.ValueString())
+	assert.Equal(t, int64(4), state.Version.ValueInt64())
+	assert.Equal(t, types.BoolValue(false), state.VIP)
+	assert.Equal(t, "ip_one", testMovedID(t, resp))
+}
+
+func TestVirtualMachineGroupMoveStateFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	resp := testMoveState(t, &VirtualMachineGroupResource{},
+		"katapult_legacy_virtual_machine_group",
+		map[string]attr.Value{
+			"id":   types.StringValue("vmgrp_one"),
+			"name": types.StringValue("web"),
+		},
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var state VirtualMachineGroupResourceModel
+	require.False(t, resp.TargetState.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "web", state.Name.ValueString())
+	assert.True(t, state.Segregate.ValueBool())
+	assert.Equal(t, "vmgrp_one", testMovedID(t, resp))
+}
+
+func TestFileStorageVolumeMoveStateFromLegacy(t *testing.T) {
+	t.Parallel()
+
+	resp := testMoveState(t, &FileStorageVolumeResource{},
+		"katapult_legacy_file_storage_volume",
+		map[string]attr.Value{
+			"id":           types.StringValue("fsv_one"),
+			"name":         types.StringValue("shared"),
+			"nfs_location": types.StringValue("nfs://fsv_one"),
+		},
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var state FileStorageVolumeResourceModel
+	require.False(t, resp.TargetState.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "shared", state.Name.ValueString())
+	assert.Equal(t, "nfs://fsv_one", state.NFSLocation.ValueString())
+	assert.Equal(t, testStringSet(), state.Associations)
+	assert.True(t, state.Timeouts.IsNull())
+	assert.Equal(t, "fsv_one", testMovedID(t, resp))
+}
+
+func TestVirtualMachineMoveStateFromLegacy(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	diskType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"name": types.StringType,
+		"size": types.Int64Type,
+	}}
+	disks := types.ListValueMust(diskType, []attr.Value{
+		types.ObjectValueMust(diskType.AttrTypes, map[string]attr.Value{
+			"name": types.StringValue(""),
+			"size": types.Int64Value(20),
+		}),
+		types.ObjectValueMust(diskType.AttrTypes, map[string]attr.Value{
+			"name": types.StringValue("Data"),
+			"size": types.Int64Value(50),
+		}),
+	})
+
+	resp := testMoveState(t, &VirtualMachineResource{},
+		"katapult_legacy_virtual_machine",
+		map[string]attr.Value{
+			"id":            types.StringValue("vm_one"),
+			"name":          types.StringValue("web-1"),
+			"hostname":      types.StringValue("web-1"),
+			"package":       types.StringValue("rock-3"),
+			"disk_template": types.StringValue("templates/ubuntu-24-04"),
+			"disk_template_options": types.MapValueMust(
+				types.StringType, map[string]attr.Value{},
+			),
+			"disk":           disks,
+			"ip_address_ids": testStringSet("ip_one"),
+			"tags":           testStringSet("web"),
+		},
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var state VirtualMachineResourceModel
+	require.False(t, resp.TargetState.Get(ctx, &state).HasError())
+	assert.Equal(t, "web-1", state.Name.ValueString())
+	assert.Equal(t, "rock-3", state.Package.ValueString())
+	assert.True(t, state.DiskTemplateOptions.IsNull())
+	assert.True(t, state.SystemDisk.IsNull())
+	assert.True(t, state.PoweredOn.IsNull())
+	assert.True(t, state.AuthorizedKeyIDs.IsNull())
+	assert.Equal(t, testStringSet("ip_one"), state.IPAddressIDs)
+	assert.Equal(t, testStringSet(), state.VirtualNetworkIDs)
+	assert.Equal(t, testStringSet("web"), state.Tags)
+	assert.Equal(t, testStringSet("web"), state.TagsAll)
+	assert.Equal(t, "vm_one", testMovedID(t, resp))
+
+	var movedDisks []VirtualMachineDiskModel
+	require.False(t, state.Disk.ElementsAs(ctx, &movedDisks, false).HasError())
+	require.Len(t, movedDisks, 2)
+	assert.True(t, movedDisks[0].Name.IsNull())
+	assert.Equal(t, int64(20), movedDisks[0].Size.ValueInt64())
+	assert.Equal(t, "Data", movedDisks[1].Name.ValueString())
+	assert.Equal(t, int64(50), movedDisks[1].Size.ValueInt64())
+
+	moved, diags := resp.TargetPrivate.GetKey(
+		ctx, virtualMachineMovedLegacyDisksPrivateKey,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.NotEmpty(t, moved)
+}
+
+func TestVirtualMachineMoveStateFromLegacyWithoutDisks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resp := testMoveState(t, &VirtualMachineResource{},
+		"katapult_legacy_virtual_machine",
+		map[string]attr.Value{
+			"id":             types.StringValue("vm_one"),
+			"package":        types.StringValue("rock-3"),
+			"disk_template":  types.StringValue("templates/ubuntu-24-04"),
+			"ip_address_ids": testStringSet("ip_one"),
+		},
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var state VirtualMachineResourceModel
+	require.False(t, resp.TargetState.Get(ctx, &state).HasError())
+	assert.Empty(t, state.Disk.Elements())
+	assert.False(t, state.Disk.IsNull())
+
+	moved, diags := resp.TargetPrivate.GetKey(
+		ctx, virtualMachineMovedLegacyDisksPrivateKey,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.Empty(t, moved)
+}
+
+func TestAdoptMovedLegacyDisks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	attachment := func(id string) core.GetVirtualMachineDisks200ResponseDisks {
+		return core.GetVirtualMachineDisks200ResponseDisks{
+			Disk: &core.GetVirtualMachineDisksPartDisk{Id: ptr(id)},
+		}
+	}
+	attachments := []core.GetVirtualMachineDisks200ResponseDisks{
+		attachment("disk_data"), attachment("disk_boot"),
+	}
+
+	t.Run("not moved", func(t *testing.T) {
+		t.Parallel()
+
+		private := testMovePrivateState{}
+		require.NoError(t, adoptMovedLegacyDisks(ctx, private, attachments))
+		assert.Empty(t, private)
+	})
+
+	t.Run("moved", func(t *testing.T) {
+		t.Parallel()
+
+		private := testMovePrivateState{
+			virtualMachineMovedLegacyDisksPrivateKey: []byte("true"),
+		}
+		require.NoError(t, adoptMovedLegacyDisks(ctx, private, attachments))
+
+		var owned []string
+		require.NoError(t, json.Unmarshal(
+			private[virtualMachineLegacyDiskIDsPrivateKey], &owned,
+		))
+		assert.Equal(t, []string{"disk_boot", "disk_data"}, owned)
+		assert.Empty(t, private[virtualMachineMovedLegacyDisksPrivateKey])
+
+		require.NoError(t, validateLegacyDiskDeleteOwnership(
+			ctx, private, attachments,
+			types.ObjectNull(virtualMachineSystemDiskAttrTypes),
+		))
+	})
+
+	t.Run("incomplete relationship", func(t *testing.T) {
+		t.Parallel()
+
+		private := testMovePrivateState{
+			virtualMachineMovedLegacyDisksPrivateKey: []byte("true"),
+		}
+		err := adoptMovedLegacyDisks(ctx, private,
+			[]core.GetVirtualMachineDisks200ResponseDisks{{}},
+		)
+		require.Error(t, err)
+		assert.NotEmpty(t, private[virtualMachineMovedLegacyDisksPrivateKey])
+	})
+}
+
+type testMovePrivateState map[string][]byte
+
+func (p testMovePrivateState) GetKey(
+	_ context.Context,
+	key string,
+) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p testMovePrivateState) SetKey(
+	_ context.Context,
+	key string,
+	value []byte,
+) diag.Diagnostics {
+	if len(value) == 0 {
+		delete(p, key)
+		return nil
+	}
+	p[key] = value
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,23 +21,47 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var (
+	_ resource.ResourceWithIdentity     = (*ObjectStorageAccessKeyResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*ObjectStorageAccessKeyResource)(nil)
+)
+
 type (
 	ObjectStorageAccessKeyResource struct {
 		M *Meta
 	}
 
 	ObjectStorageAccessKeyResourceModel struct {
-		ID              types.String `tfsdk:"id"`
-		Name            types.String `tfsdk:"name"`
-		Region          types.String `tfsdk:"region"`
-		AllBucketsRead  types.Bool   `tfsdk:"all_buckets_read"`
-		AllObjectsRead  types.Bool   `tfsdk:"all_objects_read"`
-		AllObjectsWrite types.Bool   `tfsdk:"all_objects_write"`
-		ReadBuckets     types.Set    `tfsdk:"read_buckets"`
-		WriteBuckets    types.Set    `tfsdk:"write_buckets"`
-		AccessKeyID     types.String `tfsdk:"access_key_id"`
-		SecretAccessKey types.String `tfsdk:"secret_access_key"`
-		ServerURL       types.String `tfsdk:"server_url"`
+		ID              types.String   `tfsdk:"id"`
+		Name            types.String   `tfsdk:"name"`
+		Region          types.String   `tfsdk:"region"`
+		Organization    types.String   `tfsdk:"organization"`
+		AllBucketsRead  types.Bool     `tfsdk:"all_buckets_read"`
+		AllObjectsRead  types.Bool     `tfsdk:"all_objects_read"`
+		AllObjectsWrite types.Bool     `tfsdk:"all_objects_write"`
+		ReadBuckets     types.Set      `tfsdk:"read_buckets"`
+		WriteBuckets    types.Set      `tfsdk:"write_buckets"`
+		AccessKeyID     types.String   `tfsdk:"access_key_id"`
+		SecretAccessKey types.String   `tfsdk:"secret_access_key"`
+		ServerURL       types.String   `tfsdk:"server_url"`
+		Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	// objectStorageAccessKeyResourceModelV0 is the version 0 state layout,
+	// which predates the organization argument.
+	objectStorageAccessKeyResourceModelV0 struct {
+		ID              types.String   `tfsdk:"id"`
+		Name            types.String   `tfsdk:"name"`
+		Region          types.String   `tfsdk:"region"`
+		AllBucketsRead  types.Bool     `tfsdk:"all_buckets_read"`
+		AllObjectsRead  types.Bool     `tfsdk:"all_objects_read"`
+		AllObjectsWrite types.Bool     `tfsdk:"all_objects_write"`
+		ReadBuckets     types.Set      `tfsdk:"read_buckets"`
+		WriteBuckets    types.Set      `tfsdk:"write_buckets"`
+		AccessKeyID     types.String   `tfsdk:"access_key_id"`
+		SecretAccessKey types.String   `tfsdk:"secret_access_key"`
+		ServerURL       types.String   `tfsdk:"server_url"`
+		Timeouts        timeouts.Value `tfsdk:"timeouts"`
 	}
 )
 
@@ -71,11 +96,12 @@ func (r *ObjectStorageAccessKeyResource) Configure(
 }
 
 func (r *ObjectStorageAccessKeyResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		MarkdownDescription: strings.TrimSpace(`
 Manages an access key for a Katapult object storage cluster.
 `),
@@ -103,6 +129,7 @@ Manages an access key for a Katapult object storage cluster.
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
 			"all_buckets_read": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -167,6 +194,11 @@ Manages an access key for a Katapult object storage cluster.
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
 	}
 }
 
@@ -182,21 +214,110 @@ func (r *ObjectStorageAccessKeyResource) Create(
 		return
 	}
 
-	res, err := r.M.Core.PostOrganizationObjectStorageObjectStorageClusterAccessKeysWithResponse(
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
+	keyID, err := createObjectStorageAccessKey(
+		ctx, r.M, org, plan.Region.ValueString(),
+		core.ObjectStorageAccessKeyArguments{
+			Name:            plan.Name.ValueString(),
+			AllBucketsRead:  plan.AllBucketsRead.ValueBoolPointer(),
+			AllObjectsRead:  plan.AllObjectsRead.ValueBoolPointer(),
+			AllObjectsWrite: plan.AllObjectsWrite.ValueBoolPointer(),
+		},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Object Storage Access Key Create Error",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(keyID)
+	plan.ReadBuckets = buildStringSet(nil)
+	plan.WriteBuckets = buildStringSet(nil)
+	plan.AccessKeyID = types.StringNull()
+	plan.SecretAccessKey = types.StringNull()
+	plan.ServerURL = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentials, err := generateObjectStorageAccessKeyCredentials(
+		ctx, r.M, keyID, timeout,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Object Storage Access Key Credentials Error",
+			err.Error(),
+		)
+		return
+	}
+
+	plan.AccessKeyID = types.StringValue(credentials.S3AccessKeyId.MustGet())
+	plan.SecretAccessKey = types.StringValue(
+		credentials.S3SecretAccessKey.MustGet(),
+	)
+	plan.ServerURL = types.StringValue(credentials.ServerUrl.MustGet())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func validateObjectStorageAccessKeyCredentials(
+	key *core.ObjectStorageAccessKey,
+) error {
+	missing := make([]string, 0, 3)
+	if !key.S3AccessKeyId.IsSpecified() || key.S3AccessKeyId.IsNull() ||
+		strings.TrimSpace(key.S3AccessKeyId.MustGet()) == "" {
+		missing = append(missing, "s3_access_key_id")
+	}
+	if !key.S3SecretAccessKey.IsSpecified() || key.S3SecretAccessKey.IsNull() ||
+		strings.TrimSpace(key.S3SecretAccessKey.MustGet()) == "" {
+		missing = append(missing, "s3_secret_access_key")
+	}
+	if !key.ServerUrl.IsSpecified() || key.ServerUrl.IsNull() ||
+		strings.TrimSpace(key.ServerUrl.MustGet()) == "" {
+		missing = append(missing, "server_url")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"unexpected credentials response: missing non-empty field(s): %s",
+			strings.Join(missing, ", "),
+		)
+	}
+
+	return nil
+}
+
+// createObjectStorageAccessKey creates an access key in the given region and
+// returns its ID. Credentials are generated separately.
+func createObjectStorageAccessKey(
+	ctx context.Context,
+	m *Meta,
+	org string,
+	region string,
+	properties core.ObjectStorageAccessKeyArguments,
+) (string, error) {
+	res, err := m.Core.PostOrganizationObjectStorageObjectStorageClusterAccessKeysWithResponse(
 		ctx,
 		core.PostOrganizationObjectStorageObjectStorageClusterAccessKeysJSONRequestBody{
 			ObjectStorageCluster: core.ObjectStorageClusterLookup{
-				Region: plan.Region.ValueStringPointer(),
+				Region: &region,
 			},
 			Organization: core.OrganizationLookup{
-				SubDomain: &r.M.confOrganization,
-			},
-			Properties: core.ObjectStorageAccessKeyArguments{
-				Name:            plan.Name.ValueString(),
-				AllBucketsRead:  plan.AllBucketsRead.ValueBoolPointer(),
-				AllObjectsRead:  plan.AllObjectsRead.ValueBoolPointer(),
-				AllObjectsWrite: plan.AllObjectsWrite.ValueBoolPointer(),
+				SubDomain: &org,
 			},
+			Properties: properties,
 		},
 	)
 	if err != nil {
@@ -205,12 +326,7 @@ func (r *ObjectStorageAccessKeyResource) Create(
 			body = string(res.Body)
 		}
 
-		resp.Diagnostics.AddError(
-			"Object Storage Access Key Create Error",
-			fmt.Sprintf("%s: %s", err.Error(), body),
-		)
-
-		return
+		return "", fmt.Errorf("%s: %s", err.Error(), body)
 	}
 	if res == nil || res.JSON201 == nil ||
 		res.JSON201.ObjectStorageAccessKey.Id == nil ||
@@ -221,36 +337,33 @@ func (r *ObjectStorageAccessKeyResource) Create(
 			body = string(res.Body)
 			status = res.StatusCode()
 		}
-		resp.Diagnostics.AddError(
-			"Object Storage Access Key Create Error",
-			fmt.Sprintf("unexpected response (%d): %s", status, body),
-		)
-		return
-	}
 
-	keyID := res.JSON201.ObjectStorageAccessKey.Id
-	plan.ID = types.StringValue(*keyID)
-	plan.ReadBuckets = buildStringSet(nil)
-	plan.WriteBuckets = buildStringSet(nil)
-	plan.AccessKeyID = types.StringNull()
-	plan.SecretAccessKey = types.StringNull()
-	plan.ServerURL = types.StringNull()
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-	if resp.Diagnostics.HasError() {
-		return
+		return "", fmt.Errorf("unexpected response (%d): %s", status, body)
 	}
 
+	return *res.JSON201.ObjectStorageAccessKey.Id, nil
+}
+
+// generateObjectStorageAccessKeyCredentials requests S3 credentials for an
+// access key, retrying while the key is still being provisioned or the API is
+// rate limiting.
+func generateObjectStorageAccessKeyCredentials(
+	ctx context.Context,
+	m *Meta,
+	keyID string,
+	timeout time.Duration,
+) (*core.ObjectStorageAccessKey, error) {
 	type credsResponse = core.PostObjectStorageAccessKeyGenerateCredentialsResponse
 	var credsRes *credsResponse
-	credErr := retry.RetryContext(ctx, 5*time.Minute,
+	err := retry.RetryContext(ctx, timeout,
 		func() *retry.RetryError {
 			var callErr error
-			credsRes, callErr = r.M.Core.
+			credsRes, callErr = m.Core.
 				PostObjectStorageAccessKeyGenerateCredentialsWithResponse(
 					ctx,
 					core.PostObjectStorageAccessKeyGenerateCredentialsJSONRequestBody{
 						AccessKey: core.ObjectStorageAccessKeyLookup{
-							Id: keyID,
+							Id: &keyID,
 						},
 					},
 				)
@@ -292,49 +405,11 @@ func (r *ObjectStorageAccessKeyResource) Create(
 			)
 		},
 	)
-	if credErr != nil {
-		resp.Diagnostics.AddError(
-			"Object Storage Access Key Credentials Error",
-			credErr.Error(),
-		)
-
-		return
-	}
-
-	credentials := credsRes.JSON200.ObjectStorageAccessKey
-	plan.AccessKeyID = types.StringValue(credentials.S3AccessKeyId.MustGet())
-	plan.SecretAccessKey = types.StringValue(
-		credentials.S3SecretAccessKey.MustGet(),
-	)
-	plan.ServerURL = types.StringValue(credentials.ServerUrl.MustGet())
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
-}
-
-func validateObjectStorageAccessKeyCredentials(
-	key *core.ObjectStorageAccessKey,
-) error {
-	missing := make([]string, 0, 3)
-	if !key.S3AccessKeyId.IsSpecified() || key.S3AccessKeyId.IsNull() ||
-		strings.TrimSpace(key.S3AccessKeyId.MustGet()) == "" {
-		missing = append(missing, "s3_access_key_id")
-	}
-	if !key.S3SecretAccessKey.IsSpecified() || key.S3SecretAccessKey.IsNull() ||
-		strings.TrimSpace(key.S3SecretAccessKey.MustGet()) == "" {
-		missing = append(missing, "s3_secret_access_key")
-	}
-	if !key.ServerUrl.IsSpecified() || key.ServerUrl.IsNull() ||
-		strings.TrimSpace(key.ServerUrl.MustGet()) == "" {
-		missing = append(missing, "server_url")
-	}
-	if len(missing) > 0 {
-		return fmt.Errorf(
-			"unexpected credentials response: missing non-empty field(s): %s",
-			strings.Join(missing, ", "),
-		)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return &credsRes.JSON200.ObjectStorageAccessKey, nil
 }
 
 func (r *ObjectStorageAccessKeyResource) Read(
@@ -395,6 +470,7 @@ func (r *ObjectStorageAccessKeyResource) Read(
 	r.populateModel(&state, &res.JSON200.ObjectStorageAccessKey)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *ObjectStorageAccessKeyResource) Update(
@@ -472,6 +548,7 @@ func (r *ObjectStorageAccessKeyResource) Update(
 	plan.SecretAccessKey = state.SecretAccessKey
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *ObjectStorageAccessKeyResource) Delete(
@@ -486,20 +563,41 @@ func (r *ObjectStorageAccessKeyResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.DeleteObjectStorageAccessKeyWithResponse(
+	err := deleteObjectStorageAccessKey(ctx, r.M, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Object Storage Access Key Delete Error",
+			err.Error(),
+		)
+	}
+}
+
+// deleteObjectStorageAccessKey deletes an access key, treating a key that no
+// longer exists as already deleted.
+func deleteObjectStorageAccessKey(
+	ctx context.Context,
+	m *Meta,
+	keyID string,
+) error {
+	_, err := m.Core.DeleteObjectStorageAccessKeyWithResponse(
 		ctx,
 		core.DeleteObjectStorageAccessKeyJSONRequestBody{
-			AccessKey: core.ObjectStorageAccessKeyLookup{
-				Id: state.ID.ValueStringPointer(),
-			},
+			AccessKey: core.ObjectStorageAccessKeyLookup{Id: &keyID},
 		},
 	)
 	if err != nil && !errors.Is(err, core.ErrNotFound) {
-		resp.Diagnostics.AddError(
-			"Object Storage Access Key Delete Error",
-			err.Error(),
-		)
+		return err
 	}
+
+	return nil
+}
+
+func (r *ObjectStorageAccessKeyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the object storage access key.")
 }
 
 func (r *ObjectStorageAccessKeyResource) ImportState(
@@ -507,7 +605,88 @@ func (r *ObjectStorageAccessKeyResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// UpgradeState migrates version 0 state, which older releases wrote without
+// every attribute of the current layout.
+func (r *ObjectStorageAccessKeyResource) UpgradeState(
+	ctx context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Required: true},
+					objectStorageRegionAttributeName: schema.StringAttribute{
+						Required: true,
+					},
+					"all_buckets_read":  schema.BoolAttribute{Optional: true},
+					"all_objects_read":  schema.BoolAttribute{Optional: true},
+					"all_objects_write": schema.BoolAttribute{Optional: true},
+					"read_buckets": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"write_buckets": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"access_key_id": schema.StringAttribute{Computed: true},
+					"secret_access_key": schema.StringAttribute{
+						Computed:  true,
+						Sensitive: true,
+					},
+					"server_url": schema.StringAttribute{Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+					}),
+				},
+			},
+			StateUpgrader: upgradeObjectStorageAccessKeyStateV0,
+		},
+	}
+}
+
+func upgradeObjectStorageAccessKeyStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior objectStorageAccessKeyResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := ObjectStorageAccessKeyResourceModel{
+		ID:              prior.ID,
+		Name:            prior.Name,
+		Region:          prior.Region,
+		Organization:    types.StringNull(),
+		AllBucketsRead:  types.BoolValue(prior.AllBucketsRead.ValueBool()),
+		AllObjectsRead:  types.BoolValue(prior.AllObjectsRead.ValueBool()),
+		AllObjectsWrite: types.BoolValue(prior.AllObjectsWrite.ValueBool()),
+		ReadBuckets:     prior.ReadBuckets,
+		WriteBuckets:    prior.WriteBuckets,
+		AccessKeyID:     prior.AccessKeyID,
+		SecretAccessKey: prior.SecretAccessKey,
+		ServerURL:       prior.ServerURL,
+		Timeouts:        prior.Timeouts,
+	}
+	if state.ReadBuckets.IsNull() {
+		state.ReadBuckets = buildStringSet(nil)
+	}
+	if state.WriteBuckets.IsNull() {
+		state.WriteBuckets = buildStringSet(nil)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *ObjectStorageAccessKeyResource) populateModel(
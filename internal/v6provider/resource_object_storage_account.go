@@ -9,13 +9,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/krystal/go-katapult/next/core"
@@ -33,10 +37,33 @@ type (
 	}
 
 	ObjectStorageAccountResourceModel struct {
-		Region            types.String `tfsdk:"region"`
-		AdoptExisting     types.Bool   `tfsdk:"adopt_existing"`
-		ProvisioningState types.String `tfsdk:"provisioning_state"`
+		Region            types.String   `tfsdk:"region"`
+		Organization      types.String   `tfsdk:"organization"`
+		AdoptExisting     types.Bool     `tfsdk:"adopt_existing"`
+		ProvisioningState types.String   `tfsdk:"provisioning_state"`
+		Timeouts          timeouts.Value `tfsdk:"timeouts"`
 	}
+
+	// objectStorageAccountResourceModelV0 is the layout of version 0 state,
+	// which predates the organization argument.
+	objectStorageAccountResourceModelV0 struct {
+		Region            types.String   `tfsdk:"region"`
+		AdoptExisting     types.Bool     `tfsdk:"adopt_existing"`
+		ProvisioningState types.String   `tfsdk:"provisioning_state"`
+		Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	// ObjectStorageAccountIdentityModel is the identity of an object storage
+	// account, which is unique per organization and region.
+	ObjectStorageAccountIdentityModel struct {
+		Organization types.String `tfsdk:"organization"`
+		Region       types.String `tfsdk:"region"`
+	}
+)
+
+var (
+	_ resource.ResourceWithIdentity     = (*ObjectStorageAccountResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*ObjectStorageAccountResource)(nil)
 )
 
 var objectStorageAccountMarkdownDesc = strings.TrimSpace(`
@@ -73,11 +100,12 @@ func (r *ObjectStorageAccountResource) Configure(
 }
 
 func (r *ObjectStorageAccountResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: objectStorageAccountMarkdownDesc,
 		Attributes: map[string]schema.Attribute{
 			objectStorageRegionAttributeName: schema.StringAttribute{
@@ -92,6 +120,7 @@ func (r *ObjectStorageAccountResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
 			"adopt_existing": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -109,6 +138,12 @@ func (r *ObjectStorageAccountResource) Schema(
 					"account: `provisioning`, `provisioned`, or `failed`.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -124,10 +159,17 @@ func (r *ObjectStorageAccountResource) Create(
 		return
 	}
 
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	region := plan.Region.ValueString()
+	org := r.M.organization(plan.Organization)
 	adopt := plan.AdoptExisting.ValueBool()
 
-	existing, getErr := getObjectStorageAccount(ctx, r.M, region)
+	existing, getErr := getObjectStorageAccount(ctx, r.M, org, region)
 	switch {
 	case getErr == nil:
 		if !adopt {
@@ -147,17 +189,17 @@ func (r *ObjectStorageAccountResource) Create(
 						"region, including its buckets and access keys. "+
 						"Prefer import unless you are migrating an "+
 						"existing setup into Terraform.",
-					r.M.confOrganization, region,
+					org, region,
 					deref(existing.ProvisioningState),
 					"katapult_object_storage_account.<name>",
-					region,
+					objectStorageAccountImportID(org, region),
 				),
 			)
 			return
 		}
 		// Adopting — fall through to waiter, no Create call needed.
 	case errors.Is(getErr, core.ErrNotFound):
-		if err := createObjectStorageAccount(ctx, r.M, region); err != nil {
+		if err := createObjectStorageAccount(ctx, r.M, org, region); err != nil {
 			resp.Diagnostics.AddError(
 				"Object Storage Account Create Error",
 				err.Error(),
@@ -173,6 +215,7 @@ func (r *ObjectStorageAccountResource) Create(
 	}
 
 	plan.Region = types.StringValue(region)
+	plan.Organization = types.StringValue(org)
 	plan.AdoptExisting = types.BoolValue(adopt)
 	plan.ProvisioningState = types.StringNull()
 	if existing != nil && existing.ProvisioningState != nil {
@@ -185,11 +228,14 @@ func (r *ObjectStorageAccountResource) Create(
 	// account exists remotely. Terraform retains this state when a later
 	// provisioning diagnostic fails, allowing refresh or destroy to recover.
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setObjectStorageAccountIdentity(ctx, resp.Identity, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	acct, err := waitForObjectStorageAccountProvisioned(ctx, r.M, region)
+	acct, err := waitForObjectStorageAccountProvisioned(
+		ctx, r.M, org, region, timeout,
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Object Storage Account Provisioning Error",
@@ -203,6 +249,7 @@ func (r *ObjectStorageAccountResource) Create(
 	)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setObjectStorageAccountIdentity(ctx, resp.Identity, plan)...)
 }
 
 func (r *ObjectStorageAccountResource) Read(
@@ -218,8 +265,9 @@ func (r *ObjectStorageAccountResource) Read(
 	}
 
 	region := state.Region.ValueString()
+	org := r.M.organization(state.Organization)
 
-	acct, err := getObjectStorageAccount(ctx, r.M, region)
+	acct, err := getObjectStorageAccount(ctx, r.M, org, region)
 	if err != nil {
 		if errors.Is(err, core.ErrNotFound) {
 			privateTrashID, privateDiags := req.Private.GetKey(
@@ -244,6 +292,7 @@ func (r *ObjectStorageAccountResource) Read(
 				// The remote account is already deleted, but Terraform must
 				// retain state until Delete can resume the pending trash purge.
 				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+				resp.Diagnostics.Append(setObjectStorageAccountIdentity(ctx, resp.Identity, state)...)
 				return
 			}
 
@@ -271,6 +320,7 @@ func (r *ObjectStorageAccountResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setObjectStorageAccountIdentity(ctx, resp.Identity, state)...)
 }
 
 func (r *ObjectStorageAccountResource) Update(
@@ -291,6 +341,7 @@ func (r *ObjectStorageAccountResource) Update(
 	// Preserve computed values because they may be unknown in the update plan.
 	plan.ProvisioningState = state.ProvisioningState
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setObjectStorageAccountIdentity(ctx, resp.Identity, plan)...)
 }
 
 func (r *ObjectStorageAccountResource) Delete(
@@ -305,6 +356,12 @@ func (r *ObjectStorageAccountResource) Delete(
 		return
 	}
 
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	privateTrashID, privateDiags := req.Private.GetKey(
 		ctx, objectStorageAccountTrashIDPrivateKey,
 	)
@@ -326,7 +383,7 @@ func (r *ObjectStorageAccountResource) Delete(
 			return
 		}
 		if purgeErr := purgeTrashObject(
-			ctx, r.M, 5*time.Minute, core.TrashObject{Id: &trashID},
+			ctx, r.M, timeout, core.TrashObject{Id: &trashID},
 		); purgeErr != nil && !errors.Is(purgeErr, core.ErrNotFound) {
 			resp.Diagnostics.AddError(
 				"Failed to purge object storage account from trash.",
@@ -341,11 +398,12 @@ func (r *ObjectStorageAccountResource) Delete(
 	}
 
 	region := state.Region.ValueString()
+	org := r.M.organization(state.Organization)
 
 	// Preflight: refuse to delete the account if any buckets or access keys
 	// still exist in this region — managed by Terraform or not.
 	if preflightErr := preflightObjectStorageAccountDelete(
-		ctx, r.M, region,
+		ctx, r.M, org, region,
 	); preflightErr != nil {
 		resp.Diagnostics.AddError(
 			"Object Storage Account Delete Blocked",
@@ -362,7 +420,7 @@ func (r *ObjectStorageAccountResource) Delete(
 					Region: &region,
 				},
 				Organization: core.OrganizationLookup{
-					SubDomain: &r.M.confOrganization,
+					SubDomain: &org,
 				},
 			},
 		)
@@ -411,7 +469,7 @@ func (r *ObjectStorageAccountResource) Delete(
 	}
 
 	if err := purgeTrashObject(
-		ctx, r.M, 5*time.Minute, core.TrashObject{Id: &trashID},
+		ctx, r.M, timeout, core.TrashObject{Id: &trashID},
 	); err != nil {
 		resp.Diagnostics.AddError(
 			"Failed to purge object storage account from trash.",
@@ -425,30 +483,177 @@ func (r *ObjectStorageAccountResource) Delete(
 	)...)
 }
 
+// UpgradeState migrates version 0 state, which older releases wrote without
+// every attribute of the current layout.
+func (r *ObjectStorageAccountResource) UpgradeState(
+	ctx context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					objectStorageRegionAttributeName: schema.StringAttribute{
+						Required: true,
+					},
+					"adopt_existing":     schema.BoolAttribute{Optional: true},
+					"provisioning_state": schema.StringAttribute{Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+						Delete: true,
+					}),
+				},
+			},
+			StateUpgrader: upgradeObjectStorageAccountStateV0,
+		},
+	}
+}
+
+func upgradeObjectStorageAccountStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior objectStorageAccountResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := ObjectStorageAccountResourceModel{
+		Region:            prior.Region,
+		Organization:      types.StringNull(),
+		AdoptExisting:     types.BoolValue(prior.AdoptExisting.ValueBool()),
+		ProvisioningState: prior.ProvisioningState,
+		Timeouts:          prior.Timeouts,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ObjectStorageAccountResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			organizationAttributeName: identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description: "Sub-domain of the organization owning the " +
+					"account. Defaults to the provider's `organization`.",
+			},
+			objectStorageRegionAttributeName: identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The object storage region of the account.",
+			},
+		},
+	}
+}
+
 func (r *ObjectStorageAccountResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	region := strings.TrimSpace(req.ID)
-	if region == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Expected import ID to be an object storage region, e.g. uk-lon-1.",
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var identity ObjectStorageAccountIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = objectStorageAccountImportID(
+			identity.Organization.ValueString(), identity.Region.ValueString(),
 		)
+	}
+
+	org, region, err := parseObjectStorageAccountImportID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
+	// Record the organization the account was looked up in, so Read keeps
+	// using it even if the provider's organization changes later.
+	if org == "" && r.M != nil {
+		org = r.M.organization(types.StringNull())
+	}
+	orgValue := types.StringNull()
+	if org != "" {
+		orgValue = types.StringValue(org)
+	}
+
 	resp.Diagnostics.Append(
 		resp.State.SetAttribute(
 			ctx, path.Root(objectStorageRegionAttributeName), region,
 		)...,
 	)
+	resp.Diagnostics.Append(
+		resp.State.SetAttribute(
+			ctx, path.Root(organizationAttributeName), orgValue,
+		)...,
+	)
 	resp.Diagnostics.Append(
 		resp.State.SetAttribute(
 			ctx, path.Root("adopt_existing"), false,
 		)...,
 	)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(
+			ctx, ObjectStorageAccountIdentityModel{
+				Organization: orgValue,
+				Region:       types.StringValue(region),
+			},
+		)...)
+	}
+}
+
+// setObjectStorageAccountIdentity records the organization and region of model
+// as the identity of an object storage account.
+func setObjectStorageAccountIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	model ObjectStorageAccountResourceModel,
+) diag.Diagnostics {
+	if identity == nil || model.Region.IsNull() || model.Region.IsUnknown() {
+		return nil
+	}
+
+	return identity.Set(ctx, ObjectStorageAccountIdentityModel{
+		Organization: model.Organization,
+		Region:       model.Region,
+	})
+}
+
+// objectStorageAccountImportID returns the import ID of the account in region,
+// prefixed with org when it is set.
+func objectStorageAccountImportID(org, region string) string {
+	if org == "" {
+		return region
+	}
+
+	return org + "/" + region
+}
+
+// parseObjectStorageAccountImportID splits an import ID of the form
+// "region" or "organization/region". The organization is empty when the ID
+// does not include one.
+func parseObjectStorageAccountImportID(id string) (string, string, error) {
+	org, region, ok := strings.Cut(strings.TrimSpace(id), "/")
+	if !ok {
+		org, region = "", org
+	}
+
+	if (ok && org == "") || region == "" || strings.Contains(region, "/") {
+		return "", "", errors.New(
+			"expected import ID in the format: region or " +
+				"organization/region (e.g. uk-lon-1 or my-org/uk-lon-1)",
+		)
+	}
+
+	return org, region, nil
 }
 
 // ---------------------------------------------------------------------------
@@ -460,13 +665,14 @@ func (r *ObjectStorageAccountResource) ImportState(
 func getObjectStorageAccount(
 	ctx context.Context,
 	m *Meta,
+	org string,
 	region string,
 ) (*core.ObjectStorageAccount, error) {
 	res, err := m.Core.
 		GetOrganizationObjectStorageObjectStorageClusterWithResponse(
 			ctx,
 			&core.GetOrganizationObjectStorageObjectStorageClusterParams{
-				OrganizationSubDomain:      &m.confOrganization,
+				OrganizationSubDomain:      &org,
 				ObjectStorageClusterRegion: &region,
 			},
 		)
@@ -499,6 +705,7 @@ func getObjectStorageAccount(
 func createObjectStorageAccount(
 	ctx context.Context,
 	m *Meta,
+	org string,
 	region string,
 ) error {
 	res, err := m.Core.
@@ -509,7 +716,7 @@ func createObjectStorageAccount(
 					Region: &region,
 				},
 				Organization: core.OrganizationLookup{
-					SubDomain: &m.confOrganization,
+					SubDomain: &org,
 				},
 			},
 		)
@@ -540,7 +747,9 @@ func createObjectStorageAccount(
 func waitForObjectStorageAccountProvisioned(
 	ctx context.Context,
 	m *Meta,
+	org string,
 	region string,
+	timeout time.Duration,
 ) (*core.ObjectStorageAccount, error) {
 	settleWindow := 15 * time.Second
 	if replayPollInterval := m.stateChangePollInterval(); replayPollInterval > 0 {
@@ -563,7 +772,7 @@ func waitForObjectStorageAccountProvisioned(
 			string(core.ObjectStorageAccountProvisioningStateEnumProvisioned),
 		},
 		Refresh: func() (interface{}, string, error) {
-			acct, err := getObjectStorageAccount(ctx, m, region)
+			acct, err := getObjectStorageAccount(ctx, m, org, region)
 			if err != nil {
 				return nil, "", err
 			}
@@ -586,7 +795,7 @@ func waitForObjectStorageAccountProvisioned(
 
 			return acct, string(state), nil
 		},
-		Timeout:                   5 * time.Minute,
+		Timeout:                   timeout,
 		Delay:                     m.stateChangeDelay(2 * time.Second),
 		MinTimeout:                m.stateChangeDelay(5 * time.Second),
 		PollInterval:              m.stateChangePollInterval(),
@@ -616,9 +825,10 @@ func waitForObjectStorageAccountProvisioned(
 func preflightObjectStorageAccountDelete(
 	ctx context.Context,
 	m *Meta,
+	org string,
 	region string,
 ) error {
-	acct, err := getObjectStorageAccount(ctx, m, region)
+	acct, err := getObjectStorageAccount(ctx, m, org, region)
 	if err != nil {
 		if errors.Is(err, core.ErrNotFound) {
 			return nil
@@ -634,7 +844,7 @@ func preflightObjectStorageAccountDelete(
 	}
 	bucketCount := *acct.BucketCount
 
-	keyNames, err := listObjectStorageAccessKeyNamesInRegion(ctx, m, region)
+	keyNames, err := listObjectStorageAccessKeyNamesInRegion(ctx, m, org, region)
 	if err != nil {
 		return fmt.Errorf(
 			"failed to list access keys for preflight check: %w", err,
@@ -675,6 +885,7 @@ func preflightObjectStorageAccountDelete(
 func listObjectStorageAccessKeyNamesInRegion(
 	ctx context.Context,
 	m *Meta,
+	org string,
 	region string,
 ) ([]string, error) {
 	var (
@@ -688,7 +899,7 @@ func listObjectStorageAccessKeyNamesInRegion(
 			GetOrganizationObjectStorageAccessKeysWithResponse(
 				ctx,
 				&core.GetOrganizationObjectStorageAccessKeysParams{
-					OrganizationSubDomain: &m.confOrganization,
+					OrganizationSubDomain: &org,
 					Page:                  &page,
 					PerPage:               &perPage,
 				},
@@ -18,7 +18,7 @@ func TestObjectStorageAccountDeletePreflightBlocksForBuckets(t *testing.T) {
 	})
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.ErrorContains(t, err, `region "uk-lon-1"`)
@@ -35,7 +35,7 @@ func TestObjectStorageAccountDeletePreflightFiltersKeysByRegion(
 	})
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.ErrorContains(t, err, "Access keys: target (objkey_target)")
@@ -65,7 +65,7 @@ func TestObjectStorageAccountDeletePreflightRejectsMissingBucketCount(
 	))
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.ErrorContains(t, err, "missing bucket_count")
@@ -81,7 +81,7 @@ func TestObjectStorageAccountDeletePreflightRejectsAccessKeyWithoutRegion(
 	})
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.ErrorContains(t, err, "access key objkey_unscoped")
@@ -99,7 +99,7 @@ func TestObjectStorageAccountDeletePreflightPaginatesAccessKeys(
 	})
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.ErrorContains(t, err,
@@ -123,7 +123,7 @@ func TestObjectStorageAccountDeletePreflightAcceptsEmptyZeroPagePagination(
 	})
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.NoError(t, err)
@@ -220,7 +220,7 @@ func TestObjectStorageAccountDeletePreflightPaginatesLargeSetWithoutTotals(
 	))
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.NoError(t, err)
@@ -269,7 +269,7 @@ func TestObjectStorageAccountDeletePreflightRejectsIndeterminatePagination(
 			meta := newObjectStoragePreflightTestMeta(t, 0, map[int]string{1: page})
 
 			err := preflightObjectStorageAccountDelete(
-				context.Background(), meta, "uk-lon-1",
+				context.Background(), meta, meta.confOrganization, "uk-lon-1",
 			)
 
 			require.ErrorContains(t, err, "pagination")
@@ -302,7 +302,7 @@ func TestObjectStorageAccountDeletePreflightPropagatesListError(
 	))
 
 	err := preflightObjectStorageAccountDelete(
-		context.Background(), meta, "uk-lon-1",
+		context.Background(), meta, meta.confOrganization, "uk-lon-1",
 	)
 
 	require.ErrorContains(t, err,
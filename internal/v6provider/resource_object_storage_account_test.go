@@ -34,6 +34,7 @@ func TestObjectStorageAccountAdoptExistingUpdatesStateOnly(t *testing.T) {
 		Region:            types.StringValue(objectStorageAccTestRegion),
 		AdoptExisting:     types.BoolValue(true),
 		ProvisioningState: types.StringValue("provisioned"),
+		Timeouts:          nullResourceTimeouts("create", "delete"),
 	}
 	planModel := stateModel
 	planModel.AdoptExisting = types.BoolValue(false)
@@ -244,7 +245,7 @@ func preCreateObjectStorageAccountForTest(t *testing.T, tt *testTools) {
 	// If a previous test leaked an account, refuse to start rather than
 	// trample over it.
 	if _, err := getObjectStorageAccount(
-		tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+		tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 	); err == nil {
 		t.Fatalf(
 			"object storage account already exists in region %s before "+
@@ -256,10 +257,10 @@ func preCreateObjectStorageAccountForTest(t *testing.T, tt *testTools) {
 	}
 
 	require.NoError(t, createObjectStorageAccount(
-		tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+		tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 	))
 	_, err := waitForObjectStorageAccountProvisioned(
-		tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+		tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion, 5*time.Minute,
 	)
 	require.NoError(t, err)
 
@@ -268,7 +269,7 @@ func preCreateObjectStorageAccountForTest(t *testing.T, tt *testTools) {
 		// account (e.g. failed apply, or refuse-without-adopt test), nuke
 		// it here so the next test starts clean.
 		if _, err := getObjectStorageAccount(
-			tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+			tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 		); errors.Is(err, core.ErrNotFound) {
 			return
 		}
@@ -318,7 +319,7 @@ func testAccCheckKatapultObjectStorageAccountDestroy(
 ) resource.TestCheckFunc {
 	return func(_ *terraform.State) error {
 		_, err := getObjectStorageAccount(
-			tt.Ctx, tt.Meta, objectStorageAccTestRegion,
+			tt.Ctx, tt.Meta, tt.Meta.confOrganization, objectStorageAccTestRegion,
 		)
 		if errors.Is(err, core.ErrNotFound) {
 			return nil
@@ -440,6 +440,7 @@ func objectStorageAccessKeyBoundaryModel() ObjectStorageAccessKeyResourceModel {
 		AccessKeyID:     types.StringValue("s3-access-key"),
 		SecretAccessKey: types.StringValue("s3-secret-key"),
 		ServerURL:       types.StringValue("https://objects.example.test"),
+		Timeouts:        nullResourceTimeouts("create"),
 	}
 }
 
@@ -7,8 +7,10 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/krystal/go-katapult/next/core"
 
@@ -37,6 +40,7 @@ type (
 	ObjectStorageBucketResourceModel struct {
 		Name            types.String `tfsdk:"name"`
 		Region          types.String `tfsdk:"region"`
+		Organization    types.String `tfsdk:"organization"`
 		Label           types.String `tfsdk:"label"`
 		PublicURL       types.String `tfsdk:"public_url"`
 		ServeStaticSite types.Bool   `tfsdk:"serve_static_site"`
@@ -49,6 +53,36 @@ type (
 		ReadKeyIDs      types.Set    `tfsdk:"read_key_ids"`
 		WriteKeyIDs     types.Set    `tfsdk:"write_key_ids"`
 	}
+
+	// objectStorageBucketResourceModelV0 is the version 0 state layout,
+	// which predates the organization argument.
+	objectStorageBucketResourceModelV0 struct {
+		Name            types.String `tfsdk:"name"`
+		Region          types.String `tfsdk:"region"`
+		Label           types.String `tfsdk:"label"`
+		PublicURL       types.String `tfsdk:"public_url"`
+		ServeStaticSite types.Bool   `tfsdk:"serve_static_site"`
+		StaticSiteError types.String `tfsdk:"static_site_error"`
+		StaticSiteIndex types.String `tfsdk:"static_site_index"`
+		AllKeysRead     types.Bool   `tfsdk:"all_keys_read"`
+		AllKeysWrite    types.Bool   `tfsdk:"all_keys_write"`
+		PublicList      types.Bool   `tfsdk:"public_list"`
+		PublicRead      types.Bool   `tfsdk:"public_read"`
+		ReadKeyIDs      types.Set    `tfsdk:"read_key_ids"`
+		WriteKeyIDs     types.Set    `tfsdk:"write_key_ids"`
+	}
+
+	// ObjectStorageBucketIdentityModel is the identity of an object storage
+	// bucket: its globally unique name and the region it lives in.
+	ObjectStorageBucketIdentityModel struct {
+		Name   types.String `tfsdk:"name"`
+		Region types.String `tfsdk:"region"`
+	}
+)
+
+var (
+	_ resource.ResourceWithIdentity     = (*ObjectStorageBucketResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*ObjectStorageBucketResource)(nil)
 )
 
 func (r *ObjectStorageBucketResource) Metadata(
@@ -86,6 +120,7 @@ func (r *ObjectStorageBucketResource) Schema(
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: objectStorageBucketMarkdownDesc,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -109,6 +144,7 @@ func (r *ObjectStorageBucketResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
 			"label": schema.StringAttribute{
 				Optional:            true,
 				MarkdownDescription: "Optional bucket label in Katapult.",
@@ -272,6 +308,8 @@ func (r *ObjectStorageBucketResource) Create(
 	}
 
 	name := plan.Name.ValueString()
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
 
 	readKeyIDs := []string{}
 	writeKeyIDs := []string{}
@@ -296,7 +334,7 @@ func (r *ObjectStorageBucketResource) Create(
 			Region: plan.Region.ValueStringPointer(),
 		},
 		Organization: core.OrganizationLookup{
-			SubDomain: &r.M.confOrganization,
+			SubDomain: &org,
 		},
 		Properties: core.ObjectStorageBucketArguments{
 			Name:            &name,
@@ -318,14 +356,13 @@ func (r *ObjectStorageBucketResource) Create(
 	res, err := r.M.Core.
 		PostOrganizationObjectStorageObjectStorageClusterBucketsWithResponse(ctx, args)
 	if err != nil {
-		errMsg := err.Error()
 		if res != nil {
-			errMsg = fmt.Sprintf("%s: %s", errMsg, string(res.Body))
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
-		resp.Diagnostics.AddError(
-			"error creating object storage bucket",
-			errMsg,
+		addAPIError(
+			&resp.Diagnostics, "error creating object storage bucket", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
@@ -346,6 +383,7 @@ func (r *ObjectStorageBucketResource) Create(
 	plan.Name = types.StringValue(name)
 	plan.PublicURL = types.StringNull()
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setObjectStorageBucketIdentity(ctx, resp.Identity, plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -356,14 +394,15 @@ func (r *ObjectStorageBucketResource) Create(
 		plan.Region.ValueString(),
 		&plan,
 	); err != nil {
-		resp.Diagnostics.AddError(
-			"Object Storage Bucket Read Error",
-			err.Error(),
+		addAPIError(
+			&resp.Diagnostics, "Object Storage Bucket Read Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setObjectStorageBucketIdentity(ctx, resp.Identity, plan)...)
 }
 
 func (r *ObjectStorageBucketResource) Read(
@@ -388,14 +427,16 @@ func (r *ObjectStorageBucketResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Object Storage Bucket Read Error",
-			err.Error(),
+
+		addAPIError(
+			&resp.Diagnostics, "Object Storage Bucket Read Error", err,
+			resp.State.Schema.Type(),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setObjectStorageBucketIdentity(ctx, resp.Identity, state)...)
 }
 
 // /nolint:lll // a lot of generated types leading to long lines.
@@ -488,29 +529,35 @@ func (r *ObjectStorageBucketResource) Update(
 
 	res, err := r.M.Core.PatchObjectStorageObjectStorageClusterBucketWithResponse(ctx, args)
 	if err != nil {
-		errMsg := err.Error()
 		if res != nil {
-			errMsg = fmt.Sprintf("%s: %s", errMsg, string(res.Body))
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
-		resp.Diagnostics.AddError("Object Storage Bucket Update Error", errMsg)
+		addAPIError(
+			&resp.Diagnostics, "Object Storage Bucket Update Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 	if responseErr := validateObjectStorageBucketUpdateResponse(res); responseErr != nil {
-		resp.Diagnostics.AddError(
-			"Object Storage Bucket Update Error",
-			responseErr.Error(),
+		addAPIError(
+			&resp.Diagnostics, "Object Storage Bucket Update Error",
+			responseErr, resp.State.Schema.Type(),
 		)
 		return
 	}
 	plan.PublicURL = objectStorageStringOrState(plan.PublicURL, state.PublicURL)
 
 	if err := r.ObjectStorageBucketRead(ctx, plan.Name.ValueString(), plan.Region.ValueString(), &plan); err != nil {
-		resp.Diagnostics.AddError("Object Storage Bucket Read Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Object Storage Bucket Read Error", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setObjectStorageBucketIdentity(ctx, resp.Identity, plan)...)
 }
 
 func (r *ObjectStorageBucketResource) Delete(
@@ -524,7 +571,7 @@ func (r *ObjectStorageBucketResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.
+	res, err := r.M.Core.
 		DeleteObjectStorageObjectStorageClusterBucketWithResponse(ctx,
 			core.DeleteObjectStorageObjectStorageClusterBucketJSONRequestBody{
 				Bucket: core.ObjectStorageBucketLookup{
@@ -539,34 +586,183 @@ func (r *ObjectStorageBucketResource) Delete(
 			return
 		}
 
-		resp.Diagnostics.AddError(
-			"Object Storage Bucket Delete Error",
-			err.Error(),
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Object Storage Bucket Delete Error", err,
+			resp.State.Schema.Type(),
 		)
 	}
 }
 
+func (r *ObjectStorageBucketResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The name of the bucket.",
+			},
+			objectStorageRegionAttributeName: identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The object storage region of the bucket.",
+			},
+		},
+	}
+}
+
 func (r *ObjectStorageBucketResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	parts := strings.SplitN(req.ID, "/", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Expected import ID in the format: "+
-				"name/region (e.g. my-bucket/uk-lon-1)",
+	importID := req.ID
+	if importID == "" && req.Identity != nil {
+		var identity ObjectStorageBucketIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		importID = objectStorageBucketImportID(
+			identity.Name.ValueString(), identity.Region.ValueString(),
 		)
+	}
+
+	name, region, err := parseObjectStorageBucketImportID(importID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root("name"), parts[0],
+		ctx, path.Root("name"), name,
 	)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(
-		ctx, path.Root(objectStorageRegionAttributeName), parts[1],
+		ctx, path.Root(objectStorageRegionAttributeName), region,
 	)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(
+			ctx, ObjectStorageBucketIdentityModel{
+				Name:   types.StringValue(name),
+				Region: types.StringValue(region),
+			},
+		)...)
+	}
+}
+
+// setObjectStorageBucketIdentity records the name and region of model as the
+// identity of an object storage bucket.
+func setObjectStorageBucketIdentity(
+	ctx context.Context,
+	identity *tfsdk.ResourceIdentity,
+	model ObjectStorageBucketResourceModel,
+) diag.Diagnostics {
+	if identity == nil || model.Name.IsNull() || model.Name.IsUnknown() ||
+		model.Region.IsNull() || model.Region.IsUnknown() {
+		return nil
+	}
+
+	return identity.Set(ctx, ObjectStorageBucketIdentityModel{
+		Name:   model.Name,
+		Region: model.Region,
+	})
+}
+
+// UpgradeState migrates version 0 state, which older releases wrote without
+// every attribute of the current layout.
+func (r *ObjectStorageBucketResource) UpgradeState(
+	_ context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{Required: true},
+					objectStorageRegionAttributeName: schema.StringAttribute{
+						Required: true,
+					},
+					"label":             schema.StringAttribute{Optional: true},
+					"public_url":        schema.StringAttribute{Computed: true},
+					"serve_static_site": schema.BoolAttribute{Optional: true},
+					"static_site_error": schema.StringAttribute{Optional: true},
+					"static_site_index": schema.StringAttribute{Optional: true},
+					"all_keys_read":     schema.BoolAttribute{Optional: true},
+					"all_keys_write":    schema.BoolAttribute{Optional: true},
+					"public_list":       schema.BoolAttribute{Optional: true},
+					"public_read":       schema.BoolAttribute{Optional: true},
+					"read_key_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"write_key_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			StateUpgrader: upgradeObjectStorageBucketStateV0,
+		},
+	}
+}
+
+func upgradeObjectStorageBucketStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior objectStorageBucketResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fill attributes missing from older state with their schema defaults,
+	// so the upgrade alone does not plan an update.
+	state := ObjectStorageBucketResourceModel{
+		Name:            prior.Name,
+		Region:          prior.Region,
+		Organization:    types.StringNull(),
+		Label:           prior.Label,
+		PublicURL:       prior.PublicURL,
+		ServeStaticSite: types.BoolValue(prior.ServeStaticSite.ValueBool()),
+		StaticSiteError: types.StringValue(prior.StaticSiteError.ValueString()),
+		StaticSiteIndex: types.StringValue(prior.StaticSiteIndex.ValueString()),
+		AllKeysRead:     types.BoolValue(prior.AllKeysRead.ValueBool()),
+		AllKeysWrite:    types.BoolValue(prior.AllKeysWrite.ValueBool()),
+		PublicList:      types.BoolValue(prior.PublicList.ValueBool()),
+		PublicRead:      types.BoolValue(prior.PublicRead.ValueBool()),
+		ReadKeyIDs:      prior.ReadKeyIDs,
+		WriteKeyIDs:     prior.WriteKeyIDs,
+	}
+	if state.ReadKeyIDs.IsNull() {
+		state.ReadKeyIDs = buildStringSet(nil)
+	}
+	if state.WriteKeyIDs.IsNull() {
+		state.WriteKeyIDs = buildStringSet(nil)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func objectStorageBucketImportID(name, region string) string {
+	return name + "/" + region
+}
+
+func parseObjectStorageBucketImportID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.New(
+			"expected import ID in the format: " +
+				"name/region (e.g. my-bucket/uk-lon-1)",
+		)
+	}
+
+	return parts[0], parts[1], nil
 }
 
 func (r *ObjectStorageBucketResource) ObjectStorageBucketRead(
@@ -583,6 +779,10 @@ func (r *ObjectStorageBucketResource) ObjectStorageBucketRead(
 				BucketName:                 &name,
 			})
 	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
 		return err
 	}
 	if res == nil {
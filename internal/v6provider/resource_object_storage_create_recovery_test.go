@@ -10,6 +10,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -67,6 +69,7 @@ func TestObjectStorageAccessKeyCreateRetainsStateWhenCredentialsFail(
 		AccessKeyID:     types.StringUnknown(),
 		SecretAccessKey: types.StringUnknown(),
 		ServerURL:       types.StringUnknown(),
+		Timeouts:        nullResourceTimeouts("create"),
 	}
 	req, resp := objectStorageCreateOperation(t, r.Schema, plan)
 
@@ -219,6 +222,7 @@ func TestObjectStorageAccessKeyCreateRejectsIncompleteCredentials(
 				AccessKeyID:     types.StringUnknown(),
 				SecretAccessKey: types.StringUnknown(),
 				ServerURL:       types.StringUnknown(),
+				Timeouts:        nullResourceTimeouts("create"),
 			}
 			req, resp := objectStorageCreateOperation(t, r.Schema, plan)
 
@@ -287,6 +291,7 @@ func TestObjectStorageAccessKeyCreateAppliesOnlyCredentialFields(
 		AccessKeyID:     types.StringUnknown(),
 		SecretAccessKey: types.StringUnknown(),
 		ServerURL:       types.StringUnknown(),
+		Timeouts:        nullResourceTimeouts("create"),
 	}
 	req, resp := objectStorageCreateOperation(t, r.Schema, plan)
 
@@ -485,6 +490,7 @@ func TestObjectStorageAccountCreateRetainsStateWhenWaiterFails(
 		Region:            types.StringValue("uk-lon-1"),
 		AdoptExisting:     types.BoolValue(false),
 		ProvisioningState: types.StringUnknown(),
+		Timeouts:          nullResourceTimeouts("create", "delete"),
 	}
 	req, resp := objectStorageCreateOperation(t, r.Schema, plan)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -504,6 +510,71 @@ func TestObjectStorageAccountCreateRetainsStateWhenWaiterFails(
 	requireKnownNullString(t, state.ProvisioningState)
 }
 
+func TestObjectStorageAccountCreateHonoursCreateTimeout(t *testing.T) {
+	var getCalls atomic.Int32
+	meta := newObjectStorageFailureTestMeta(t, http.HandlerFunc(
+		func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			switch req.Method {
+			case http.MethodGet:
+				if getCalls.Add(1) == 1 {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{
+						"error": {
+							"code": "object_storage_account_not_found",
+							"description": "not found",
+							"detail": {}
+						}
+					}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{
+					"object_storage_account": {
+						"region": "uk-lon-1",
+						"provisioning_state": "provisioning"
+					}
+				}`))
+			case http.MethodPost:
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(`{
+					"object_storage_account": {
+						"region": "uk-lon-1",
+						"provisioning_state": "provisioning"
+					}
+				}`))
+			default:
+				http.Error(w, "unexpected method", http.StatusInternalServerError)
+			}
+		},
+	))
+
+	r := &ObjectStorageAccountResource{M: meta}
+	plan := ObjectStorageAccountResourceModel{
+		Region:            types.StringValue("uk-lon-1"),
+		AdoptExisting:     types.BoolValue(false),
+		ProvisioningState: types.StringUnknown(),
+		Timeouts: timeouts.Value{Object: types.ObjectValueMust(
+			map[string]attr.Type{
+				"create": types.StringType,
+				"delete": types.StringType,
+			},
+			map[string]attr.Value{
+				"create": types.StringValue("100ms"),
+				"delete": types.StringNull(),
+			},
+		)},
+	}
+	req, resp := objectStorageCreateOperation(t, r.Schema, plan)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	r.Create(ctx, req, &resp)
+
+	require.Less(t, time.Since(start), 5*time.Second)
+	requireDiagnosticContains(t, resp.Diagnostics, "timeout while waiting")
+}
+
 func TestObjectStorageAccountProvisioningWaiterReplayTiming(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -547,7 +618,7 @@ func TestObjectStorageAccountProvisioningWaiterReplayTiming(t *testing.T) {
 			defer cancel()
 
 			account, err := waitForObjectStorageAccountProvisioned(
-				ctx, meta, "uk-lon-1",
+				ctx, meta, meta.confOrganization, "uk-lon-1", 5*time.Minute,
 			)
 
 			if tt.wantErr != "" {
@@ -620,6 +691,7 @@ func TestObjectStorageAccountDeleteResumesTrashPurgeFromPrivateState(
 		Region:            types.StringValue("uk-lon-1"),
 		AdoptExisting:     types.BoolValue(false),
 		ProvisioningState: types.StringValue("provisioned"),
+		Timeouts:          nullResourceTimeouts("create", "delete"),
 	}
 	state := tfsdk.State{Schema: schemaResp.Schema}
 	require.False(t, state.Set(ctx, &stateModel).HasError())
@@ -870,11 +942,10 @@ func newObjectStorageFailureTestMeta(
 	t.Helper()
 	server := httptest.NewServer(handler)
 	t.Cleanup(server.Close)
-	t.Setenv("KATAPULT_TF_DEBUG_API_URL", server.URL)
-
 	meta, err := NewMeta(
 		"test-api-key", "test-data-center", "test-organization", nil,
-		"error", "test", server.Client(), "test", "test",
+		"error", "test", server.Client(),
+		ConnectionConfig{APIURL: server.URL}, "test", "test",
 	)
 	require.NoError(t, err)
 	meta.retryClient.RetryMax = 0
@@ -922,11 +993,23 @@ func objectStorageAccountTestState(
 		Region:            types.StringValue("uk-lon-1"),
 		AdoptExisting:     types.BoolValue(false),
 		ProvisioningState: types.StringValue("provisioned"),
+		Timeouts:          nullResourceTimeouts("create", "delete"),
 	}).HasError())
 
 	return state
 }
 
+// nullResourceTimeouts returns a null timeouts block with the given
+// operations, for models built directly in tests.
+func nullResourceTimeouts(operations ...string) timeouts.Value {
+	attrTypes := make(map[string]attr.Type, len(operations))
+	for _, operation := range operations {
+		attrTypes[operation] = types.StringType
+	}
+
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
+
 func requireDiagnosticContains(
 	t *testing.T,
 	diagnostics diag.Diagnostics,
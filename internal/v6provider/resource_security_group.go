@@ -0,0 +1,1086 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ resource.ResourceWithIdentity = (*SecurityGroupResource)(nil)
+
+type (
+	SecurityGroupResource struct {
+		M *Meta
+	}
+
+	SecurityGroupResourceModel struct {
+		ID               types.String   `tfsdk:"id"`
+		Name             types.String   `tfsdk:"name"`
+		Associations     types.Set      `tfsdk:"associations"`
+		AllowAllInbound  types.Bool     `tfsdk:"allow_all_inbound"`
+		AllowAllOutbound types.Bool     `tfsdk:"allow_all_outbound"`
+		ExternalRules    types.Bool     `tfsdk:"external_rules"`
+		InboundRules     types.List     `tfsdk:"inbound_rule"`
+		OutboundRules    types.List     `tfsdk:"outbound_rule"`
+		Organization     types.String   `tfsdk:"organization"`
+		Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	// securityGroupResourceModelV0 is the state layout written by the
+	// SDKv2 implementation of the resource.
+	securityGroupResourceModelV0 struct {
+		ID               types.String `tfsdk:"id"`
+		Name             types.String `tfsdk:"name"`
+		Associations     types.Set    `tfsdk:"associations"`
+		AllowAllInbound  types.Bool   `tfsdk:"allow_all_inbound"`
+		AllowAllOutbound types.Bool   `tfsdk:"allow_all_outbound"`
+		ExternalRules    types.Bool   `tfsdk:"external_rules"`
+		InboundRules     types.List   `tfsdk:"inbound_rule"`
+		OutboundRules    types.List   `tfsdk:"outbound_rule"`
+	}
+
+	SecurityGroupInlineRuleModel struct {
+		ID        types.String `tfsdk:"id"`
+		Direction types.String `tfsdk:"direction"`
+		Protocol  types.String `tfsdk:"protocol"`
+		Ports     types.String `tfsdk:"ports"`
+		Targets   types.Set    `tfsdk:"targets"`
+		Notes     types.String `tfsdk:"notes"`
+	}
+)
+
+const securityGroupRulesPageSize = 100
+
+func SecurityGroupInlineRuleType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"id":        types.StringType,
+			"direction": types.StringType,
+			"protocol":  types.StringType,
+			"ports":     types.StringType,
+			"targets":   types.SetType{ElemType: types.StringType},
+			"notes":     types.StringType,
+		},
+	}
+}
+
+func (r *SecurityGroupResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_security_group"
+}
+
+func (r *SecurityGroupResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	r.M = meta
+}
+
+func securityGroupInlineRuleBlock(direction string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: fmt.Sprintf(
+			"Zero or more %[1]s rules to apply to the security group. "+
+				"Each rule specifies %[1]s traffic which should be allowed.",
+			direction,
+		),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: securityGroupRuleIDDescription,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"direction": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: securityGroupRuleDirectionDescription,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"protocol": securityGroupRuleProtocolAttribute(),
+				"ports":    securityGroupRulePortsAttribute(),
+				"targets":  securityGroupRuleTargetsAttribute(),
+				"notes":    securityGroupRuleNotesAttribute(),
+			},
+		},
+	}
+}
+
+//nolint:funlen
+func (r *SecurityGroupResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		MarkdownDescription: "Allows management of security groups, their " +
+			"rules, and their associations. By default all traffic is " +
+			"blocked, both inbound and outbound. To allow traffic you must " +
+			"explicitly add rules to the security group. You can also allow " +
+			"all traffic by setting the `allow_all_inbound` and " +
+			"`allow_all_outbound` attributes to `true`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The ID of the security group. This " +
+					"is automatically generated by the API.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the security group.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"associations": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The resource IDs to apply this " +
+					"security group to. Accepts IDs of virtual machines, " +
+					"virtual machine groups, and tags.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthAtLeast(1),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					NullToEmptySetPlanModifier(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_all_inbound": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "Whether or not to allow all inbound " +
+					"traffic. If not explicitly set, it defaults to false, " +
+					"blocking all inbound traffic not covered by a inbound " +
+					"rule. If changed to true on a existing security group, " +
+					"all existing inbound rules will be deleted, hence it " +
+					"cannot be enabled while any inbound rules are defined.",
+			},
+			"allow_all_outbound": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "Whether or not to allow all outbound " +
+					"traffic. If not explicitly set, it defaults to false, " +
+					"blocking all outbound traffic not covered by a outbound " +
+					"rule. If changed to true on a existing security group, " +
+					"all existing outbound rules will be deleted, hence it " +
+					"cannot be enabled while any outbound rules are defined.",
+			},
+			"external_rules": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				MarkdownDescription: "When enabled, The full list of rules " +
+					"are not managed by Terraform. Induvidual rules can " +
+					"still be managed with the `katapult_security_group_rule` " +
+					"resource. This is required to prevent Terraform from " +
+					"deleting rules managed outside of Terraform. Defaults " +
+					"to `false`.",
+			},
+			organizationAttributeName: organizationResourceAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"inbound_rule":  securityGroupInlineRuleBlock(string(core.Inbound)),
+			"outbound_rule": securityGroupInlineRuleBlock(string(core.Outbound)),
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SecurityGroupResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config SecurityGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasInbound := len(config.InboundRules.Elements()) > 0
+	hasOutbound := len(config.OutboundRules.Elements()) > 0
+
+	if config.ExternalRules.ValueBool() && hasInbound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("inbound_rule"),
+			"Invalid Attribute Combination",
+			"inbound_rule cannot be specified when external_rules is enabled",
+		)
+	}
+
+	if config.ExternalRules.ValueBool() && hasOutbound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("outbound_rule"),
+			"Invalid Attribute Combination",
+			"outbound_rule cannot be specified when external_rules is enabled",
+		)
+	}
+
+	if config.AllowAllInbound.ValueBool() && hasInbound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("inbound_rule"),
+			"Invalid Attribute Combination",
+			"cannot enable allow_all_inbound while also specifying one "+
+				"or more inbound_rule",
+		)
+	}
+
+	if config.AllowAllOutbound.ValueBool() && hasOutbound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("outbound_rule"),
+			"Invalid Attribute Combination",
+			"cannot enable allow_all_outbound while also specifying one "+
+				"or more outbound_rule",
+		)
+	}
+}
+
+func (r *SecurityGroupResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SecurityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	associations := []string{}
+	if !plan.Associations.IsUnknown() {
+		resp.Diagnostics.Append(
+			plan.Associations.ElementsAs(ctx, &associations, false)...,
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
+	res, err := r.M.Core.PostOrganizationSecurityGroupsWithResponse(ctx,
+		core.PostOrganizationSecurityGroupsJSONRequestBody{
+			Organization: core.OrganizationLookup{
+				SubDomain: &org,
+			},
+			Properties: core.SecurityGroupArguments{
+				Name:             plan.Name.ValueStringPointer(),
+				Associations:     &associations,
+				AllowAllInbound:  plan.AllowAllInbound.ValueBoolPointer(),
+				AllowAllOutbound: plan.AllowAllOutbound.ValueBoolPointer(),
+			},
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	plan.ID = types.StringPointerValue(res.JSON200.SecurityGroup.Id)
+
+	if !plan.ExternalRules.ValueBool() {
+		err = r.applyRules(ctx, &plan, &SecurityGroupResourceModel{})
+		if err != nil {
+			// The security group exists, so record it in state to have it
+			// tainted rather than orphaned.
+			if readErr := r.SecurityGroupRead(ctx, &plan, true); readErr == nil {
+				resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+				resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+			}
+
+			addAPIError(
+				&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
+	}
+
+	if err := r.SecurityGroupRead(ctx, &plan, false); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *SecurityGroupResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SecurityGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SecurityGroupRead(ctx, &state, true)
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			r.M.Logger.Info(
+				"Security Group not found, removing from state",
+				"id", state.ID.ValueString(),
+			)
+
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *SecurityGroupResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan SecurityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SecurityGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Update(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	plan.ID = state.ID
+	args := core.SecurityGroupArguments{}
+	changed := false
+
+	if !plan.Name.Equal(state.Name) {
+		args.Name = plan.Name.ValueStringPointer()
+		changed = true
+	}
+
+	if !plan.Associations.Equal(state.Associations) {
+		associations := []string{}
+		resp.Diagnostics.Append(
+			plan.Associations.ElementsAs(ctx, &associations, false)...,
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		args.Associations = &associations
+		changed = true
+	}
+
+	if !plan.AllowAllInbound.Equal(state.AllowAllInbound) {
+		args.AllowAllInbound = plan.AllowAllInbound.ValueBoolPointer()
+		changed = true
+	}
+
+	if !plan.AllowAllOutbound.Equal(state.AllowAllOutbound) {
+		args.AllowAllOutbound = plan.AllowAllOutbound.ValueBoolPointer()
+		changed = true
+	}
+
+	if changed {
+		res, err := r.M.Core.PatchSecurityGroupWithResponse(ctx,
+			core.PatchSecurityGroupJSONRequestBody{
+				SecurityGroup: core.SecurityGroupLookup{
+					Id: state.ID.ValueStringPointer(),
+				},
+				Properties: args,
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
+	}
+
+	// Handle rules if external_rules is disabled, or if it was changed to
+	// enabled now, in which case we need to remove all rules.
+	externalRulesChanged := !plan.ExternalRules.Equal(state.ExternalRules)
+	if !plan.ExternalRules.ValueBool() || externalRulesChanged {
+		if err := r.applyRules(ctx, &plan, &state); err != nil {
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
+	}
+
+	// Warn the user if external_rules was disabled. Rules which were
+	// previously managed outside of Terraform are not adopted into state
+	// until the next refresh.
+	if externalRulesChanged && !plan.ExternalRules.ValueBool() {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf(
+				"security group %q (%s): external_rules option has been "+
+					"disabled.",
+				plan.Name.ValueString(), plan.ID.ValueString(),
+			),
+			"Please run \"terraform plan\" or \"terraform apply\" again "+
+				"to ensure all rules are in sync with Terraform definitions.",
+		)
+	}
+
+	if err := r.SecurityGroupRead(ctx, &plan, false); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *SecurityGroupResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state SecurityGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := state.Timeouts.Delete(ctx, 5*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Security groups are not moved to the trash when deleted, and their
+	// rules are deleted along with them.
+	res, err := r.M.Core.DeleteSecurityGroupWithResponse(ctx,
+		core.DeleteSecurityGroupJSONRequestBody{
+			SecurityGroup: core.SecurityGroupLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+		})
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return
+		}
+
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+}
+
+func (r *SecurityGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the security group.")
+}
+
+func (r *SecurityGroupResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// UpgradeState migrates state written by the SDKv2 implementation of this
+// resource to the current layout.
+func (r *SecurityGroupResource) UpgradeState(
+	_ context.Context,
+) map[int64]resource.StateUpgrader {
+	ruleBlock := schema.ListNestedBlock{
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"id":        schema.StringAttribute{Computed: true},
+				"direction": schema.StringAttribute{Computed: true},
+				"protocol":  schema.StringAttribute{Required: true},
+				"ports":     schema.StringAttribute{Optional: true},
+				"targets": schema.SetAttribute{
+					Required:    true,
+					ElementType: types.StringType,
+				},
+				"notes": schema.StringAttribute{Optional: true},
+			},
+		},
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Computed: true},
+					"name": schema.StringAttribute{Required: true},
+					"associations": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"allow_all_inbound":  schema.BoolAttribute{Optional: true},
+					"allow_all_outbound": schema.BoolAttribute{Optional: true},
+					"external_rules":     schema.BoolAttribute{Optional: true},
+				},
+				Blocks: map[string]schema.Block{
+					"inbound_rule":  ruleBlock,
+					"outbound_rule": ruleBlock,
+				},
+			},
+			StateUpgrader: upgradeSecurityGroupStateV0,
+		},
+	}
+}
+
+func upgradeSecurityGroupStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior securityGroupResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := SecurityGroupResourceModel{
+		ID:               prior.ID,
+		Name:             prior.Name,
+		Associations:     prior.Associations,
+		AllowAllInbound:  types.BoolValue(prior.AllowAllInbound.ValueBool()),
+		AllowAllOutbound: types.BoolValue(prior.AllowAllOutbound.ValueBool()),
+		ExternalRules:    types.BoolValue(prior.ExternalRules.ValueBool()),
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+
+	if upgraded.Associations.IsNull() {
+		upgraded.Associations = types.SetValueMust(
+			types.StringType, []attr.Value{},
+		)
+	}
+
+	var diags diag.Diagnostics
+	upgraded.InboundRules, diags = upgradeSecurityGroupRulesV0(
+		ctx, prior.InboundRules,
+	)
+	resp.Diagnostics.Append(diags...)
+
+	upgraded.OutboundRules, diags = upgradeSecurityGroupRulesV0(
+		ctx, prior.OutboundRules,
+	)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
+
+func upgradeSecurityGroupRulesV0(
+	ctx context.Context,
+	prior types.List,
+) (types.List, diag.Diagnostics) {
+	rules := []SecurityGroupInlineRuleModel{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags := prior.ElementsAs(ctx, &rules, false)
+		if diags.HasError() {
+			return prior, diags
+		}
+	}
+
+	for i := range rules {
+		rules[i].Protocol = upgradeSecurityGroupRuleProtocol(rules[i].Protocol)
+		rules[i].Ports = upgradeSecurityGroupRuleString(rules[i].Ports)
+		rules[i].Notes = upgradeSecurityGroupRuleString(rules[i].Notes)
+	}
+
+	return types.ListValueFrom(ctx, SecurityGroupInlineRuleType(), rules)
+}
+
+// SecurityGroupRead populates the model from the API. The rules in the model
+// are used to keep the order and formatting of known rules. When adopt is
+// true, rules unknown to the model are appended, otherwise they are left out
+// to keep the result of an apply consistent with its plan.
+func (r *SecurityGroupResource) SecurityGroupRead(
+	ctx context.Context,
+	model *SecurityGroupResourceModel,
+	adopt bool,
+) error {
+	res, err := r.M.Core.GetSecurityGroupWithResponse(ctx,
+		&core.GetSecurityGroupParams{
+			SecurityGroupId: model.ID.ValueStringPointer(),
+		})
+	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return err
+	}
+
+	sg := res.JSON200.SecurityGroup
+
+	model.ID = types.StringPointerValue(sg.Id)
+	model.Name = types.StringPointerValue(sg.Name)
+	model.AllowAllInbound = types.BoolValue(
+		sg.AllowAllInbound != nil && *sg.AllowAllInbound,
+	)
+	model.AllowAllOutbound = types.BoolValue(
+		sg.AllowAllOutbound != nil && *sg.AllowAllOutbound,
+	)
+	model.Associations = securityGroupStringSet(sg.Associations)
+
+	if model.ExternalRules.IsNull() || model.ExternalRules.IsUnknown() {
+		model.ExternalRules = types.BoolValue(false)
+	}
+
+	priorInbound, diags := securityGroupInlineRules(ctx, model.InboundRules)
+	if diags.HasError() {
+		return diagsToError(diags)
+	}
+
+	priorOutbound, diags := securityGroupInlineRules(ctx, model.OutboundRules)
+	if diags.HasError() {
+		return diagsToError(diags)
+	}
+
+	var inbound, outbound []SecurityGroupInlineRuleModel
+
+	// Skip rule handling if external_rules is enabled.
+	if !model.ExternalRules.ValueBool() {
+		rules, err := fetchAllSecurityGroupRules(ctx, r.M, model.ID.ValueString())
+		if err != nil {
+			return err
+		}
+
+		var apiInbound, apiOutbound []securityGroupRuleAPIModel
+		for _, rule := range rules {
+			if rule.Direction == nil {
+				continue
+			}
+
+			switch *rule.Direction {
+			case core.Inbound:
+				apiInbound = append(apiInbound, rule)
+			case core.Outbound:
+				apiOutbound = append(apiOutbound, rule)
+			}
+		}
+
+		inbound = mergeSecurityGroupRules(priorInbound, apiInbound, adopt)
+		outbound = mergeSecurityGroupRules(priorOutbound, apiOutbound, adopt)
+	}
+
+	model.InboundRules, diags = securityGroupInlineRuleList(ctx, inbound)
+	if diags.HasError() {
+		return diagsToError(diags)
+	}
+
+	model.OutboundRules, diags = securityGroupInlineRuleList(ctx, outbound)
+	if diags.HasError() {
+		return diagsToError(diags)
+	}
+
+	return nil
+}
+
+// applyRules creates, updates, and deletes the rules of the security group to
+// go from the inline rules in state to the inline rules in plan. IDs of created
+// rules are set on the plan as they are created, so a partial failure still
+// leaves the plan describing what exists.
+func (r *SecurityGroupResource) applyRules(
+	ctx context.Context,
+	plan *SecurityGroupResourceModel,
+	state *SecurityGroupResourceModel,
+) error {
+	for _, direction := range []core.SecurityGroupRuleDirectionEnum{
+		core.Inbound, core.Outbound,
+	} {
+		planList, stateList := &plan.InboundRules, state.InboundRules
+		if direction == core.Outbound {
+			planList, stateList = &plan.OutboundRules, state.OutboundRules
+		}
+
+		newRules := []SecurityGroupInlineRuleModel{}
+		if !plan.ExternalRules.ValueBool() {
+			var diags diag.Diagnostics
+			newRules, diags = securityGroupInlineRules(ctx, *planList)
+			if diags.HasError() {
+				return diagsToError(diags)
+			}
+		}
+
+		oldRules, diags := securityGroupInlineRules(ctx, stateList)
+		if diags.HasError() {
+			return diagsToError(diags)
+		}
+
+		err := r.applyDirectionRules(
+			ctx, plan.ID.ValueString(), direction, oldRules, newRules,
+		)
+
+		list, diags := securityGroupInlineRuleList(ctx, newRules)
+		if diags.HasError() {
+			return diagsToError(diags)
+		}
+		*planList = list
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SecurityGroupResource) applyDirectionRules(
+	ctx context.Context,
+	sgID string,
+	direction core.SecurityGroupRuleDirectionEnum,
+	oldRules []SecurityGroupInlineRuleModel,
+	newRules []SecurityGroupInlineRuleModel,
+) error {
+	create, update, del := diffSecurityGroupInlineRules(oldRules, newRules)
+
+	for _, i := range create {
+		rule := &newRules[i]
+
+		args, diags := buildSecurityGroupRuleArgs(
+			ctx, rule.Protocol, rule.Ports, rule.Targets, rule.Notes,
+		)
+		if diags.HasError() {
+			return diagsToError(diags)
+		}
+		args.Direction = &direction
+
+		res, err := r.M.Core.PostSecurityGroupRulesWithResponse(ctx,
+			core.PostSecurityGroupRulesJSONRequestBody{
+				SecurityGroup: core.SecurityGroupLookup{Id: &sgID},
+				Properties:    args,
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return err
+		}
+
+		rule.ID = types.StringPointerValue(res.JSON200.SecurityGroupRule.Id)
+		rule.Direction = types.StringValue(string(direction))
+	}
+
+	for _, i := range update {
+		rule := newRules[i]
+
+		args, diags := buildSecurityGroupRuleArgs(
+			ctx, rule.Protocol, rule.Ports, rule.Targets, rule.Notes,
+		)
+		if diags.HasError() {
+			return diagsToError(diags)
+		}
+
+		res, err := r.M.Core.PatchSecurityGroupsRulesSecurityGroupRuleWithResponse(
+			ctx,
+			core.PatchSecurityGroupsRulesSecurityGroupRuleJSONRequestBody{
+				SecurityGroupRule: core.SecurityGroupRuleLookup{
+					Id: rule.ID.ValueStringPointer(),
+				},
+				Properties: args,
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return err
+		}
+	}
+
+	for _, id := range del {
+		if err := deleteSecurityGroupRule(ctx, r.M, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffSecurityGroupInlineRules returns the indexes of rules in newRules which
+// need to be created and updated, and the IDs of old rules to delete.
+func diffSecurityGroupInlineRules(
+	oldRules []SecurityGroupInlineRuleModel,
+	newRules []SecurityGroupInlineRuleModel,
+) (create, update []int, del []string) {
+	existing := map[string]SecurityGroupInlineRuleModel{}
+	var order []string
+	for _, rule := range oldRules {
+		if id := rule.ID.ValueString(); id != "" {
+			existing[id] = rule
+			order = append(order, id)
+		}
+	}
+
+	for i, rule := range newRules {
+		id := rule.ID.ValueString()
+		if rule.ID.IsUnknown() || id == "" {
+			create = append(create, i)
+			continue
+		}
+
+		oldRule, ok := existing[id]
+		delete(existing, id)
+
+		if !ok || securityGroupInlineRuleChanged(oldRule, rule) {
+			update = append(update, i)
+		}
+	}
+
+	for _, id := range order {
+		if _, ok := existing[id]; ok {
+			del = append(del, id)
+		}
+	}
+
+	return create, update, del
+}
+
+func securityGroupInlineRuleChanged(a, b SecurityGroupInlineRuleModel) bool {
+	return !strings.EqualFold(a.Protocol.ValueString(), b.Protocol.ValueString()) ||
+		a.Ports.ValueString() != b.Ports.ValueString() ||
+		a.Notes.ValueString() != b.Notes.ValueString() ||
+		!a.Targets.Equal(b.Targets)
+}
+
+// mergeSecurityGroupRules converts rules returned by the API to inline rule
+// models, ordered to match the prior rules.
+func mergeSecurityGroupRules(
+	prior []SecurityGroupInlineRuleModel,
+	rules []securityGroupRuleAPIModel,
+	adopt bool,
+) []SecurityGroupInlineRuleModel {
+	byID := map[string]securityGroupRuleAPIModel{}
+	for _, rule := range rules {
+		if rule.ID != nil {
+			byID[*rule.ID] = rule
+		}
+	}
+
+	out := []SecurityGroupInlineRuleModel{}
+	seen := map[string]bool{}
+
+	for _, p := range prior {
+		id := p.ID.ValueString()
+		rule, ok := byID[id]
+		if !ok || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		out = append(out, flattenSecurityGroupInlineRule(p, rule))
+	}
+
+	if !adopt {
+		return out
+	}
+
+	for _, rule := range rules {
+		if rule.ID == nil || seen[*rule.ID] {
+			continue
+		}
+
+		out = append(out, flattenSecurityGroupInlineRule(
+			SecurityGroupInlineRuleModel{}, rule,
+		))
+	}
+
+	return out
+}
+
+func flattenSecurityGroupInlineRule(
+	prior SecurityGroupInlineRuleModel,
+	rule securityGroupRuleAPIModel,
+) SecurityGroupInlineRuleModel {
+	return SecurityGroupInlineRuleModel{
+		ID:        types.StringPointerValue(rule.ID),
+		Direction: types.StringPointerValue((*string)(rule.Direction)),
+		Protocol:  preserveStringCase(prior.Protocol, (*string)(rule.Protocol)),
+		Ports:     preserveEmptyString(prior.Ports, rule.Ports),
+		Targets:   securityGroupStringSet(rule.Targets),
+		Notes:     preserveEmptyString(prior.Notes, rule.Notes),
+	}
+}
+
+func fetchAllSecurityGroupRules(
+	ctx context.Context,
+	m *Meta,
+	sgID string,
+) ([]securityGroupRuleAPIModel, error) {
+	var all []securityGroupRuleAPIModel
+	for page := 1; ; page++ {
+		res, err := m.Core.GetSecurityGroupRulesWithResponse(ctx,
+			&core.GetSecurityGroupRulesParams{
+				SecurityGroupId: &sgID,
+				Page:            ptr(page),
+				PerPage:         ptr(securityGroupRulesPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return nil, err
+		}
+
+		body := res.JSON200
+		for _, rule := range body.SecurityGroupRules {
+			all = append(all, securityGroupRuleAPIModel{
+				ID:        rule.Id,
+				Direction: rule.Direction,
+				Protocol:  rule.Protocol,
+				Ports:     nullableStringValue(rule.Ports),
+				Targets:   rule.Targets,
+				Notes:     nullableStringValue(rule.Notes),
+			})
+		}
+
+		if !paginationHasNext(
+			body.Pagination, page, len(body.SecurityGroupRules),
+			securityGroupRulesPageSize,
+		) {
+			break
+		}
+	}
+
+	return all, nil
+}
+
+func securityGroupInlineRules(
+	ctx context.Context,
+	list types.List,
+) ([]SecurityGroupInlineRuleModel, diag.Diagnostics) {
+	rules := []SecurityGroupInlineRuleModel{}
+	if list.IsNull() || list.IsUnknown() {
+		return rules, nil
+	}
+
+	diags := list.ElementsAs(ctx, &rules, false)
+
+	return rules, diags
+}
+
+func securityGroupInlineRuleList(
+	ctx context.Context,
+	rules []SecurityGroupInlineRuleModel,
+) (types.List, diag.Diagnostics) {
+	if rules == nil {
+		rules = []SecurityGroupInlineRuleModel{}
+	}
+
+	return types.ListValueFrom(ctx, SecurityGroupInlineRuleType(), rules)
+}
+
+func diagsToError(diags diag.Diagnostics) error {
+	var errs []error
+	for _, d := range diags.Errors() {
+		errs = append(errs, fmt.Errorf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.Join(errs...)
+}
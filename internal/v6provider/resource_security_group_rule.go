@@ -0,0 +1,581 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ resource.ResourceWithIdentity = (*SecurityGroupRuleResource)(nil)
+
+type (
+	SecurityGroupRuleResource struct {
+		M *Meta
+	}
+
+	SecurityGroupRuleResourceModel struct {
+		ID              types.String `tfsdk:"id"`
+		SecurityGroupID types.String `tfsdk:"security_group_id"`
+		Direction       types.String `tfsdk:"direction"`
+		Protocol        types.String `tfsdk:"protocol"`
+		Ports           types.String `tfsdk:"ports"`
+		Targets         types.Set    `tfsdk:"targets"`
+		Notes           types.String `tfsdk:"notes"`
+	}
+
+	// securityGroupRuleAPIModel is the common subset of the various
+	// per-operation security group rule types generated by the API client.
+	securityGroupRuleAPIModel struct {
+		ID              *string
+		SecurityGroupID *string
+		Direction       *core.SecurityGroupRuleDirectionEnum
+		Protocol        *core.SecurityGroupRuleProtocolEnum
+		Ports           types.String
+		Targets         *[]string
+		Notes           types.String
+	}
+)
+
+const (
+	securityGroupRuleIDDescription = "The ID of the security group rule. " +
+		"This is automatically generated by the API."
+	securityGroupRuleDirectionDescription = "The direction of the rule " +
+		"(`inbound` or `outbound`)."
+	securityGroupRuleProtocolDescription = "The protocol of the rule " +
+		"(`TCP`, `UDP`, or `ICMP`)."
+	securityGroupRulePortsDescription = "The port, ports, or range of " +
+		"ports to which the rule applies (e.g. `22`, `22,80,443`, or " +
+		"`3000-3999`). If not specified, the rule applies to all ports."
+	securityGroupRuleTargetsDescription = "The targets to which the rule " +
+		"applies. Can be IP addresses, CIDR blocks, IDs for virtual " +
+		"machines, virtual machine groups, tags, address lists, or " +
+		"`all:ipv4` and `all:ipv6`."
+	securityGroupRuleNotesDescription = "Notes for the rule. Used for " +
+		"human reference only."
+)
+
+func (r *SecurityGroupRuleResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_security_group_rule"
+}
+
+func (r *SecurityGroupRuleResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	r.M = meta
+}
+
+func (r *SecurityGroupRuleResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Version: 1,
+		MarkdownDescription: "Allows management of individual security " +
+			"group rules. This should only be used with the " +
+			"`katapult_security_group` resource if the `external_rules` " +
+			"attribute is enabled. Otherwise you should define rules " +
+			"directly on the security group resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: securityGroupRuleIDDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The ID of the security group to " +
+					"which the rule applies.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"direction": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: securityGroupRuleDirectionDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive(
+						string(core.Inbound),
+						string(core.Outbound),
+					),
+				},
+			},
+			"protocol": securityGroupRuleProtocolAttribute(),
+			"ports":    securityGroupRulePortsAttribute(),
+			"targets":  securityGroupRuleTargetsAttribute(),
+			"notes":    securityGroupRuleNotesAttribute(),
+		},
+	}
+}
+
+func securityGroupRuleProtocolAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: securityGroupRuleProtocolDescription,
+		Validators: []validator.String{
+			stringvalidator.OneOfCaseInsensitive(
+				string(core.TCP),
+				string(core.UDP),
+				string(core.ICMP),
+			),
+		},
+	}
+}
+
+func securityGroupRulePortsAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: securityGroupRulePortsDescription,
+	}
+}
+
+func securityGroupRuleTargetsAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		Required:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: securityGroupRuleTargetsDescription,
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(
+				stringvalidator.LengthAtLeast(1),
+			),
+		},
+	}
+}
+
+func securityGroupRuleNotesAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: securityGroupRuleNotesDescription,
+	}
+}
+
+func (r *SecurityGroupRuleResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SecurityGroupRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args, diags := buildSecurityGroupRuleArgs(
+		ctx, plan.Protocol, plan.Ports, plan.Targets, plan.Notes,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	direction := core.SecurityGroupRuleDirectionEnum(
+		strings.ToLower(plan.Direction.ValueString()),
+	)
+	args.Direction = &direction
+
+	res, err := r.M.Core.PostSecurityGroupRulesWithResponse(ctx,
+		core.PostSecurityGroupRulesJSONRequestBody{
+			SecurityGroup: core.SecurityGroupLookup{
+				Id: plan.SecurityGroupID.ValueStringPointer(),
+			},
+			Properties: args,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	plan.ID = types.StringPointerValue(res.JSON200.SecurityGroupRule.Id)
+
+	if err := r.SecurityGroupRuleRead(ctx, plan.ID.ValueString(), &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *SecurityGroupRuleResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SecurityGroupRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SecurityGroupRuleRead(ctx, state.ID.ValueString(), &state)
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			r.M.Logger.Info(
+				"Security Group Rule not found, removing from state",
+				"id", state.ID.ValueString(),
+			)
+
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func (r *SecurityGroupRuleResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan SecurityGroupRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state SecurityGroupRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args, diags := buildSecurityGroupRuleArgs(
+		ctx, plan.Protocol, plan.Ports, plan.Targets, plan.Notes,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !strings.EqualFold(
+		plan.Direction.ValueString(), state.Direction.ValueString(),
+	) {
+		direction := core.SecurityGroupRuleDirectionEnum(
+			strings.ToLower(plan.Direction.ValueString()),
+		)
+		args.Direction = &direction
+	}
+
+	res, err := r.M.Core.PatchSecurityGroupsRulesSecurityGroupRuleWithResponse(
+		ctx,
+		core.PatchSecurityGroupsRulesSecurityGroupRuleJSONRequestBody{
+			SecurityGroupRule: core.SecurityGroupRuleLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+			Properties: args,
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	if err := r.SecurityGroupRuleRead(ctx, state.ID.ValueString(), &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *SecurityGroupRuleResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state SecurityGroupRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := deleteSecurityGroupRule(ctx, r.M, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+}
+
+func (r *SecurityGroupRuleResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the security group rule.")
+}
+
+func (r *SecurityGroupRuleResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// UpgradeState migrates state written by the SDKv2 implementation of this
+// resource, which stored omitted ports and notes as empty strings.
+func (r *SecurityGroupRuleResource) UpgradeState(
+	_ context.Context,
+) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.StringAttribute{Computed: true},
+					"security_group_id": schema.StringAttribute{Required: true},
+					"direction":         schema.StringAttribute{Required: true},
+					"protocol":          schema.StringAttribute{Required: true},
+					"ports":             schema.StringAttribute{Optional: true},
+					"targets": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+					},
+					"notes": schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: func(
+				ctx context.Context,
+				req resource.UpgradeStateRequest,
+				resp *resource.UpgradeStateResponse,
+			) {
+				var prior SecurityGroupRuleResourceModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				prior.Protocol = upgradeSecurityGroupRuleProtocol(prior.Protocol)
+				prior.Ports = upgradeSecurityGroupRuleString(prior.Ports)
+				prior.Notes = upgradeSecurityGroupRuleString(prior.Notes)
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, prior)...)
+			},
+		},
+	}
+}
+
+func (r *SecurityGroupRuleResource) SecurityGroupRuleRead(
+	ctx context.Context,
+	id string,
+	model *SecurityGroupRuleResourceModel,
+) error {
+	res, err := r.M.Core.GetSecurityGroupsRulesSecurityGroupRuleWithResponse(
+		ctx,
+		&core.GetSecurityGroupsRulesSecurityGroupRuleParams{
+			SecurityGroupRuleId: &id,
+		})
+	if err != nil {
+		if res != nil && !errors.Is(err, core.ErrNotFound) {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return err
+	}
+
+	rule := res.JSON200.SecurityGroupRule
+	apiRule := securityGroupRuleAPIModel{
+		ID:        rule.Id,
+		Direction: rule.Direction,
+		Protocol:  rule.Protocol,
+		Ports:     nullableStringValue(rule.Ports),
+		Targets:   rule.Targets,
+		Notes:     nullableStringValue(rule.Notes),
+	}
+	if rule.SecurityGroup != nil {
+		apiRule.SecurityGroupID = rule.SecurityGroup.Id
+	}
+
+	model.ID = types.StringPointerValue(apiRule.ID)
+	if apiRule.SecurityGroupID != nil {
+		model.SecurityGroupID = types.StringPointerValue(
+			apiRule.SecurityGroupID,
+		)
+	}
+	model.Direction = preserveStringCase(
+		model.Direction, (*string)(apiRule.Direction),
+	)
+	model.Protocol = preserveStringCase(
+		model.Protocol, (*string)(apiRule.Protocol),
+	)
+	model.Ports = preserveEmptyString(model.Ports, apiRule.Ports)
+	model.Notes = preserveEmptyString(model.Notes, apiRule.Notes)
+	model.Targets = securityGroupStringSet(apiRule.Targets)
+
+	return nil
+}
+
+// buildSecurityGroupRuleArgs builds the API arguments shared by standalone
+// and inline security group rules. The direction is left for the caller to
+// set, as it is only sent when creating or changing it.
+func buildSecurityGroupRuleArgs(
+	ctx context.Context,
+	protocol types.String,
+	ports types.String,
+	targets types.Set,
+	notes types.String,
+) (core.SecurityGroupRuleArguments, diag.Diagnostics) {
+	proto := core.SecurityGroupRuleProtocolEnum(
+		strings.ToUpper(protocol.ValueString()),
+	)
+
+	// Always send ports and notes so removing them from the configuration
+	// clears them on the rule. The API treats an empty string as unset.
+	args := core.SecurityGroupRuleArguments{
+		Protocol: &proto,
+		Ports:    ptr(ports.ValueString()),
+		Notes:    ptr(notes.ValueString()),
+	}
+
+	targetList := []string{}
+	diags := targets.ElementsAs(ctx, &targetList, false)
+	args.Targets = &targetList
+
+	return args, diags
+}
+
+func deleteSecurityGroupRule(ctx context.Context, m *Meta, id string) error {
+	res, err := m.Core.DeleteSecurityGroupsRulesSecurityGroupRuleWithResponse(
+		ctx,
+		core.DeleteSecurityGroupsRulesSecurityGroupRuleJSONRequestBody{
+			SecurityGroupRule: core.SecurityGroupRuleLookup{Id: &id},
+		})
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return nil
+		}
+
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func upgradeSecurityGroupRuleProtocol(v types.String) types.String {
+	if v.IsNull() || v.IsUnknown() {
+		return v
+	}
+
+	return types.StringValue(strings.ToUpper(v.ValueString()))
+}
+
+func upgradeSecurityGroupRuleString(v types.String) types.String {
+	if !v.IsUnknown() && v.ValueString() == "" {
+		return types.StringNull()
+	}
+
+	return v
+}
+
+// preserveStringCase returns the API value, unless it only differs from the
+// prior value by case. Direction and protocol are accepted case-insensitively,
+// so this keeps the value as written in the configuration.
+func preserveStringCase(prior types.String, value *string) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() &&
+		strings.EqualFold(prior.ValueString(), *value) {
+		return prior
+	}
+
+	return types.StringValue(*value)
+}
+
+// preserveEmptyString returns the API value, unless it is null and the prior
+// value is an explicitly configured empty string.
+func preserveEmptyString(prior types.String, value types.String) types.String {
+	if value.IsNull() && !prior.IsUnknown() && prior.ValueString() == "" {
+		return prior
+	}
+
+	return value
+}
+
+func nullableStringValue(value interface {
+	IsSpecified() bool
+	IsNull() bool
+	Get() (string, error)
+},
+) types.String {
+	if !value.IsSpecified() || value.IsNull() {
+		return types.StringNull()
+	}
+	if v, err := value.Get(); err == nil && v != "" {
+		return types.StringValue(v)
+	}
+	return types.StringNull()
+}
+
+func securityGroupStringSet(values *[]string) types.Set {
+	elems := []attr.Value{}
+	if values != nil {
+		for _, v := range *values {
+			elems = append(elems, types.StringValue(v))
+		}
+	}
+
+	return types.SetValueMust(types.StringType, elems)
+}
@@ -0,0 +1,209 @@
+package v6provider
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jimeh/undent"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+func TestAccKatapultSecurityGroupRule_example(t *testing.T) {
+	if vcrMode() == recorder.ModeReplaying {
+		t.Skip("example based tests are not supported in replay mode")
+	}
+
+	tt := newTestTools(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: exampleResourceConfig(
+					t, "katapult_security_group_rule",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.minimal",
+					),
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.http",
+					),
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.ssh",
+					),
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.range",
+					),
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.range_all_ports",
+					),
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.smtp",
+					),
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.http_out",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKatapultSecurityGroupRule_update(t *testing.T) {
+	tt := newTestTools(t)
+
+	name := tt.ResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccCheckKatapultSecurityGroupRuleDestroy(tt),
+			testAccCheckKatapultSecurityGroupDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: undent.Stringf(`
+					resource "katapult_security_group" "my_sg" {
+						name           = "%s"
+						external_rules = true
+					}
+
+					resource "katapult_security_group_rule" "my_rule" {
+						security_group_id = katapult_security_group.my_sg.id
+						direction         = "inbound"
+						protocol          = "TCP"
+						ports             = "22"
+						targets           = ["all:ipv4"]
+						notes             = "SSH"
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.my_rule",
+					),
+					resource.TestCheckResourceAttrPair(
+						"katapult_security_group_rule.my_rule",
+						"security_group_id",
+						"katapult_security_group.my_sg", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule", "ports", "22",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule", "notes", "SSH",
+					),
+				),
+			},
+			{
+				Config: undent.Stringf(`
+					resource "katapult_security_group" "my_sg" {
+						name           = "%s"
+						external_rules = true
+					}
+
+					resource "katapult_security_group_rule" "my_rule" {
+						security_group_id = katapult_security_group.my_sg.id
+						direction         = "outbound"
+						protocol          = "UDP"
+						ports             = "53"
+						targets           = ["all:ipv4", "all:ipv6"]
+						notes             = "DNS"
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSecurityGroupRuleExists(
+						tt, "katapult_security_group_rule.my_rule",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule",
+						"direction", "outbound",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule",
+						"protocol", "UDP",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule", "ports", "53",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule", "targets.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group_rule.my_rule", "notes", "DNS",
+					),
+				),
+			},
+			{
+				ResourceName:      "katapult_security_group_rule.my_rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKatapultSecurityGroupRuleExists(
+	tt *testTools,
+	res string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+
+		resp, err := tt.Meta.Core.GetSecurityGroupsRulesSecurityGroupRuleWithResponse(
+			tt.Ctx,
+			&core.GetSecurityGroupsRulesSecurityGroupRuleParams{
+				SecurityGroupRuleId: &rs.Primary.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		sgr := resp.JSON200.SecurityGroupRule
+
+		return resource.TestCheckResourceAttr(res, "id", *sgr.Id)(s)
+	}
+}
+
+func testAccCheckKatapultSecurityGroupRuleDestroy(
+	tt *testTools,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "katapult_security_group_rule" {
+				continue
+			}
+
+			_, err := tt.Meta.Core.GetSecurityGroupsRulesSecurityGroupRuleWithResponse(
+				tt.Ctx,
+				&core.GetSecurityGroupsRulesSecurityGroupRuleParams{
+					SecurityGroupRuleId: &rs.Primary.ID,
+				},
+			)
+			if err == nil {
+				return fmt.Errorf(
+					"katapult_security_group_rule %s was not destroyed",
+					rs.Primary.ID,
+				)
+			}
+			if !errors.Is(err, core.ErrNotFound) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,632 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dnaeon/go-vcr/recorder"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jimeh/undent"
+	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() { //nolint:gochecknoinits
+	resource.AddTestSweepers("katapult_security_group", &resource.Sweeper{
+		Name: "katapult_security_group",
+		F:    testSweepSecurityGroups,
+	})
+}
+
+func testSweepSecurityGroups(_ string) error {
+	m := sweepMeta()
+	ctx := context.TODO()
+
+	var groups []core.SecurityGroup
+	totalPages := 2
+	for pageNum := 1; pageNum <= totalPages; pageNum++ {
+		res, err := m.Core.GetOrganizationSecurityGroupsWithResponse(ctx,
+			&core.GetOrganizationSecurityGroupsParams{
+				OrganizationId: &m.confOrganization,
+				Page:           &pageNum,
+			})
+		if err != nil {
+			if errors.Is(err, core.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		if res == nil || res.JSON200 == nil {
+			return fmt.Errorf(
+				"unexpected empty response listing security groups",
+			)
+		}
+
+		resp := res.JSON200
+
+		totalPages, _ = resp.Pagination.TotalPages.Get()
+		groups = append(groups, resp.SecurityGroups...)
+	}
+
+	for _, sg := range groups {
+		if sg.Name == nil || sg.Id == nil {
+			continue
+		}
+		if !strings.HasPrefix(*sg.Name, testAccResourceNamePrefix) {
+			continue
+		}
+
+		m.Logger.Info("deleting security group", "id", *sg.Id, "name", *sg.Name)
+		_, err := m.Core.DeleteSecurityGroupWithResponse(ctx,
+			core.DeleteSecurityGroupJSONRequestBody{
+				SecurityGroup: core.SecurityGroupLookup{
+					Id: sg.Id,
+				},
+			})
+		if err != nil && !errors.Is(err, core.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAccKatapultSecurityGroup_example(t *testing.T) {
+	if vcrMode() == recorder.ModeReplaying {
+		t.Skip("example based tests are not supported in replay mode")
+	}
+
+	tt := newTestTools(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: exampleResourceConfig(
+					t, "katapult_security_group",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSecurityGroupExists(
+						tt, "katapult_security_group.minimal",
+					),
+					testAccCheckKatapultSecurityGroupExists(
+						tt, "katapult_security_group.practical",
+					),
+					testAccCheckKatapultSecurityGroupExists(
+						tt, "katapult_security_group.dynamic",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKatapultSecurityGroup_update(t *testing.T) {
+	tt := newTestTools(t)
+
+	name := tt.ResourceName()
+	updatedName := tt.ResourceName("updated")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSecurityGroupDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: undent.Stringf(`
+					resource "katapult_security_group" "my_sg" {
+						name = "%s"
+
+						inbound_rule {
+							protocol = "TCP"
+							ports    = "22"
+							targets  = ["all:ipv4"]
+							notes    = "SSH"
+						}
+					}`,
+					name,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSecurityGroupExists(
+						tt, "katapult_security_group.my_sg",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg", "name", name,
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg",
+						"allow_all_outbound", "false",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg", "inbound_rule.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg",
+						"inbound_rule.0.ports", "22",
+					),
+					resource.TestCheckResourceAttrSet(
+						"katapult_security_group.my_sg", "inbound_rule.0.id",
+					),
+				),
+			},
+			{
+				Config: undent.Stringf(`
+					resource "katapult_security_group" "my_sg" {
+						name               = "%s"
+						allow_all_outbound = true
+
+						inbound_rule {
+							protocol = "TCP"
+							ports    = "2222"
+							targets  = ["all:ipv4", "all:ipv6"]
+							notes    = "SSH"
+						}
+
+						inbound_rule {
+							protocol = "TCP"
+							ports    = "80,443"
+							targets  = ["all:ipv4", "all:ipv6"]
+							notes    = "HTTP"
+						}
+					}`,
+					updatedName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSecurityGroupExists(
+						tt, "katapult_security_group.my_sg",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg", "name", updatedName,
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg",
+						"allow_all_outbound", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg", "inbound_rule.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg",
+						"inbound_rule.0.ports", "2222",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_security_group.my_sg",
+						"inbound_rule.1.ports", "80,443",
+					),
+				),
+			},
+			{
+				ResourceName:            "katapult_security_group.my_sg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+func testAccCheckKatapultSecurityGroupExists(
+	tt *testTools,
+	res string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+
+		resp, err := tt.Meta.Core.GetSecurityGroupWithResponse(
+			tt.Ctx,
+			&core.GetSecurityGroupParams{
+				SecurityGroupId: &rs.Primary.ID,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		sg := resp.JSON200.SecurityGroup
+
+		return resource.TestCheckResourceAttr(res, "id", *sg.Id)(s)
+	}
+}
+
+func testAccCheckKatapultSecurityGroupDestroy(
+	tt *testTools,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "katapult_security_group" {
+				continue
+			}
+
+			resp, err := tt.Meta.Core.GetSecurityGroupWithResponse(
+				tt.Ctx,
+				&core.GetSecurityGroupParams{
+					SecurityGroupId: &rs.Primary.ID,
+				},
+			)
+
+			if err == nil && resp.JSON200 != nil {
+				return fmt.Errorf(
+					"katapult_security_group %s (%s) was not destroyed",
+					rs.Primary.ID, *resp.JSON200.SecurityGroup.Name,
+				)
+			}
+		}
+
+		return nil
+	}
+}
+
+func TestSecurityGroupResourceReadRemovesMissingResource(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusNotFound, `{
+			"error": {
+				"code": "security_group_not_found",
+				"description": "No security group was found"
+			}
+		}`)
+	})
+	r := &SecurityGroupResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := securityGroupTestState(t, r, SecurityGroupResourceModel{
+		ID: types.StringValue("sg_missing"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.True(
+		t, resp.State.Raw.IsNull(),
+		"missing security group should be removed from state",
+	)
+}
+
+func TestSecurityGroupResourceReadAdoptsUnknownRules(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, securityGroupReadTestHandler)
+	r := &SecurityGroupResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := securityGroupTestState(t, r, SecurityGroupResourceModel{
+		ID: types.StringValue("sg_test"),
+		InboundRules: securityGroupTestRules(t, SecurityGroupInlineRuleModel{
+			ID:        types.StringValue("sgr_known"),
+			Direction: types.StringValue("inbound"),
+			Protocol:  types.StringValue("tcp"),
+			Ports:     types.StringValue(""),
+			Targets:   securityGroupStringSet(&[]string{"all:ipv4"}),
+			Notes:     types.StringNull(),
+		}),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var model SecurityGroupResourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &model)...)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	assert.Equal(t, "sg_test", model.ID.ValueString())
+	assert.Equal(t, "Test SG", model.Name.ValueString())
+	assert.False(t, model.ExternalRules.ValueBool())
+
+	inbound, diags := securityGroupInlineRules(
+		context.Background(), model.InboundRules,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Len(t, inbound, 2)
+
+	// Known rules keep their configured casing and empty values.
+	assert.Equal(t, "sgr_known", inbound[0].ID.ValueString())
+	assert.Equal(t, "tcp", inbound[0].Protocol.ValueString())
+	assert.Equal(t, types.StringValue(""), inbound[0].Ports)
+
+	// Rules created outside Terraform are adopted as the API returns them.
+	assert.Equal(t, "sgr_external", inbound[1].ID.ValueString())
+	assert.Equal(t, "UDP", inbound[1].Protocol.ValueString())
+	assert.True(t, inbound[1].Ports.IsNull())
+	assert.Equal(t, "dns", inbound[1].Notes.ValueString())
+
+	outbound, diags := securityGroupInlineRules(
+		context.Background(), model.OutboundRules,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Len(t, outbound, 1)
+	assert.Equal(t, "ICMP", outbound[0].Protocol.ValueString())
+}
+
+func TestSecurityGroupResourceSecurityGroupReadWithoutAdopt(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, securityGroupReadTestHandler)
+	r := &SecurityGroupResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	model := SecurityGroupResourceModel{
+		ID:            types.StringValue("sg_test"),
+		ExternalRules: types.BoolValue(false),
+		InboundRules: securityGroupTestRules(t, SecurityGroupInlineRuleModel{
+			ID:        types.StringValue("sgr_known"),
+			Direction: types.StringValue("inbound"),
+			Protocol:  types.StringValue("TCP"),
+			Ports:     types.StringNull(),
+			Targets:   securityGroupStringSet(&[]string{"all:ipv4"}),
+			Notes:     types.StringNull(),
+		}),
+		OutboundRules: securityGroupTestRules(t),
+	}
+
+	err := r.SecurityGroupRead(context.Background(), &model, false)
+	require.NoError(t, err)
+
+	inbound, diags := securityGroupInlineRules(
+		context.Background(), model.InboundRules,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Len(t, inbound, 1)
+	assert.Equal(t, "sgr_known", inbound[0].ID.ValueString())
+
+	outbound, diags := securityGroupInlineRules(
+		context.Background(), model.OutboundRules,
+	)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.Empty(t, outbound)
+}
+
+func TestSecurityGroupResourceReadSkipsRulesWhenExternal(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		if r.URL.Path != "/security_groups/security_group" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		securityGroupReadTestHandler(w, r)
+	})
+	r := &SecurityGroupResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	model := SecurityGroupResourceModel{
+		ID:            types.StringValue("sg_test"),
+		ExternalRules: types.BoolValue(true),
+	}
+
+	err := r.SecurityGroupRead(context.Background(), &model, true)
+	require.NoError(t, err)
+
+	assert.True(t, model.ExternalRules.ValueBool())
+	assert.Empty(t, model.InboundRules.Elements())
+	assert.Empty(t, model.OutboundRules.Elements())
+}
+
+func TestSecurityGroupResourceUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &SecurityGroupResource{}
+	upgrader := r.UpgradeState(ctx)[0]
+	require.NotNil(t, upgrader.PriorSchema)
+
+	prior := securityGroupResourceModelV0{
+		ID:               types.StringValue("sg_test"),
+		Name:             types.StringValue("Test SG"),
+		Associations:     types.SetNull(types.StringType),
+		AllowAllInbound:  types.BoolNull(),
+		AllowAllOutbound: types.BoolValue(true),
+		ExternalRules:    types.BoolNull(),
+		InboundRules: securityGroupTestRules(t, SecurityGroupInlineRuleModel{
+			ID:        types.StringValue("sgr_1"),
+			Direction: types.StringValue("inbound"),
+			Protocol:  types.StringValue("tcp"),
+			Ports:     types.StringValue(""),
+			Targets:   securityGroupStringSet(&[]string{"all:ipv4"}),
+			Notes:     types.StringValue(""),
+		}),
+		OutboundRules: types.ListNull(SecurityGroupInlineRuleType()),
+	}
+	priorState := tfsdk.State{Schema: *upgrader.PriorSchema}
+	diags := priorState.Set(ctx, prior)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(ctx, frameworkresource.SchemaRequest{}, schemaResp)
+
+	req := frameworkresource.UpgradeStateRequest{State: &priorState}
+	resp := frameworkresource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var model SecurityGroupResourceModel
+	diags = resp.State.Get(ctx, &model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, "sg_test", model.ID.ValueString())
+	assert.False(t, model.AllowAllInbound.ValueBool())
+	assert.True(t, model.AllowAllOutbound.ValueBool())
+	assert.False(t, model.ExternalRules.IsNull())
+	assert.False(t, model.Associations.IsNull())
+	assert.Empty(t, model.Associations.Elements())
+
+	inbound, diags := securityGroupInlineRules(ctx, model.InboundRules)
+	require.False(t, diags.HasError(), diags.Errors())
+	require.Len(t, inbound, 1)
+	assert.Equal(t, "TCP", inbound[0].Protocol.ValueString())
+	assert.True(t, inbound[0].Ports.IsNull())
+	assert.True(t, inbound[0].Notes.IsNull())
+	assert.Empty(t, model.OutboundRules.Elements())
+}
+
+func TestDiffSecurityGroupInlineRules(t *testing.T) {
+	t.Parallel()
+
+	rule := func(id, protocol, ports string) SecurityGroupInlineRuleModel {
+		idValue := types.StringValue(id)
+		if id == "" {
+			idValue = types.StringUnknown()
+		}
+
+		return SecurityGroupInlineRuleModel{
+			ID:       idValue,
+			Protocol: types.StringValue(protocol),
+			Ports:    types.StringValue(ports),
+			Targets:  securityGroupStringSet(&[]string{"all:ipv4"}),
+			Notes:    types.StringNull(),
+		}
+	}
+
+	oldRules := []SecurityGroupInlineRuleModel{
+		rule("sgr_same", "TCP", "22"),
+		rule("sgr_case", "TCP", "80"),
+		rule("sgr_changed", "TCP", "443"),
+		rule("sgr_removed", "UDP", "53"),
+	}
+	newRules := []SecurityGroupInlineRuleModel{
+		rule("sgr_same", "TCP", "22"),
+		rule("sgr_case", "tcp", "80"),
+		rule("sgr_changed", "TCP", "8443"),
+		rule("", "ICMP", ""),
+	}
+
+	create, update, del := diffSecurityGroupInlineRules(oldRules, newRules)
+
+	assert.Equal(t, []int{3}, create)
+	assert.Equal(t, []int{2}, update)
+	assert.Equal(t, []string{"sgr_removed"}, del)
+}
+
+func securityGroupReadTestHandler(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/security_groups/security_group":
+		writeTestJSON(w, http.StatusOK, `{
+			"security_group": {
+				"id": "sg_test",
+				"name": "Test SG",
+				"allow_all_inbound": false,
+				"allow_all_outbound": false,
+				"associations": []
+			}
+		}`)
+	case "/security_groups/security_group/rules":
+		writeTestJSON(w, http.StatusOK, `{
+			"pagination": {"current_page": 1, "total_pages": 1},
+			"security_group_rules": [
+				{
+					"id": "sgr_known",
+					"direction": "inbound",
+					"protocol": "TCP",
+					"ports": null,
+					"targets": ["all:ipv4"],
+					"notes": null
+				},
+				{
+					"id": "sgr_external",
+					"direction": "inbound",
+					"protocol": "UDP",
+					"ports": null,
+					"targets": ["all:ipv4"],
+					"notes": "dns"
+				},
+				{
+					"id": "sgr_icmp",
+					"direction": "outbound",
+					"protocol": "ICMP",
+					"ports": null,
+					"targets": ["all:ipv6"],
+					"notes": null
+				}
+			]
+		}`)
+	default:
+		writeTestJSON(w, http.StatusNotFound, `{}`)
+	}
+}
+
+func securityGroupTestRules(
+	t *testing.T,
+	rules ...SecurityGroupInlineRuleModel,
+) types.List {
+	t.Helper()
+
+	list, diags := securityGroupInlineRuleList(context.Background(), rules)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return list
+}
+
+func securityGroupTestState(
+	t *testing.T,
+	r *SecurityGroupResource,
+	model SecurityGroupResourceModel,
+) tfsdk.State {
+	t.Helper()
+
+	if model.Associations.IsNull() {
+		model.Associations = types.SetNull(types.StringType)
+	}
+	if model.InboundRules.IsNull() {
+		model.InboundRules = types.ListNull(SecurityGroupInlineRuleType())
+	}
+	if model.OutboundRules.IsNull() {
+		model.OutboundRules = types.ListNull(SecurityGroupInlineRuleType())
+	}
+	if len(model.Timeouts.AttributeTypes(context.Background())) == 0 {
+		model.Timeouts = timeouts.Value{Object: types.ObjectNull(
+			map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+		)}
+	}
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(
+		context.Background(),
+		frameworkresource.SchemaRequest{},
+		schemaResp,
+	)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return state
+}
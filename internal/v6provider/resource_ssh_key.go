@@ -0,0 +1,339 @@
+package v6provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/go-katapult/next/core"
+)
+
+var _ resource.ResourceWithIdentity = (*SSHKeyResource)(nil)
+
+type (
+	SSHKeyResource struct {
+		M *Meta
+	}
+
+	SSHKeyResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		Key          types.String `tfsdk:"key"`
+		Fingerprint  types.String `tfsdk:"fingerprint"`
+		Organization types.String `tfsdk:"organization"`
+	}
+)
+
+const sshKeysPageSize = 100
+
+const (
+	sshKeyIDDescription          = "The ID of the SSH key."
+	sshKeyNameDescription        = "The name of the SSH key."
+	sshKeyFingerprintDescription = "The fingerprint of the SSH key."
+	sshKeyKeyDescription         = "The public key, in OpenSSH " +
+		"`authorized_keys` format. Katapult does not return the key once it " +
+		"has been added, so an imported key adopts the configured value " +
+		"without being replaced."
+)
+
+func (r *SSHKeyResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key"
+}
+
+func (r *SSHKeyResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	resp *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	meta, ok := req.ProviderData.(*Meta)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Meta Error",
+			"meta is not of type *Meta",
+		)
+		return
+	}
+
+	r.M = meta
+}
+
+func (r *SSHKeyResource) Schema(
+	_ context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage an SSH key in the organization. SSH keys can " +
+			"be authorized on new virtual machines with the " +
+			"authorized_key_ids attribute of katapult_virtual_machine.",
+		MarkdownDescription: "Manage an SSH key in the organization. SSH " +
+			"keys can be authorized on new virtual machines with the " +
+			"`authorized_key_ids` attribute of `katapult_virtual_machine`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: sshKeyIDDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: sshKeyNameDescription,
+				Validators: []validator.String{
+					stringValidatorNotEmpty(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				Description:         sshKeyKeyDescription,
+				MarkdownDescription: sshKeyKeyDescription,
+				Validators: []validator.String{
+					stringValidatorSSHPublicKey(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						sshKeyRequiresReplace,
+						"Replacing the key requires a new SSH key.",
+						"Replacing the key requires a new SSH key.",
+					),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Computed:    true,
+				Description: sshKeyFingerprintDescription,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			organizationAttributeName: organizationResourceAttribute(),
+		},
+	}
+}
+
+// sshKeyRequiresReplace lets an imported key, which has no key in state,
+// adopt the configured value in place.
+func sshKeyRequiresReplace(
+	_ context.Context,
+	req planmodifier.StringRequest,
+	resp *stringplanmodifier.RequiresReplaceIfFuncResponse,
+) {
+	resp.RequiresReplace = !req.StateValue.IsNull()
+}
+
+func (r *SSHKeyResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := strings.TrimSpace(plan.Key.ValueString())
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
+	res, err := r.M.Core.PostOrganizationSshKeysWithResponse(ctx,
+		core.PostOrganizationSshKeysJSONRequestBody{
+			Organization: core.OrganizationLookup{
+				SubDomain: &org,
+			},
+			SshKey: core.AuthSSHKeyProperties{
+				Name: plan.Name.ValueStringPointer(),
+				Key:  &key,
+			},
+		})
+	if err != nil {
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
+
+	sshKey := res.JSON201.SshKey
+	if sshKey.Id == nil {
+		resp.Diagnostics.AddError("Create Error", "missing ID in response")
+		return
+	}
+
+	plan.ID = types.StringPointerValue(sshKey.Id)
+	plan.Name = types.StringPointerValue(sshKey.Name)
+	plan.Fingerprint = types.StringPointerValue(sshKey.Fingerprint)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *SSHKeyResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SSHKeyRead(ctx, state.ID.ValueString(), &state)
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			r.M.Logger.Info(
+				"SSH Key not found, removing from state",
+				"id", state.ID.ValueString(),
+			)
+
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+// Update is only reached when an imported key adopts its configured value,
+// as every other change requires replacement.
+func (r *SSHKeyResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan SSHKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.SSHKeyRead(ctx, plan.ID.ValueString(), &plan); err != nil {
+		resp.Diagnostics.AddError("Read Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+func (r *SSHKeyResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state SSHKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.M.Core.DeleteSshKeyWithResponse(ctx,
+		core.DeleteSshKeyJSONRequestBody{
+			SshKey: core.AuthSSHKeyLookup{
+				Id: state.ID.ValueStringPointer(),
+			},
+		})
+	if err != nil {
+		if errors.Is(err, core.ErrNotFound) {
+			return
+		}
+
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		resp.Diagnostics.AddError("Delete Error", err.Error())
+		return
+	}
+}
+
+func (r *SSHKeyResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the SSH key.")
+}
+
+func (r *SSHKeyResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// SSHKeyRead looks the key up in the organization's SSH keys, as the API has
+// no endpoint to fetch a single key. The key itself is never returned, so the
+// model's existing value is kept.
+func (r *SSHKeyResource) SSHKeyRead(
+	ctx context.Context,
+	id string,
+	model *SSHKeyResourceModel,
+) error {
+	org := r.M.organization(model.Organization)
+	for page := 1; ; page++ {
+		res, err := r.M.Core.GetOrganizationSshKeysWithResponse(ctx,
+			&core.GetOrganizationSshKeysParams{
+				OrganizationSubDomain: &org,
+				Page:                  ptr(page),
+				PerPage:               ptr(sshKeysPageSize),
+			})
+		if err != nil {
+			if res != nil {
+				err = genericAPIError(err, res.Body, res.HTTPResponse)
+			}
+
+			return err
+		}
+
+		for _, sshKey := range res.JSON200.SshKeys {
+			if sshKey.Id == nil || *sshKey.Id != id {
+				continue
+			}
+
+			model.ID = types.StringPointerValue(sshKey.Id)
+			model.Name = types.StringPointerValue(sshKey.Name)
+			model.Fingerprint = types.StringPointerValue(sshKey.Fingerprint)
+
+			return nil
+		}
+
+		if !paginationHasNext(
+			res.JSON200.Pagination, page, len(res.JSON200.SshKeys),
+			sshKeysPageSize,
+		) {
+			return fmt.Errorf("%w: ssh key %s", core.ErrNotFound, id)
+		}
+	}
+}
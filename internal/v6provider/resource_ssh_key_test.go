@@ -0,0 +1,412 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jimeh/undent"
+	"github.com/krystal/go-katapult/next/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() { //nolint:gochecknoinits
+	resource.AddTestSweepers("katapult_ssh_key", &resource.Sweeper{
+		Name: "katapult_ssh_key",
+		F:    testSweepSSHKeys,
+	})
+}
+
+func testSweepSSHKeys(_ string) error {
+	m := sweepMeta()
+	ctx := context.TODO()
+
+	var keys []core.AuthSSHKey
+	totalPages := 2
+	for pageNum := 1; pageNum <= totalPages; pageNum++ {
+		res, err := m.Core.GetOrganizationSshKeysWithResponse(ctx,
+			&core.GetOrganizationSshKeysParams{
+				OrganizationSubDomain: &m.confOrganization,
+				Page:                  &pageNum,
+			})
+		if err != nil {
+			return err
+		}
+		if res == nil || res.JSON200 == nil {
+			return fmt.Errorf("unexpected empty response listing SSH keys")
+		}
+
+		resp := res.JSON200
+
+		totalPages, _ = resp.Pagination.TotalPages.Get()
+		keys = append(keys, resp.SshKeys...)
+	}
+
+	for _, key := range keys {
+		if key.Name == nil || key.Id == nil {
+			continue
+		}
+		if !strings.HasPrefix(*key.Name, testAccResourceNamePrefix) {
+			continue
+		}
+
+		m.Logger.Info("deleting SSH key", "id", *key.Id, "name", *key.Name)
+		_, err := m.Core.DeleteSshKeyWithResponse(ctx,
+			core.DeleteSshKeyJSONRequestBody{
+				SshKey: core.AuthSSHKeyLookup{
+					Id: key.Id,
+				},
+			})
+		if err != nil && !errors.Is(err, core.ErrNotFound) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func TestAccKatapultSSHKey_update(t *testing.T) {
+	tt := newTestTools(t)
+
+	name := tt.ResourceName()
+	var keyID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:             testAccCheckKatapultSSHKeyDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: undent.Stringf(`
+					resource "katapult_ssh_key" "deploy" {
+						name = "%s"
+						key  = "%s"
+					}`,
+					name, testSSHPublicKey,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSSHKeyExists(
+						tt, "katapult_ssh_key.deploy",
+					),
+					captureResourceAttr("katapult_ssh_key.deploy", "id", &keyID),
+					resource.TestCheckResourceAttr(
+						"katapult_ssh_key.deploy", "name", name,
+					),
+					resource.TestCheckResourceAttrSet(
+						"katapult_ssh_key.deploy", "fingerprint",
+					),
+				),
+			},
+			{
+				// SSH keys cannot be changed in place, so renaming one
+				// replaces it.
+				Config: undent.Stringf(`
+					resource "katapult_ssh_key" "deploy" {
+						name = "%s-renamed"
+						key  = "%s"
+					}`,
+					name, testSSHPublicKey,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKatapultSSHKeyExists(
+						tt, "katapult_ssh_key.deploy",
+					),
+					resource.TestCheckResourceAttr(
+						"katapult_ssh_key.deploy", "name", name+"-renamed",
+					),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["katapult_ssh_key.deploy"]
+						if rs.Primary.ID == keyID {
+							return fmt.Errorf(
+								"katapult_ssh_key %s was not replaced", keyID,
+							)
+						}
+
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "katapult_ssh_key.deploy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API never returns the key itself, nor the owning
+				// organization.
+				ImportStateVerifyIgnore: []string{"key", "organization"},
+			},
+		},
+	})
+}
+
+func TestSSHKeyResourceCreate(t *testing.T) {
+	t.Parallel()
+
+	var body core.PostOrganizationSshKeysJSONRequestBody
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/organizations/organization/ssh_keys", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		writeTestJSON(w, http.StatusCreated, `{
+			"ssh_key": {
+				"id": "key_test",
+				"name": "deploy",
+				"fingerprint": "aa:bb:cc"
+			}
+		}`)
+	})
+	r := &SSHKeyResource{M: &Meta{
+		Core:             client,
+		Logger:           hclog.NewNullLogger(),
+		confOrganization: "test-org",
+		testMode:         true,
+	}}
+	plan := sshKeyTestState(t, r, SSHKeyResourceModel{
+		ID:          types.StringUnknown(),
+		Name:        types.StringValue("deploy"),
+		Key:         types.StringValue(testSSHPublicKey + "\n"),
+		Fingerprint: types.StringUnknown(),
+	})
+
+	resp := frameworkresource.CreateResponse{
+		State: tfsdk.State{Schema: plan.Schema},
+	}
+	r.Create(
+		context.Background(),
+		frameworkresource.CreateRequest{
+			Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw},
+		},
+		&resp,
+	)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	assert.Equal(t, "test-org", *body.Organization.SubDomain)
+	assert.Equal(t, "deploy", *body.SshKey.Name)
+	assert.Equal(t, testSSHPublicKey, *body.SshKey.Key)
+
+	var got SSHKeyResourceModel
+	diags := resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, SSHKeyResourceModel{
+		ID:           types.StringValue("key_test"),
+		Name:         types.StringValue("deploy"),
+		Key:          types.StringValue(testSSHPublicKey + "\n"),
+		Fingerprint:  types.StringValue("aa:bb:cc"),
+		Organization: types.StringValue("test-org"),
+	}, got)
+}
+
+func TestSSHKeyResourceReadPaginates(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		r *http.Request,
+	) {
+		switch r.URL.Query().Get("page") {
+		case "1":
+			writeTestJSON(w, http.StatusOK, `{
+				"ssh_keys": [
+					{"id": "key_other", "name": "other", "fingerprint": "00"}
+				],
+				"pagination": {"current_page": 1, "total_pages": 2}
+			}`)
+		case "2":
+			writeTestJSON(w, http.StatusOK, `{
+				"ssh_keys": [
+					{"id": "key_test", "name": "renamed", "fingerprint": "aa:bb"}
+				],
+				"pagination": {"current_page": 2, "total_pages": 2}
+			}`)
+		default:
+			t.Errorf("unexpected page: %s", r.URL.Query().Get("page"))
+			writeTestJSON(w, http.StatusNotFound, `{}`)
+		}
+	})
+	r := &SSHKeyResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := sshKeyTestState(t, r, SSHKeyResourceModel{
+		ID:  types.StringValue("key_test"),
+		Key: types.StringValue(testSSHPublicKey),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	var got SSHKeyResourceModel
+	diags := resp.State.Get(context.Background(), &got)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	assert.Equal(t, SSHKeyResourceModel{
+		ID:          types.StringValue("key_test"),
+		Name:        types.StringValue("renamed"),
+		Key:         types.StringValue(testSSHPublicKey),
+		Fingerprint: types.StringValue("aa:bb"),
+	}, got)
+}
+
+func TestSSHKeyResourceReadRemovesMissingResource(t *testing.T) {
+	t.Parallel()
+
+	client := newVirtualMachineTestClient(t, func(
+		w http.ResponseWriter,
+		_ *http.Request,
+	) {
+		writeTestJSON(w, http.StatusOK, `{
+			"ssh_keys": [],
+			"pagination": {"current_page": 1, "total_pages": 1}
+		}`)
+	})
+	r := &SSHKeyResource{M: &Meta{
+		Core:     client,
+		Logger:   hclog.NewNullLogger(),
+		testMode: true,
+	}}
+	state := sshKeyTestState(t, r, SSHKeyResourceModel{
+		ID: types.StringValue("key_missing"),
+	})
+
+	req := frameworkresource.ReadRequest{State: state}
+	resp := frameworkresource.ReadResponse{State: state}
+	r.Read(context.Background(), req, &resp)
+
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.True(
+		t, resp.State.Raw.IsNull(),
+		"missing SSH key should be removed from state",
+	)
+}
+
+func TestSSHKeyRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		state types.String
+		want  bool
+	}{
+		{
+			name:  "imported key without a value",
+			state: types.StringNull(),
+			want:  false,
+		},
+		{
+			name:  "changed key",
+			state: types.StringValue("ssh-ed25519 AAAA old"),
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+			sshKeyRequiresReplace(
+				context.Background(),
+				planmodifier.StringRequest{
+					StateValue: tt.state,
+					PlanValue:  types.StringValue(testSSHPublicKey),
+				},
+				resp,
+			)
+
+			assert.Equal(t, tt.want, resp.RequiresReplace)
+		})
+	}
+}
+
+//
+// Helpers
+//
+
+func testAccCheckKatapultSSHKeyExists(
+	tt *testTools,
+	res string,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+
+		r := &SSHKeyResource{M: tt.Meta}
+		model := SSHKeyResourceModel{Organization: types.StringNull()}
+		if err := r.SSHKeyRead(tt.Ctx, rs.Primary.ID, &model); err != nil {
+			return err
+		}
+
+		return resource.TestCheckResourceAttr(
+			res, "fingerprint", model.Fingerprint.ValueString(),
+		)(s)
+	}
+}
+
+func testAccCheckKatapultSSHKeyDestroy(
+	tt *testTools,
+) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		r := &SSHKeyResource{M: tt.Meta}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "katapult_ssh_key" {
+				continue
+			}
+
+			model := SSHKeyResourceModel{Organization: types.StringNull()}
+			err := r.SSHKeyRead(tt.Ctx, rs.Primary.ID, &model)
+			if err == nil {
+				return fmt.Errorf(
+					"katapult_ssh_key %s was not destroyed", rs.Primary.ID,
+				)
+			}
+			if !errors.Is(err, core.ErrNotFound) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func sshKeyTestState(
+	t *testing.T,
+	r *SSHKeyResource,
+	model SSHKeyResourceModel,
+) tfsdk.State {
+	t.Helper()
+
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(
+		context.Background(),
+		frameworkresource.SchemaRequest{},
+		schemaResp,
+	)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	diags := state.Set(context.Background(), model)
+	require.False(t, diags.HasError(), diags.Errors())
+
+	return state
+}
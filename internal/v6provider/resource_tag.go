@@ -13,15 +13,18 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var _ resource.ResourceWithIdentity = (*TagResource)(nil)
+
 type (
 	TagResource struct {
 		M *Meta
 	}
 
 	TagResourceModel struct {
-		ID    types.String `tfsdk:"id"`
-		Name  types.String `tfsdk:"name"`
-		Color types.String `tfsdk:"color"`
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		Color        types.String `tfsdk:"color"`
+		Organization types.String `tfsdk:"organization"`
 	}
 )
 
@@ -88,6 +91,7 @@ func (r TagResource) Schema(
 					"(https://apidocs.k.io/katapult/enums/6808ef8ef6/) " +
 					"for available colors",
 			},
+			organizationAttributeName: organizationResourceAttribute(),
 		},
 	}
 }
@@ -111,19 +115,24 @@ func (r *TagResource) Create(
 		tagArgs.Color = (*core.TagColorsEnum)(plan.Color.ValueStringPointer())
 	}
 
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
 	res, err := r.M.Core.PostOrganizationTagsWithResponse(ctx,
 		core.PostOrganizationTagsJSONRequestBody{
 			Organization: core.OrganizationLookup{
-				SubDomain: &r.M.confOrganization,
+				SubDomain: &org,
 			},
 			Properties: tagArgs,
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -131,6 +140,7 @@ func (r *TagResource) Create(
 	plan.ID = types.StringPointerValue(id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 
 	if err := r.TagRead(ctx, id, &plan); err != nil {
 		resp.Diagnostics.AddError("Read Error", err.Error())
@@ -139,6 +149,7 @@ func (r *TagResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *TagResource) Read(
@@ -170,6 +181,7 @@ func (r *TagResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *TagResource) Update(
@@ -208,10 +220,12 @@ func (r *TagResource) Update(
 	apiResp, err := r.M.Core.PatchTagWithResponse(ctx, args)
 	if err != nil {
 		if apiResp != nil {
-			err = genericAPIError(err, apiResp.Body)
+			err = genericAPIError(err, apiResp.Body, apiResp.HTTPResponse)
 		}
 
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -221,6 +235,7 @@ func (r *TagResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *TagResource) Delete(
@@ -243,7 +258,7 @@ func (r *TagResource) Delete(
 	)
 	if err != nil {
 		if apiResp != nil {
-			err = genericAPIError(err, apiResp.Body)
+			err = genericAPIError(err, apiResp.Body, apiResp.HTTPResponse)
 		}
 
 		resp.Diagnostics.AddError("Delete Error", err.Error())
@@ -251,12 +266,22 @@ func (r *TagResource) Delete(
 	}
 }
 
+func (r *TagResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the tag.")
+}
+
 func (r *TagResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 }
 
 func (r *TagResource) TagRead(
@@ -269,7 +294,7 @@ func (r *TagResource) TagRead(
 	})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 
 		return err
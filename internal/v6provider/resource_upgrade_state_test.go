@@ -0,0 +1,303 @@
+package v6provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testUpgradeState runs the upgrader of r for version against raw JSON
+// state, decoded against the prior schema the same way the framework does.
+func testUpgradeState(
+	t *testing.T,
+	r resource.ResourceWithUpgradeState,
+	version int64,
+	raw string,
+) *resource.UpgradeStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	require.True(t, ok, "no upgrader for version %d", version)
+	require.NotNil(t, upgrader.PriorSchema)
+
+	priorValue, err := tfprotov6.RawState{JSON: []byte(raw)}.UnmarshalWithOpts(
+		upgrader.PriorSchema.Type().TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{
+			ValueFromJSONOpts: tftypes.ValueFromJSONOpts{
+				IgnoreUndefinedAttributes: true,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	resp := &resource.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw: tftypes.NewValue(
+				schemaResp.Schema.Type().TerraformType(ctx), nil,
+			),
+		},
+	}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(raw)},
+		State: &tfsdk.State{
+			Schema: *upgrader.PriorSchema,
+			Raw:    priorValue,
+		},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+
+	return resp
+}
+
+func TestVersionedResourcesUpgradeEveryPriorVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	for _, factory := range (&KatapultProvider{}).Resources(ctx) {
+		r := factory()
+
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{
+			ProviderTypeName: "katapult",
+		}, &meta)
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		if schemaResp.Schema.Version == 0 {
+			continue
+		}
+
+		t.Run(meta.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			ur, ok := r.(resource.ResourceWithUpgradeState)
+			require.True(t, ok, "versioned resource has no state upgraders")
+
+			upgraders := ur.UpgradeState(ctx)
+			for v := int64(0); v < schemaResp.Schema.Version; v++ {
+				upgrader, ok := upgraders[v]
+				require.True(t, ok, "no upgrader for version %d", v)
+				assert.NotNil(t, upgrader.PriorSchema, "version %d", v)
+			}
+		})
+	}
+}
+
+func TestDiskUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	resp := testUpgradeState(t, &DiskResource{}, 0, `{
+		"id": "disk_one",
+		"name": "data",
+		"size_in_gb": 20,
+		"storage_speed": "ssd",
+		"wwn": "0x5000",
+		"state": "ready"
+	}`)
+
+	var state DiskResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "disk_one", state.ID.ValueString())
+	assert.Equal(t, int64(20), state.SizeInGB.ValueInt64())
+	assert.Equal(t, "ssd", state.StorageSpeed.ValueString())
+	assert.Equal(t, "offline", state.ResizeMethod.ValueString())
+	assert.True(t, state.InitialFileSystem.IsNull())
+	assert.True(t, state.Timeouts.IsNull())
+}
+
+func TestDiskUpgradeStateV0PreservesResizeMethod(t *testing.T) {
+	t.Parallel()
+
+	resp := testUpgradeState(t, &DiskResource{}, 0, `{
+		"id": "disk_one",
+		"name": "data",
+		"size_in_gb": 20,
+		"resize_method": "online",
+		"timeouts": {"update": "4h"}
+	}`)
+
+	var state DiskResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "online", state.ResizeMethod.ValueString())
+	assert.False(t, state.Timeouts.IsNull())
+}
+
+func TestLoadBalancerRuleUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	resp := testUpgradeState(t, &LoadBalancerRuleResource{}, 0, `{
+		"id": "lbrule_one",
+		"load_balancer_id": "lb_one",
+		"destination_port": 8080,
+		"listen_port": 80,
+		"protocol": "HTTP",
+		"check_enabled": true,
+		"check_path": "/healthz"
+	}`)
+
+	var state LoadBalancerRuleResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "lbrule_one", state.ID.ValueString())
+	assert.Equal(t, "round_robin", state.Algorithm.ValueString())
+	assert.Equal(t, types.BoolValue(false), state.ProxyProtocol)
+	assert.Equal(t, types.BoolValue(false), state.BackendSSL)
+	assert.Equal(t, types.BoolValue(false), state.PassthroughSSL)
+	assert.True(t, state.CheckEnabled.ValueBool())
+	assert.Equal(t, "/healthz", state.CheckPath.ValueString())
+	assert.Equal(t, int64(2), state.CheckFall.ValueInt64())
+	assert.Equal(t, int64(20), state.CheckInterval.ValueInt64())
+	assert.Equal(t, "2", state.CheckHTTPStatuses.ValueString())
+	assert.Equal(t, "HTTP", state.CheckProtocol.ValueString())
+	assert.Equal(t, int64(2), state.CheckRise.ValueInt64())
+	assert.Equal(t, int64(5), state.CheckTimeout.ValueInt64())
+	assert.Empty(t, state.CertificateIDs.Elements())
+	assert.False(t, state.CertificateIDs.IsNull())
+}
+
+func TestObjectStorageAccountUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	resp := testUpgradeState(t, &ObjectStorageAccountResource{}, 0, `{
+		"region": "uk-lon-1",
+		"provisioning_state": "provisioned"
+	}`)
+
+	var state ObjectStorageAccountResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "uk-lon-1", state.Region.ValueString())
+	assert.Equal(t, types.BoolValue(false), state.AdoptExisting)
+	assert.Equal(t, "provisioned", state.ProvisioningState.ValueString())
+}
+
+func TestObjectStorageBucketUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	resp := testUpgradeState(t, &ObjectStorageBucketResource{}, 0, `{
+		"name": "assets",
+		"region": "uk-lon-1",
+		"public_url": "https://assets.example.com",
+		"public_read": true,
+		"read_key_ids": ["key_one"]
+	}`)
+
+	var state ObjectStorageBucketResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "assets", state.Name.ValueString())
+	assert.True(t, state.Label.IsNull())
+	assert.True(t, state.PublicRead.ValueBool())
+	assert.Equal(t, types.BoolValue(false), state.PublicList)
+	assert.Equal(t, types.BoolValue(false), state.ServeStaticSite)
+	assert.Equal(t, types.StringValue(""), state.StaticSiteIndex)
+	assert.Equal(t, types.StringValue(""), state.StaticSiteError)
+	assert.Equal(t, types.BoolValue(false), state.AllKeysRead)
+	assert.Equal(t, types.BoolValue(false), state.AllKeysWrite)
+	assert.Equal(t, buildStringSet([]string{"key_one"}), state.ReadKeyIDs)
+	assert.Equal(t, buildStringSet(nil), state.WriteKeyIDs)
+}
+
+func TestObjectStorageAccessKeyUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+
+	resp := testUpgradeState(t, &ObjectStorageAccessKeyResource{}, 0, `{
+		"id": "key_one",
+		"name": "deploy",
+		"region": "uk-lon-1",
+		"all_objects_read": true,
+		"access_key_id": "AKIA",
+		"secret_access_key": "secret",
+		"server_url": "https://uk-lon-1.example.com"
+	}`)
+
+	var state ObjectStorageAccessKeyResourceModel
+	require.False(t, resp.State.Get(context.Background(), &state).HasError())
+	assert.Equal(t, "deploy", state.Name.ValueString())
+	assert.Equal(t, "secret", state.SecretAccessKey.ValueString())
+	assert.True(t, state.AllObjectsRead.ValueBool())
+	assert.Equal(t, types.BoolValue(false), state.AllBucketsRead)
+	assert.Equal(t, types.BoolValue(false), state.AllObjectsWrite)
+	assert.Equal(t, buildStringSet(nil), state.ReadBuckets)
+	assert.Equal(t, buildStringSet(nil), state.WriteBuckets)
+}
+
+func TestVirtualMachineUpgradeStateV0(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resp := testUpgradeState(t, &VirtualMachineResource{}, 0, `{
+		"id": "vm_one",
+		"name": "web-1",
+		"hostname": "web-1",
+		"package": "rock-3",
+		"disk_template": "templates/ubuntu-24-04",
+		"system_disk": {
+			"id": "disk_boot",
+			"name": "System Disk",
+			"size_in_gb": 20,
+			"wwn": "0x5000",
+			"state": "ready"
+		},
+		"ip_address_ids": ["ip_one"],
+		"ip_addresses": ["192.0.2.10"],
+		"network_interfaces": []
+	}`)
+
+	var state VirtualMachineResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.Equal(t, "web-1", state.Name.ValueString())
+	assert.True(t, state.PoweredOn.IsNull())
+	assert.True(t, state.AuthorizedKeyIDs.IsNull())
+	assert.Empty(t, state.Disk.Elements())
+	assert.False(t, state.Disk.IsNull())
+	assert.Equal(t, buildStringSet(nil), state.Tags)
+	assert.Equal(t, buildStringSet(nil), state.VirtualNetworkIDs)
+
+	system, diags := decodeVirtualMachineSystemDisk(ctx, state.SystemDisk)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.Equal(t, "disk_boot", system.ID.ValueString())
+	assert.Equal(t, int64(20), system.SizeInGB.ValueInt64())
+	assert.Equal(t, "offline", system.ResizeMethod.ValueString())
+}
+
+func TestVirtualMachineUpgradeStateV0PreservesLegacyDisks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resp := testUpgradeState(t, &VirtualMachineResource{}, 0, `{
+		"id": "vm_one",
+		"package": "rock-3",
+		"disk_template": "templates/ubuntu-24-04",
+		"disk": [
+			{"name": "System", "size": 20},
+			{"name": null, "size": 50}
+		],
+		"ip_address_ids": ["ip_one"],
+		"tags": ["web"],
+		"removed_attribute": "ignored"
+	}`)
+
+	var state VirtualMachineResourceModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	assert.True(t, state.SystemDisk.IsNull())
+	assert.Equal(t, buildStringSet([]string{"web"}), state.Tags)
+	assert.Equal(t, state.Tags, state.TagsAll)
+
+	var disks []VirtualMachineDiskModel
+	require.False(t, state.Disk.ElementsAs(ctx, &disks, false).HasError())
+	require.Len(t, disks, 2)
+	assert.Equal(t, "System", disks[0].Name.ValueString())
+	assert.True(t, disks[1].Name.IsNull())
+	assert.Equal(t, int64(50), disks[1].Size.ValueInt64())
+}
@@ -50,6 +50,36 @@ type (
 		Package             types.String   `tfsdk:"package"`
 		DiskTemplate        types.String   `tfsdk:"disk_template"`
 		DiskTemplateOptions types.Map      `tfsdk:"disk_template_options"`
+		AuthorizedKeyIDs    types.Set      `tfsdk:"authorized_key_ids"`
+		Disk                types.List     `tfsdk:"disk"`
+		SystemDisk          types.Object   `tfsdk:"system_disk"`
+		IPAddressIDs        types.Set      `tfsdk:"ip_address_ids"`
+		IPAddresses         types.Set      `tfsdk:"ip_addresses"`
+		VirtualNetworkIDs   types.Set      `tfsdk:"virtual_network_ids"`
+		NetworkSpeedProfile types.String   `tfsdk:"network_speed_profile"`
+		NetworkInterfaces   types.List     `tfsdk:"network_interfaces"`
+		Tags                types.Set      `tfsdk:"tags"`
+		TagsAll             types.Set      `tfsdk:"tags_all"`
+		GroupID             types.String   `tfsdk:"group_id"`
+		Organization        types.String   `tfsdk:"organization"`
+		DataCenter          types.String   `tfsdk:"data_center"`
+		Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	}
+
+	// virtualMachineResourceModelV0 is the version 0 state layout, which
+	// predates tags_all and the organization and data_center overrides.
+	virtualMachineResourceModelV0 struct {
+		ID                  types.String   `tfsdk:"id"`
+		Name                types.String   `tfsdk:"name"`
+		Hostname            types.String   `tfsdk:"hostname"`
+		Description         types.String   `tfsdk:"description"`
+		FQDN                types.String   `tfsdk:"fqdn"`
+		State               types.String   `tfsdk:"state"`
+		PoweredOn           types.Bool     `tfsdk:"powered_on"`
+		Package             types.String   `tfsdk:"package"`
+		DiskTemplate        types.String   `tfsdk:"disk_template"`
+		DiskTemplateOptions types.Map      `tfsdk:"disk_template_options"`
+		AuthorizedKeyIDs    types.Set      `tfsdk:"authorized_key_ids"`
 		Disk                types.List     `tfsdk:"disk"`
 		SystemDisk          types.Object   `tfsdk:"system_disk"`
 		IPAddressIDs        types.Set      `tfsdk:"ip_address_ids"`
@@ -66,6 +96,28 @@ type (
 		Name types.String `tfsdk:"name"`
 		Size types.Int64  `tfsdk:"size"`
 	}
+
+	// legacyVirtualMachineResourceModel is the state layout of
+	// katapult_legacy_virtual_machine.
+	legacyVirtualMachineResourceModel struct {
+		ID                  types.String `tfsdk:"id"`
+		Name                types.String `tfsdk:"name"`
+		Hostname            types.String `tfsdk:"hostname"`
+		Description         types.String `tfsdk:"description"`
+		FQDN                types.String `tfsdk:"fqdn"`
+		State               types.String `tfsdk:"state"`
+		Package             types.String `tfsdk:"package"`
+		DiskTemplate        types.String `tfsdk:"disk_template"`
+		DiskTemplateOptions types.Map    `tfsdk:"disk_template_options"`
+		Disk                types.List   `tfsdk:"disk"`
+		IPAddressIDs        types.Set    `tfsdk:"ip_address_ids"`
+		IPAddresses         types.Set    `tfsdk:"ip_addresses"`
+		VirtualNetworkIDs   types.Set    `tfsdk:"virtual_network_ids"`
+		NetworkSpeedProfile types.String `tfsdk:"network_speed_profile"`
+		NetworkInterfaces   types.List   `tfsdk:"network_interfaces"`
+		Tags                types.Set    `tfsdk:"tags"`
+		GroupID             types.String `tfsdk:"group_id"`
+	}
 )
 
 type virtualMachinePackageReader interface {
@@ -86,7 +138,9 @@ const (
 	virtualMachineShutdownActionLabel             = "shutdown"
 	virtualMachineImportDiskTemplatePrivateKey    = "virtual_machine_import_disk_template_v1"
 	virtualMachineImportTemplateOptionsPrivateKey = "virtual_machine_import_disk_template_options_v1"
+	virtualMachineImportAuthorizedKeysPrivateKey  = "virtual_machine_import_authorized_key_ids_v1"
 	virtualMachineLegacyDiskIDsPrivateKey         = "virtual_machine_legacy_disk_ids_v1"
+	virtualMachineMovedLegacyDisksPrivateKey      = "virtual_machine_moved_legacy_disks_v1"
 	virtualMachineSystemDiskResizePrivateKey      = "virtual_machine_system_disk_resize_method_v1"
 )
 
@@ -120,7 +174,12 @@ var vmNetworkInterfaceAttrTypes = map[string]attr.Type{
 	"ip_addresses":       types.SetType{ElemType: types.StringType},
 }
 
-var _ resource.ResourceWithModifyPlan = (*VirtualMachineResource)(nil)
+var (
+	_ resource.ResourceWithModifyPlan   = (*VirtualMachineResource)(nil)
+	_ resource.ResourceWithIdentity     = (*VirtualMachineResource)(nil)
+	_ resource.ResourceWithMoveState    = (*VirtualMachineResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*VirtualMachineResource)(nil)
+)
 
 // vmGroupPatchBody is a custom PATCH body that allows explicitly sending
 // "group": null to clear the VM group, which the SDK struct cannot express
@@ -186,10 +245,12 @@ func (r *VirtualMachineResource) ModifyPlan(
 	var configuredSystem types.Object
 	var configuredDiskTemplate types.String
 	var configuredDiskTemplateOptions types.Map
+	var configuredAuthorizedKeyIDs types.Set
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk"), &configuredLegacy)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("system_disk"), &configuredSystem)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk_template"), &configuredDiskTemplate)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("disk_template_options"), &configuredDiskTemplateOptions)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("authorized_key_ids"), &configuredAuthorizedKeyIDs)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -199,6 +260,14 @@ func (r *VirtualMachineResource) ModifyPlan(
 		resp.Diagnostics.AddError("Conflicting Disk Configuration", "Configure either deprecated disk blocks or system_disk, not both.")
 		return
 	}
+	if r.M != nil {
+		tagsAll, diags := mergeDefaultTags(ctx, plan.Tags, r.M.DefaultTags)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	if req.State.Raw.IsNull() {
 		if err := validateChangedLegacyDiskSizes(ctx, types.List{}, plan.Disk); err != nil {
 			resp.Diagnostics.AddAttributeError(path.Root("disk"), "Invalid Legacy Disk Size", err.Error())
@@ -217,6 +286,7 @@ func (r *VirtualMachineResource) ModifyPlan(
 	}
 	templateImportEligible := false
 	templateOptionsImportEligible := false
+	authorizedKeysImportEligible := false
 	if req.Private != nil {
 		value, diags := req.Private.GetKey(ctx, virtualMachineImportDiskTemplatePrivateKey)
 		resp.Diagnostics.Append(diags...)
@@ -224,6 +294,9 @@ func (r *VirtualMachineResource) ModifyPlan(
 		value, diags = req.Private.GetKey(ctx, virtualMachineImportTemplateOptionsPrivateKey)
 		resp.Diagnostics.Append(diags...)
 		templateOptionsImportEligible = len(value) > 0
+		value, diags = req.Private.GetKey(ctx, virtualMachineImportAuthorizedKeysPrivateKey)
+		resp.Diagnostics.Append(diags...)
+		authorizedKeysImportEligible = len(value) > 0
 		if resp.Diagnostics.HasError() {
 			return
 		}
@@ -302,6 +375,12 @@ func (r *VirtualMachineResource) ModifyPlan(
 		!plan.DiskTemplateOptions.Equal(state.DiskTemplateOptions) {
 		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("disk_template_options"))
 	}
+	if authorizedKeysImportEligible && !configuredAuthorizedKeyIDs.IsNull() && !configuredAuthorizedKeyIDs.IsUnknown() {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, virtualMachineImportAuthorizedKeysPrivateKey, nil)...)
+	} else if !state.AuthorizedKeyIDs.IsNull() && !state.AuthorizedKeyIDs.IsUnknown() &&
+		!plan.AuthorizedKeyIDs.Equal(state.AuthorizedKeyIDs) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("authorized_key_ids"))
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -555,6 +634,7 @@ func (r *VirtualMachineResource) Schema( //nolint:funlen
 	resp *resource.SchemaResponse,
 ) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: virtualMachineMarkdownDescription,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -638,6 +718,17 @@ func (r *VirtualMachineResource) Schema( //nolint:funlen
 				MarkdownDescription: "Options to pass to the Disk " +
 					"Template during creation.",
 			},
+			"authorized_key_ids": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Set of `katapult_ssh_key` IDs to " +
+					"authorize for all users on the Virtual Machine during " +
+					"creation. If not set, every SSH key in the organization " +
+					"is authorized. Changing this replaces the Virtual Machine.",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringValidatorNotEmpty()),
+				},
+			},
 			"system_disk": schema.SingleNestedAttribute{
 				Optional: true,
 				Computed: true,
@@ -769,6 +860,15 @@ func (r *VirtualMachineResource) Schema( //nolint:funlen
 					NullToEmptySetPlanModifier(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
+			dataCenterAttributeName:   dataCenterResourceAttribute(),
+			"tags_all": schema.SetAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Set of tag names assigned to the " +
+					"Virtual Machine, including the provider's " +
+					"`default_tags`.",
+			},
 			"group_id": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -842,9 +942,14 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 		return
 	}
 
+	org := r.M.organization(plan.Organization)
+	dataCenter := r.M.dataCenter(plan.DataCenter)
+	plan.Organization = types.StringValue(org)
+	plan.DataCenter = types.StringValue(dataCenter)
+
 	spec := &buildspec.VirtualMachineSpec{
 		DataCenter: &buildspec.DataCenter{
-			Permalink: r.M.confDataCenter,
+			Permalink: dataCenter,
 		},
 		Hostname: r.M.UseOrGenerateHostname(plan.Hostname.ValueString()),
 		AuthorizedKeys: &buildspec.AuthorizedKeys{
@@ -853,6 +958,18 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 		},
 	}
 
+	authorizedKeyIDs, diags := stringSetValueStrings(
+		ctx, "authorized_key_ids", plan.AuthorizedKeyIDs,
+	)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(authorizedKeyIDs) > 0 {
+		spec.AuthorizedKeys.AllSSHKeys = false
+		spec.AuthorizedKeys.SSHKeys = authorizedKeyIDs
+	}
+
 	if name := plan.Name.ValueString(); name != "" {
 		spec.Name = name
 	}
@@ -866,11 +983,23 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 			req.Config.GetAttribute(ctx, path.Root("tags"), &targetTags)...,
 		)
 	}
-	planTags, diags := stringSetValueStrings(ctx, "tags", targetTags)
+	tagsAll, diags := mergeDefaultTags(ctx, targetTags, r.M.DefaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TagsAll = tagsAll
+	planTags, diags := stringSetValueStrings(ctx, "tags_all", tagsAll)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	if err := r.M.ensureDefaultTags(ctx, org); err != nil {
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
+		return
+	}
 	if len(planTags) > 0 {
 		spec.Tags = planTags
 	}
@@ -988,9 +1117,11 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 			&core.GetIpAddressParams{IpAddressId: &id})
 		if err != nil {
 			if ipRes != nil {
-				err = genericAPIError(err, ipRes.Body)
+				err = genericAPIError(err, ipRes.Body, ipRes.HTTPResponse)
 			}
-			resp.Diagnostics.AddError("Create Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 		if ipRes.JSON200 == nil {
@@ -1075,7 +1206,9 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 
 	xmlBytes, err := spec.XML()
 	if err != nil {
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 	xmlStr := string(xmlBytes)
@@ -1084,15 +1217,17 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 		PostOrganizationVirtualMachinesBuildFromSpecWithResponse(ctx,
 			core.PostOrganizationVirtualMachinesBuildFromSpecJSONRequestBody{
 				Organization: core.OrganizationLookup{
-					SubDomain: &r.M.confOrganization,
+					SubDomain: &org,
 				},
 				Xml: xmlStr,
 			})
 	if err != nil {
 		if buildRes != nil {
-			err = genericAPIError(err, buildRes.Body)
+			err = genericAPIError(err, buildRes.Body, buildRes.HTTPResponse)
 		}
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -1124,7 +1259,7 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 				)
 			if e != nil {
 				if res != nil {
-					e = genericAPIError(e, res.Body)
+					e = genericAPIError(e, res.Body, res.HTTPResponse)
 				}
 				return nil, "", e
 			}
@@ -1189,9 +1324,11 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 			})
 		if e != nil {
 			if patchRes != nil {
-				e = genericAPIError(e, patchRes.Body)
+				e = genericAPIError(e, patchRes.Body, patchRes.HTTPResponse)
 			}
-			resp.Diagnostics.AddError("Create Error", e.Error())
+			addAPIError(
+				&resp.Diagnostics, "Create Error", e, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1214,7 +1351,7 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 				})
 			if e != nil {
 				if res != nil {
-					e = genericAPIError(e, res.Body)
+					e = genericAPIError(e, res.Body, res.HTTPResponse)
 				}
 				return nil, "", e
 			}
@@ -1256,7 +1393,9 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 			return
 		}
 		if err = patchDiskName(ctx, r.M, *bootDisk.Id, systemDisk.Name.ValueString()); err != nil {
-			resp.Diagnostics.AddError("Create Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1267,7 +1406,9 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 		if err = reconcileVirtualMachinePowerState(
 			ctx, r.M, vmID, false, timeout,
 		); err != nil {
-			resp.Diagnostics.AddError("Create Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1294,6 +1435,7 @@ func (r *VirtualMachineResource) Create( //nolint:funlen,gocyclo
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *VirtualMachineResource) Read(
@@ -1307,7 +1449,8 @@ func (r *VirtualMachineResource) Read(
 		return
 	}
 
-	err := r.vmRead(ctx, &state)
+	var attachments []core.GetVirtualMachineDisks200ResponseDisks
+	err := r.vmReadWithAttachments(ctx, &state, &attachments)
 	if err != nil {
 		if errors.Is(err, core.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -1316,8 +1459,15 @@ func (r *VirtualMachineResource) Read(
 		resp.Diagnostics.AddError("Read Error", err.Error())
 		return
 	}
+	if resp.Private != nil {
+		if err := adoptMovedLegacyDisks(ctx, resp.Private, attachments); err != nil {
+			resp.Diagnostics.AddError("Read Error", err.Error())
+			return
+		}
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 //nolint:lll // Preserve complete operator-facing update diagnostics.
@@ -1406,7 +1556,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 		if err := reconcileVirtualMachinePowerState(
 			coupledCtx, r.M, vmID, false, timeout,
 		); err != nil {
-			resp.Diagnostics.AddError("Update Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1420,7 +1572,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 			timeout,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError("Update Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1454,14 +1608,18 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 		}
 		if nameChanged {
 			if err := patchDiskName(coupledCtx, r.M, diskID, plannedSystemDisk.Name.ValueString()); err != nil {
-				resp.Diagnostics.AddError("Update Error", err.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+				)
 				return
 			}
 		}
 		if sizeChanged {
 			vmState, err := fetchVirtualMachineState(coupledCtx, r.M, vmID)
 			if err != nil {
-				resp.Diagnostics.AddError("Update Error", err.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+				)
 				return
 			}
 			if vmState != core.Started && vmState != core.Stopped {
@@ -1501,7 +1659,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 				} else {
 					resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 				}
-				resp.Diagnostics.AddError("Update Error", err.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+				)
 				return
 			}
 		}
@@ -1530,12 +1690,30 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 			req.Config.GetAttribute(ctx, path.Root("tags"), &targetTags)...,
 		)
 	}
-	if !targetTags.IsUnknown() && !targetTags.Equal(state.Tags) {
-		tags, diags := stringSetValueStrings(ctx, "tags", targetTags)
+	targetTagsAll, diags := mergeDefaultTags(ctx, targetTags, r.M.DefaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// State written before tags_all existed, and not yet refreshed, only
+	// records the configured tags.
+	priorTagsAll := state.TagsAll
+	if priorTagsAll.IsNull() {
+		priorTagsAll = state.Tags
+	}
+	if !targetTagsAll.IsUnknown() && !targetTagsAll.Equal(priorTagsAll) {
+		tags, diags := stringSetValueStrings(ctx, "tags_all", targetTagsAll)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		err := r.M.ensureDefaultTags(ctx, r.M.organization(plan.Organization))
+		if err != nil {
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
+			return
+		}
 		args.TagNames = &tags
 	}
 
@@ -1598,9 +1776,11 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 		)
 		if err != nil {
 			if patchRes != nil {
-				err = genericAPIError(err, patchRes.Body)
+				err = genericAPIError(err, patchRes.Body, patchRes.HTTPResponse)
 			}
-			resp.Diagnostics.AddError("Update Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1634,7 +1814,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 		removeIDs := stringsDiff(stateIDs, targetIDs)
 
 		if err := allocateIPsToVM(ctx, r.M, vmID, addIDs); err != nil {
-			resp.Diagnostics.AddError("Update Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 
@@ -1645,7 +1827,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 					IpAddress: core.IPAddressLookup{Id: &id},
 				})
 			if e != nil && !errors.Is(e, core.ErrNotFound) {
-				resp.Diagnostics.AddError("Update Error", e.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", e, resp.State.Schema.Type(),
+				)
 				return
 			}
 		}
@@ -1673,7 +1857,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 
 		ifaces, err := fetchAllVMNetworkInterfaces(ctx, r.M, vmID)
 		if err != nil {
-			resp.Diagnostics.AddError("Update Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 
@@ -1717,7 +1903,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 			if e := addVirtualNetworkToVM(
 				ctx, r.M, vmID, vnID, nsp, timeout,
 			); e != nil {
-				resp.Diagnostics.AddError("Update Error", e.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", e, resp.State.Schema.Type(),
+				)
 				return
 			}
 		}
@@ -1726,7 +1914,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 			if e := attachVMNetworkInterface(
 				ctx, r.M, ifaceID, timeout,
 			); e != nil {
-				resp.Diagnostics.AddError("Update Error", e.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", e, resp.State.Schema.Type(),
+				)
 				return
 			}
 		}
@@ -1754,7 +1944,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 			if e := removeVMNetworkInterface(
 				ctx, r.M, ifaceID, timeout,
 			); e != nil {
-				resp.Diagnostics.AddError("Update Error", e.Error())
+				addAPIError(
+					&resp.Diagnostics, "Update Error", e, resp.State.Schema.Type(),
+				)
 				return
 			}
 		}
@@ -1766,7 +1958,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 		if e := updateVMNetworkSpeedProfile(
 			ctx, r.M, vmID, permalink, timeout,
 		); e != nil {
-			resp.Diagnostics.AddError("Update Error", e.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", e, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1775,7 +1969,9 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 		if err := reconcileVirtualMachinePowerState(
 			coupledCtx, r.M, vmID, true, timeout,
 		); err != nil {
-			resp.Diagnostics.AddError("Update Error", err.Error())
+			addAPIError(
+				&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+			)
 			return
 		}
 	}
@@ -1792,6 +1988,7 @@ func (r *VirtualMachineResource) Update( //nolint:funlen,gocyclo
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 //nolint:lll // Preserve complete relationship ownership diagnostics.
@@ -1867,7 +2064,7 @@ func (r *VirtualMachineResource) Delete( //nolint:funlen,gocyclo
 			return
 		}
 		if vmRes != nil {
-			err = genericAPIError(err, vmRes.Body)
+			err = genericAPIError(err, vmRes.Body, vmRes.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
@@ -1897,7 +2094,7 @@ func (r *VirtualMachineResource) Delete( //nolint:funlen,gocyclo
 			})
 		if e != nil {
 			if stopRes != nil {
-				e = genericAPIError(e, stopRes.Body)
+				e = genericAPIError(e, stopRes.Body, stopRes.HTTPResponse)
 			}
 			if !isErrNotFoundOrInTrash(e, nil) {
 				resp.Diagnostics.AddError(
@@ -1980,7 +2177,7 @@ func (r *VirtualMachineResource) Delete( //nolint:funlen,gocyclo
 		if isErrNotFoundOrInTrash(err, delRes.JSON406) {
 			deleteReturnedInTrash = true
 		} else {
-			err = genericAPIError(err, delRes.Body)
+			err = genericAPIError(err, delRes.Body, delRes.HTTPResponse)
 			resp.Diagnostics.AddError(
 				"Delete Error",
 				fmt.Sprintf("failed to delete VM: %s", err),
@@ -2032,14 +2229,396 @@ func (r *VirtualMachineResource) Delete( //nolint:funlen,gocyclo
 	}
 }
 
+func (r *VirtualMachineResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the Virtual Machine.")
+}
+
 func (r *VirtualMachineResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, virtualMachineImportDiskTemplatePrivateKey, []byte("true"))...)
 	resp.Diagnostics.Append(resp.Private.SetKey(ctx, virtualMachineImportTemplateOptionsPrivateKey, []byte("true"))...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, virtualMachineImportAuthorizedKeysPrivateKey, []byte("true"))...)
+}
+
+// UpgradeState migrates version 0 state, which older releases wrote without
+// every attribute of the current layout.
+func (r *VirtualMachineResource) UpgradeState(
+	ctx context.Context,
+) map[int64]resource.StateUpgrader {
+	stringSet := schema.SetAttribute{
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                    schema.StringAttribute{Computed: true},
+					"name":                  schema.StringAttribute{Optional: true},
+					"hostname":              schema.StringAttribute{Optional: true},
+					"description":           schema.StringAttribute{Optional: true},
+					"fqdn":                  schema.StringAttribute{Computed: true},
+					"state":                 schema.StringAttribute{Computed: true},
+					"powered_on":            schema.BoolAttribute{Optional: true},
+					"package":               schema.StringAttribute{Required: true},
+					"disk_template":         schema.StringAttribute{Optional: true},
+					"disk_template_options": schema.MapAttribute{Optional: true, ElementType: types.StringType},
+					"authorized_key_ids":    stringSet,
+					"system_disk": schema.SingleNestedAttribute{
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"id":               schema.StringAttribute{Computed: true},
+							"name":             schema.StringAttribute{Optional: true},
+							"size_in_gb":       schema.Int64Attribute{Optional: true},
+							"resize_method":    schema.StringAttribute{Optional: true},
+							"wwn":              schema.StringAttribute{Computed: true},
+							stateAttributeName: schema.StringAttribute{Computed: true},
+						},
+					},
+					"ip_address_ids":        stringSet,
+					"ip_addresses":          stringSet,
+					"virtual_network_ids":   stringSet,
+					"network_speed_profile": schema.StringAttribute{Optional: true},
+					"network_interfaces": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id":                 schema.StringAttribute{Computed: true},
+								"network_id":         schema.StringAttribute{Computed: true},
+								"virtual_network_id": schema.StringAttribute{Computed: true},
+								"mac_address":        schema.StringAttribute{Computed: true},
+								"ip_addresses":       stringSet,
+							},
+						},
+					},
+					"tags":     stringSet,
+					"group_id": schema.StringAttribute{Optional: true},
+				},
+				Blocks: map[string]schema.Block{
+					"timeouts": timeouts.Block(ctx, timeouts.Opts{
+						Create: true,
+						Update: true,
+						Delete: true,
+					}),
+					"disk": schema.ListNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"name":                          schema.StringAttribute{Optional: true},
+								virtualMachineDiskSizeAttribute: schema.Int64Attribute{Required: true},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: upgradeVirtualMachineStateV0,
+		},
+	}
+}
+
+func upgradeVirtualMachineStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var prior virtualMachineResourceModelV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := VirtualMachineResourceModel{
+		ID:                  prior.ID,
+		Name:                prior.Name,
+		Hostname:            prior.Hostname,
+		Description:         prior.Description,
+		FQDN:                prior.FQDN,
+		State:               prior.State,
+		PoweredOn:           prior.PoweredOn,
+		Package:             prior.Package,
+		DiskTemplate:        prior.DiskTemplate,
+		DiskTemplateOptions: prior.DiskTemplateOptions,
+		AuthorizedKeyIDs:    prior.AuthorizedKeyIDs,
+		Disk:                prior.Disk,
+		SystemDisk:          prior.SystemDisk,
+		IPAddressIDs:        prior.IPAddressIDs,
+		IPAddresses:         prior.IPAddresses,
+		VirtualNetworkIDs:   prior.VirtualNetworkIDs,
+		NetworkSpeedProfile: prior.NetworkSpeedProfile,
+		NetworkInterfaces:   prior.NetworkInterfaces,
+		Tags:                prior.Tags,
+		GroupID:             prior.GroupID,
+		Timeouts:            prior.Timeouts,
+	}
+
+	// State written before system_disk gained resize_method lacks the
+	// default the current schema would plan.
+	if !state.SystemDisk.IsNull() {
+		system, diags := decodeVirtualMachineSystemDisk(ctx, state.SystemDisk)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if system.ResizeMethod.IsNull() {
+			system.ResizeMethod = types.StringValue("offline")
+		}
+		state.SystemDisk, diags = virtualMachineSystemDiskValue(ctx, system)
+		resp.Diagnostics.Append(diags...)
+	}
+	if state.Disk.IsNull() {
+		state.Disk = types.ListValueMust(
+			state.Disk.ElementType(ctx), []attr.Value{},
+		)
+	}
+	if state.VirtualNetworkIDs.IsNull() {
+		state.VirtualNetworkIDs = types.SetValueMust(
+			types.StringType, []attr.Value{},
+		)
+	}
+	if state.Tags.IsNull() {
+		state.Tags = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	state.TagsAll = state.Tags
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// MoveState moves katapult_legacy_virtual_machine state to
+// katapult_virtual_machine. Legacy disk blocks are carried over unchanged so
+// they can later be migrated to system_disk, katapult_disk and
+// katapult_disk_assignment. The legacy resource never tracked disk
+// identities, so ownership is recorded on the first refresh after the move.
+func (r *VirtualMachineResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"name":        schema.StringAttribute{Optional: true},
+					"hostname":    schema.StringAttribute{Optional: true},
+					"description": schema.StringAttribute{Optional: true},
+					"fqdn":        schema.StringAttribute{Computed: true},
+					"state":       schema.StringAttribute{Computed: true},
+					"package":     schema.StringAttribute{Required: true},
+					"disk_template": schema.StringAttribute{
+						Required: true,
+					},
+					"disk_template_options": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"disk": schema.ListNestedAttribute{
+						Optional: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{Optional: true},
+								"size": schema.Int64Attribute{Required: true},
+							},
+						},
+					},
+					"ip_address_ids": schema.SetAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+					},
+					"ip_addresses": schema.SetAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+					},
+					"virtual_network_ids": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"network_speed_profile": schema.StringAttribute{
+						Optional: true,
+					},
+					"network_interfaces": schema.ListNestedAttribute{
+						Computed: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id":                 schema.StringAttribute{Computed: true},
+								"network_id":         schema.StringAttribute{Computed: true},
+								"virtual_network_id": schema.StringAttribute{Computed: true},
+								"mac_address":        schema.StringAttribute{Computed: true},
+								"ip_addresses": schema.SetAttribute{
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
+					"tags": schema.SetAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+					"group_id": schema.StringAttribute{Optional: true},
+				},
+			},
+			StateMover: moveLegacyVirtualMachineState,
+		},
+	}
+}
+
+func moveLegacyVirtualMachineState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+) {
+	if !movedFromLegacy(req, "katapult_legacy_virtual_machine") {
+		return
+	}
+
+	var prior legacyVirtualMachineResourceModel
+	if !getLegacySourceState(ctx, req, resp, &prior) {
+		return
+	}
+
+	disk, diags := moveLegacyVirtualMachineDisks(ctx, prior.Disk)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := VirtualMachineResourceModel{
+		ID:                  prior.ID,
+		Name:                prior.Name,
+		Hostname:            prior.Hostname,
+		Description:         prior.Description,
+		FQDN:                prior.FQDN,
+		State:               prior.State,
+		PoweredOn:           types.BoolNull(),
+		Package:             prior.Package,
+		DiskTemplate:        prior.DiskTemplate,
+		DiskTemplateOptions: prior.DiskTemplateOptions,
+		AuthorizedKeyIDs:    types.SetNull(types.StringType),
+		Disk:                disk,
+		SystemDisk:          types.ObjectNull(virtualMachineSystemDiskAttrTypes),
+		IPAddressIDs:        prior.IPAddressIDs,
+		IPAddresses:         prior.IPAddresses,
+		VirtualNetworkIDs:   prior.VirtualNetworkIDs,
+		NetworkSpeedProfile: prior.NetworkSpeedProfile,
+		NetworkInterfaces:   prior.NetworkInterfaces,
+		Tags:                prior.Tags,
+		GroupID:             prior.GroupID,
+		Timeouts: timeouts.Value{
+			Object: types.ObjectNull(map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			}),
+		},
+	}
+	// SDKv2 stores an empty map where the framework expects null, which
+	// would otherwise plan a replacement.
+	if len(state.DiskTemplateOptions.Elements()) == 0 {
+		state.DiskTemplateOptions = types.MapNull(types.StringType)
+	}
+	if state.VirtualNetworkIDs.IsNull() {
+		state.VirtualNetworkIDs = types.SetValueMust(
+			types.StringType, []attr.Value{},
+		)
+	}
+	if state.NetworkInterfaces.IsNull() {
+		state.NetworkInterfaces = types.ListValueMust(
+			types.ObjectType{AttrTypes: vmNetworkInterfaceAttrTypes},
+			[]attr.Value{},
+		)
+	}
+	if state.Tags.IsNull() {
+		state.Tags = types.SetValueMust(types.StringType, []attr.Value{})
+	}
+	state.TagsAll = state.Tags
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+	resp.Diagnostics.Append(
+		setIDIdentity(ctx, resp.TargetIdentity, state.ID)...,
+	)
+	if len(disk.Elements()) > 0 && resp.TargetPrivate != nil {
+		resp.Diagnostics.Append(resp.TargetPrivate.SetKey(
+			ctx, virtualMachineMovedLegacyDisksPrivateKey, []byte("true"),
+		)...)
+	}
+}
+
+// moveLegacyVirtualMachineDisks converts legacy disk blocks to the deprecated
+// disk blocks of katapult_virtual_machine. SDKv2 stores an omitted disk name
+// as an empty string, which is moved as null to match configuration.
+func moveLegacyVirtualMachineDisks(
+	ctx context.Context,
+	prior types.List,
+) (types.List, diag.Diagnostics) {
+	var disks []VirtualMachineDiskModel
+	if !prior.IsNull() {
+		diags := prior.ElementsAs(ctx, &disks, false)
+		if diags.HasError() {
+			return types.ListNull(prior.ElementType(ctx)), diags
+		}
+	}
+
+	for i := range disks {
+		if disks[i].Name.ValueString() == "" {
+			disks[i].Name = types.StringNull()
+		}
+	}
+	if disks == nil {
+		disks = []VirtualMachineDiskModel{}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name":                          types.StringType,
+			virtualMachineDiskSizeAttribute: types.Int64Type,
+		},
+	}, disks)
+}
+
+// adoptMovedLegacyDisks records the disks attached to a Virtual Machine moved
+// from katapult_legacy_virtual_machine as owned by its deprecated disk
+// blocks, as Create does for new Virtual Machines.
+func adoptMovedLegacyDisks(
+	ctx context.Context,
+	private interface {
+		GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+		SetKey(context.Context, string, []byte) diag.Diagnostics
+	},
+	attachments []core.GetVirtualMachineDisks200ResponseDisks,
+) error {
+	moved, diags := private.GetKey(ctx, virtualMachineMovedLegacyDisksPrivateKey)
+	if diags.HasError() {
+		return fmt.Errorf("reading moved disk state: %s", diags)
+	}
+	if len(moved) == 0 {
+		return nil
+	}
+
+	ids, err := virtualMachineDiskAttachmentIDs(attachments)
+	if err != nil {
+		return fmt.Errorf("cannot record deprecated disk ownership: %w", err)
+	}
+	encoded, err := json.Marshal(ids)
+	if err != nil {
+		return err
+	}
+	diags = private.SetKey(ctx, virtualMachineLegacyDiskIDsPrivateKey, encoded)
+	diags.Append(private.SetKey(ctx, virtualMachineMovedLegacyDisksPrivateKey, nil)...)
+	if diags.HasError() {
+		return fmt.Errorf("recording deprecated disk ownership: %s", diags)
+	}
+
+	return nil
 }
 
 func virtualMachineDiskAttachmentIDs(
@@ -2142,7 +2721,7 @@ func (r *VirtualMachineResource) vmReadWithAttachments(
 			return core.ErrNotFound
 		}
 		if vmRes != nil {
-			err = genericAPIError(err, vmRes.Body)
+			err = genericAPIError(err, vmRes.Body, vmRes.HTTPResponse)
 		}
 		return err
 	}
@@ -2302,13 +2881,12 @@ func (r *VirtualMachineResource) vmReadWithAttachments(
 		types.StringType, vnetIDs,
 	)
 
-	tagVals := make([]attr.Value, 0)
+	tagNames := []string{}
 	if vm.TagNames != nil {
-		for _, t := range *vm.TagNames {
-			tagVals = append(tagVals, types.StringValue(t))
-		}
+		tagNames = *vm.TagNames
 	}
-	model.Tags = types.SetValueMust(types.StringType, tagVals)
+	model.Tags = splitDefaultTags(ctx, model.Tags, tagNames, r.M.DefaultTags)
+	model.TagsAll = buildStringSet(tagNames)
 
 	niList, err := buildVMNetworkInterfaceList(ifaces)
 	if err != nil {
@@ -2460,7 +3038,7 @@ func queueVirtualMachinePowerAction(
 		)
 		if actionErr != nil {
 			if res != nil {
-				actionErr = genericAPIError(actionErr, res.Body)
+				actionErr = genericAPIError(actionErr, res.Body, res.HTTPResponse)
 			}
 			return "", action, fmt.Errorf(
 				"failed to queue start for virtual machine %s: %w",
@@ -2485,7 +3063,7 @@ func queueVirtualMachinePowerAction(
 	)
 	if actionErr != nil {
 		if res != nil {
-			actionErr = genericAPIError(actionErr, res.Body)
+			actionErr = genericAPIError(actionErr, res.Body, res.HTTPResponse)
 		}
 		return "", action, fmt.Errorf(
 			"failed to queue graceful shutdown for virtual machine %s: %w",
@@ -2514,7 +3092,7 @@ func fetchVirtualMachineState(
 	)
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return "", err
 	}
@@ -2754,7 +3332,7 @@ func virtualMachineForPackageValidation(
 			return nil, nil
 		}
 		if vmRes != nil {
-			err = genericAPIError(err, vmRes.Body)
+			err = genericAPIError(err, vmRes.Body, vmRes.HTTPResponse)
 		}
 
 		return nil, fmt.Errorf(
@@ -2782,7 +3360,7 @@ func virtualMachinePackageForValidation(
 	)
 	if err != nil {
 		if pkgRes != nil {
-			err = genericAPIError(err, pkgRes.Body)
+			err = genericAPIError(err, pkgRes.Body, pkgRes.HTTPResponse)
 		}
 
 		return nil, fmt.Errorf("failed to fetch new package details: %w", err)
@@ -2807,7 +3385,7 @@ func changeVirtualMachinePackage(
 	)
 	if err != nil {
 		if vmRes != nil {
-			err = genericAPIError(err, vmRes.Body)
+			err = genericAPIError(err, vmRes.Body, vmRes.HTTPResponse)
 		}
 
 		return fmt.Errorf("failed to fetch virtual machine details: %w", err)
@@ -2833,7 +3411,7 @@ func changeVirtualMachinePackage(
 	)
 	if err != nil {
 		if changeRes != nil {
-			err = genericAPIError(err, changeRes.Body)
+			err = genericAPIError(err, changeRes.Body, changeRes.HTTPResponse)
 		}
 
 		return fmt.Errorf("failed to change virtual machine package: %w", err)
@@ -3046,7 +3624,7 @@ func fetchAllVMNetworkInterfaces(
 		)
 		if err != nil {
 			if resp != nil {
-				return nil, genericAPIError(err, resp.Body)
+				return nil, genericAPIError(err, resp.Body, resp.HTTPResponse)
 			}
 			return nil, err
 		}
@@ -3106,7 +3684,7 @@ func getVMNetworkInterface(
 			if res.StatusCode() == http.StatusNotFound {
 				return nil, core.ErrNotFound
 			}
-			return nil, genericAPIError(err, res.Body)
+			return nil, genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return nil, err
 	}
@@ -3142,7 +3720,7 @@ func addVirtualNetworkToVM(
 		)
 	if err != nil {
 		if createResp != nil {
-			return genericAPIError(err, createResp.Body)
+			return genericAPIError(err, createResp.Body, createResp.HTTPResponse)
 		}
 		return err
 	}
@@ -3174,7 +3752,7 @@ func attachVMNetworkInterface(
 		)
 	if err != nil {
 		if attachResp != nil {
-			return genericAPIError(err, attachResp.Body)
+			return genericAPIError(err, attachResp.Body, attachResp.HTTPResponse)
 		}
 		return err
 	}
@@ -3223,7 +3801,7 @@ func removeVMNetworkInterface(
 			if delResp.StatusCode() == http.StatusNotFound {
 				return nil
 			}
-			return genericAPIError(err, delResp.Body)
+			return genericAPIError(err, delResp.Body, delResp.HTTPResponse)
 		}
 		return err
 	}
@@ -3257,7 +3835,7 @@ func detachVMNetworkInterface(
 					"virtual_machine_network_interface_not_attached" {
 				return nil
 			}
-			return genericAPIError(err, detachResp.Body)
+			return genericAPIError(err, detachResp.Body, detachResp.HTTPResponse)
 		}
 		return err
 	}
@@ -3292,7 +3870,7 @@ func allocateIPsToVM(
 			&core.GetIpAddressParams{IpAddressId: &id})
 		if err != nil {
 			if ipRes != nil {
-				err = genericAPIError(err, ipRes.Body)
+				err = genericAPIError(err, ipRes.Body, ipRes.HTTPResponse)
 			}
 			return err
 		}
@@ -3342,7 +3920,7 @@ func allocateIPsToVM(
 			)
 		if err != nil {
 			if resp != nil {
-				return genericAPIError(err, resp.Body)
+				return genericAPIError(err, resp.Body, resp.HTTPResponse)
 			}
 			return err
 		}
@@ -3392,7 +3970,7 @@ func updateVMNetworkSpeedProfile(
 						core.SpeedProfileAlreadyAssigned {
 					continue
 				}
-				return genericAPIError(err, res.Body)
+				return genericAPIError(err, res.Body, res.HTTPResponse)
 			}
 			return err
 		}
@@ -3464,7 +4042,7 @@ func addVMUniqueHostnameSuffix(
 		})
 	if err != nil {
 		if patchRes != nil {
-			err = genericAPIError(err, patchRes.Body)
+			err = genericAPIError(err, patchRes.Body, patchRes.HTTPResponse)
 		}
 		return "", err
 	}
@@ -14,12 +14,24 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var (
+	_ resource.ResourceWithIdentity  = (*VirtualMachineGroupResource)(nil)
+	_ resource.ResourceWithMoveState = (*VirtualMachineGroupResource)(nil)
+)
+
 type (
 	VirtualMachineGroupResource struct {
 		M *Meta
 	}
 
 	VirtualMachineGroupResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		Name         types.String `tfsdk:"name"`
+		Segregate    types.Bool   `tfsdk:"segregate"`
+		Organization types.String `tfsdk:"organization"`
+	}
+
+	legacyVirtualMachineGroupResourceModel struct {
 		ID        types.String `tfsdk:"id"`
 		Name      types.String `tfsdk:"name"`
 		Segregate types.Bool   `tfsdk:"segregate"`
@@ -87,6 +99,7 @@ func (r *VirtualMachineGroupResource) Schema(
 					"machines, providing hardware-level isolation for " +
 					"improved availability. Defaults to `true`.",
 			},
+			organizationAttributeName: organizationResourceAttribute(),
 		},
 	}
 }
@@ -103,10 +116,12 @@ func (r *VirtualMachineGroupResource) Create(
 	}
 
 	segregate := plan.Segregate.ValueBool()
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
 	res, err := r.M.Core.PostOrganizationVirtualMachineGroupsWithResponse(ctx,
 		core.PostOrganizationVirtualMachineGroupsJSONRequestBody{
 			Organization: core.OrganizationLookup{
-				SubDomain: &r.M.confOrganization,
+				SubDomain: &org,
 			},
 			Properties: core.VirtualMachineGroupArguments{
 				Name:      plan.Name.ValueStringPointer(),
@@ -115,9 +130,11 @@ func (r *VirtualMachineGroupResource) Create(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
-		resp.Diagnostics.AddError("Create Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Create Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -125,6 +142,7 @@ func (r *VirtualMachineGroupResource) Create(
 	plan.ID = types.StringPointerValue(vmg.Id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 
 	if err := r.vmgRead(ctx, vmg.Id, &plan); err != nil {
 		resp.Diagnostics.AddError("Read Error", err.Error())
@@ -132,6 +150,7 @@ func (r *VirtualMachineGroupResource) Create(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *VirtualMachineGroupResource) Read(
@@ -156,6 +175,7 @@ func (r *VirtualMachineGroupResource) Read(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *VirtualMachineGroupResource) Update(
@@ -193,9 +213,11 @@ func (r *VirtualMachineGroupResource) Update(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
-		resp.Diagnostics.AddError("Update Error", err.Error())
+		addAPIError(
+			&resp.Diagnostics, "Update Error", err, resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -205,6 +227,7 @@ func (r *VirtualMachineGroupResource) Update(
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *VirtualMachineGroupResource) Delete(
@@ -226,19 +249,78 @@ func (r *VirtualMachineGroupResource) Delete(
 		})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		resp.Diagnostics.AddError("Delete Error", err.Error())
 		return
 	}
 }
 
+func (r *VirtualMachineGroupResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the virtual machine group.")
+}
+
 func (r *VirtualMachineGroupResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
+}
+
+// MoveState moves katapult_legacy_virtual_machine_group state to
+// katapult_virtual_machine_group.
+func (r *VirtualMachineGroupResource) MoveState(
+	_ context.Context,
+) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			SourceSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":        schema.StringAttribute{Computed: true},
+					"name":      schema.StringAttribute{Required: true},
+					"segregate": schema.BoolAttribute{Optional: true},
+				},
+			},
+			StateMover: moveLegacyVirtualMachineGroupState,
+		},
+	}
+}
+
+func moveLegacyVirtualMachineGroupState(
+	ctx context.Context,
+	req resource.MoveStateRequest,
+	resp *resource.MoveStateResponse,
+) {
+	if !movedFromLegacy(req, "katapult_legacy_virtual_machine_group") {
+		return
+	}
+
+	var prior legacyVirtualMachineGroupResourceModel
+	if !getLegacySourceState(ctx, req, resp, &prior) {
+		return
+	}
+
+	state := VirtualMachineGroupResourceModel{
+		ID:           prior.ID,
+		Name:         prior.Name,
+		Segregate:    prior.Segregate,
+		Organization: types.StringNull(),
+	}
+	if state.Segregate.IsNull() {
+		state.Segregate = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, state)...)
+	resp.Diagnostics.Append(
+		setIDIdentity(ctx, resp.TargetIdentity, state.ID)...,
+	)
 }
 
 func (r *VirtualMachineGroupResource) vmgRead(
@@ -255,7 +337,7 @@ func (r *VirtualMachineGroupResource) vmgRead(
 			return err
 		}
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
@@ -689,6 +689,107 @@ func TestVirtualMachineResourceCreateShutdownFailureKeepsCheckpointedID(
 	require.Equal(t, "vm_checkpoint", got.ID.ValueString())
 }
 
+func TestVirtualMachineResourceCreateAuthorizesConfiguredKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		keyIDs   types.Set
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "all keys by default",
+			keyIDs:   types.SetNull(types.StringType),
+			contains: []string{`<SSHKeys all="yes">`},
+			excludes: []string{"<SSHKey>"},
+		},
+		{
+			name: "configured keys",
+			keyIDs: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("key_a"),
+				types.StringValue("key_b"),
+			}),
+			contains: []string{
+				`<Users all="yes">`,
+				"<SSHKey>key_a</SSHKey>",
+				"<SSHKey>key_b</SSHKey>",
+			},
+			excludes: []string{`<SSHKeys all="yes">`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var spec string
+			client := newVirtualMachineTestClient(t, func(
+				w http.ResponseWriter,
+				r *http.Request,
+			) {
+				switch {
+				case r.Method == http.MethodGet &&
+					r.URL.Path == "/ip_addresses/ip_address":
+					writeTestJSON(w, http.StatusOK, `{
+						"ip_address": {
+							"id": "ip_test",
+							"network": {"id": "net_test"}
+						}
+					}`)
+				case r.Method == http.MethodPost &&
+					r.URL.Path ==
+						"/organizations/organization/virtual_machines/build_from_spec":
+					var body core.PostOrganizationVirtualMachinesBuildFromSpecJSONRequestBody
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+					spec = body.Xml
+
+					writeTestJSON(w, http.StatusUnprocessableEntity, `{
+						"error": {
+							"code": "validation_error",
+							"description": "Stop after build request"
+						}
+					}`)
+				default:
+					http.NotFound(w, r)
+				}
+			})
+			resource := &VirtualMachineResource{M: &Meta{
+				Core:             client,
+				confDataCenter:   "test-dc",
+				confOrganization: "test-org",
+				testMode:         true,
+			}}
+			model := VirtualMachineResourceModel{
+				Hostname:          types.StringValue("keys-vm"),
+				Package:           types.StringValue("rock-3"),
+				DiskTemplate:      types.StringValue("ubuntu-18-04"),
+				AuthorizedKeyIDs:  tt.keyIDs,
+				IPAddressIDs:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ip_test")}),
+				VirtualNetworkIDs: types.SetValueMust(types.StringType, nil),
+				Tags:              types.SetValueMust(types.StringType, nil),
+			}
+			plan := virtualMachineTestState(t, resource, model)
+			resp := frameworkresource.CreateResponse{State: tfsdk.State{
+				Schema: plan.Schema,
+			}}
+
+			resource.Create(context.Background(), frameworkresource.CreateRequest{
+				Config: tfsdk.Config(plan),
+				Plan:   tfsdk.Plan(plan),
+			}, &resp)
+
+			require.True(t, resp.Diagnostics.HasError())
+			for _, want := range tt.contains {
+				require.Contains(t, spec, want)
+			}
+			for _, unwanted := range tt.excludes {
+				require.NotContains(t, spec, unwanted)
+			}
+		})
+	}
+}
+
 func TestVirtualMachineGroupDataSourceNotFoundDiagnostic(t *testing.T) {
 	t.Parallel()
 
@@ -1578,6 +1679,20 @@ func TestVirtualMachineModifyPlanLeavesProjectionsUnknownOnReplacement(t *testin
 				config.DiskTemplateOptions = plan.DiskTemplateOptions
 			},
 		},
+		{
+			name: "authorized key ids",
+			change: func(state, plan, config *VirtualMachineResourceModel) {
+				state.AuthorizedKeyIDs = types.SetValueMust(
+					types.StringType,
+					[]attr.Value{types.StringValue("key_old")},
+				)
+				plan.AuthorizedKeyIDs = types.SetValueMust(
+					types.StringType,
+					[]attr.Value{types.StringValue("key_new")},
+				)
+				config.AuthorizedKeyIDs = plan.AuthorizedKeyIDs
+			},
+		},
 		{
 			name: "legacy disk",
 			change: func(state, plan, config *VirtualMachineResourceModel) {
@@ -1878,6 +1993,7 @@ func TestVirtualMachineImportMarksTemplateFieldsAdoptable(t *testing.T) {
 	for _, key := range []string{
 		virtualMachineImportDiskTemplatePrivateKey,
 		virtualMachineImportTemplateOptionsPrivateKey,
+		virtualMachineImportAuthorizedKeysPrivateKey,
 	} {
 		value, privateDiags := resp.Private.GetKey(context.Background(), key)
 		require.False(t, privateDiags.HasError(), privateDiags.Errors())
@@ -1894,6 +2010,9 @@ func virtualMachineTestState(
 	if model.DiskTemplateOptions.IsNull() {
 		model.DiskTemplateOptions = types.MapNull(types.StringType)
 	}
+	if model.AuthorizedKeyIDs.IsNull() {
+		model.AuthorizedKeyIDs = types.SetNull(types.StringType)
+	}
 	if model.Disk.IsNull() {
 		model.Disk = types.ListNull(types.ObjectType{
 			AttrTypes: map[string]attr.Type{
@@ -1922,6 +2041,9 @@ func virtualMachineTestState(
 	if model.Tags.IsNull() {
 		model.Tags = types.SetNull(types.StringType)
 	}
+	if model.TagsAll.IsNull() {
+		model.TagsAll = types.SetNull(types.StringType)
+	}
 	if len(model.Timeouts.AttributeTypes(context.Background())) == 0 {
 		model.Timeouts = resourcetimeouts.Value{Object: types.ObjectNull(
 			map[string]attr.Type{
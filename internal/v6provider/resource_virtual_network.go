@@ -3,6 +3,7 @@ package v6provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +14,8 @@ import (
 	"github.com/krystal/go-katapult/next/core"
 )
 
+var _ resource.ResourceWithIdentity = (*VirtualNetworkResource)(nil)
+
 type VirtualNetworkResource struct {
 	M *Meta
 }
@@ -21,6 +24,8 @@ type VirtualNetworkResourceModel struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
 	DataCenterID types.String `tfsdk:"data_center_id"`
+	Organization types.String `tfsdk:"organization"`
+	DataCenter   types.String `tfsdk:"data_center"`
 }
 
 func (r *VirtualNetworkResource) Metadata(
@@ -83,10 +88,27 @@ func (r *VirtualNetworkResource) Schema(
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			organizationAttributeName: organizationResourceAttribute(),
+			dataCenterAttributeName:   virtualNetworkDataCenterAttribute(),
 		},
 	}
 }
 
+// virtualNetworkDataCenterAttribute is the data_center argument of
+// katapult_virtual_network, an alternative to data_center_id.
+func virtualNetworkDataCenterAttribute() schema.StringAttribute {
+	attr := dataCenterResourceAttribute()
+	attr.MarkdownDescription = "Permalink of the data center to create " +
+		"the virtual network in when `data_center_id` is not set. " +
+		"Defaults to the provider's `data_center`. Changing this " +
+		"replaces the resource; removing it keeps the resource where it is."
+	attr.Validators = append(attr.Validators,
+		stringvalidator.ConflictsWith(path.MatchRoot("data_center_id")),
+	)
+
+	return attr
+}
+
 func (r *VirtualNetworkResource) Create(
 	ctx context.Context,
 	req resource.CreateRequest,
@@ -103,13 +125,21 @@ func (r *VirtualNetworkResource) Create(
 	dcID := plan.DataCenterID.ValueString()
 	if dcID != "" {
 		dcLookup.Id = &dcID
+		if plan.DataCenter.IsUnknown() {
+			plan.DataCenter = types.StringNull()
+		}
 	} else {
-		dcLookup.Permalink = &r.M.confDataCenter
+		dataCenter := r.M.dataCenter(plan.DataCenter)
+		plan.DataCenter = types.StringValue(dataCenter)
+		dcLookup.Permalink = &dataCenter
 	}
 
+	org := r.M.organization(plan.Organization)
+	plan.Organization = types.StringValue(org)
+
 	params := core.PostOrganizationVirtualNetworksJSONRequestBody{
 		Organization: core.OrganizationLookup{
-			SubDomain: &r.M.confOrganization,
+			SubDomain: &org,
 		},
 		DataCenter: dcLookup,
 		Properties: core.VirtualNetworkArguments{
@@ -121,7 +151,14 @@ func (r *VirtualNetworkResource) Create(
 		params,
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating virtual network", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Error creating virtual network", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
@@ -135,6 +172,7 @@ func (r *VirtualNetworkResource) Create(
 
 	assignVirtualNetworkFields(&res.JSON200.VirtualNetwork, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *VirtualNetworkResource) Read(
@@ -154,17 +192,25 @@ func (r *VirtualNetworkResource) Read(
 		ctx, &core.GetVirtualNetworkParams{VirtualNetworkId: &id},
 	)
 	if err != nil {
-		if res.JSON404 != nil {
+		if res != nil && res.JSON404 != nil {
 			resp.State.RemoveResource(ctx)
 			return
 		}
 
-		resp.Diagnostics.AddError("Error reading virtual network", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Error reading virtual network", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	assignVirtualNetworkFields(&res.JSON200.VirtualNetwork, &state)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 func (r *VirtualNetworkResource) Update(
@@ -189,12 +235,20 @@ func (r *VirtualNetworkResource) Update(
 
 	res, err := r.M.Core.PatchVirtualNetworkWithResponse(ctx, params)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating virtual network", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Error updating virtual network", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 
 	assignVirtualNetworkFields(&res.JSON200.VirtualNetwork, &plan)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *VirtualNetworkResource) Delete(
@@ -208,7 +262,7 @@ func (r *VirtualNetworkResource) Delete(
 		return
 	}
 
-	_, err := r.M.Core.DeleteVirtualNetworkWithResponse(
+	res, err := r.M.Core.DeleteVirtualNetworkWithResponse(
 		ctx, core.DeleteVirtualNetworkJSONRequestBody{
 			VirtualNetwork: core.VirtualNetworkLookup{
 				Id: state.ID.ValueStringPointer(),
@@ -216,17 +270,34 @@ func (r *VirtualNetworkResource) Delete(
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting virtual network", err.Error())
+		if res != nil {
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
+		}
+
+		addAPIError(
+			&resp.Diagnostics, "Error deleting virtual network", err,
+			resp.State.Schema.Type(),
+		)
 		return
 	}
 }
 
+func (r *VirtualNetworkResource) IdentitySchema(
+	_ context.Context,
+	_ resource.IdentitySchemaRequest,
+	resp *resource.IdentitySchemaResponse,
+) {
+	resp.IdentitySchema = idIdentitySchema("The ID of the virtual network.")
+}
+
 func (r *VirtualNetworkResource) ImportState(
 	ctx context.Context,
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(
+		ctx, path.Root("id"), path.Root("id"), req, resp,
+	)
 }
 
 func assignVirtualNetworkFields(
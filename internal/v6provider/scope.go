@@ -0,0 +1,149 @@
+package v6provider
+
+import (
+	"context"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resources, data sources and list resources can override the organization
+// and data center set on the provider, so one provider configuration can
+// manage objects across several of them.
+const (
+	organizationAttributeName = "organization"
+	dataCenterAttributeName   = "data_center"
+)
+
+// organization returns the organization sub-domain in override, falling
+// back to the provider's organization when it is not set.
+func (m *Meta) organization(override types.String) string {
+	if v := override.ValueString(); v != "" {
+		return v
+	}
+
+	return m.confOrganization
+}
+
+// dataCenter returns the data center permalink in override, falling back to
+// the provider's data center when it is not set.
+func (m *Meta) dataCenter(override types.String) string {
+	if v := override.ValueString(); v != "" {
+		return v
+	}
+
+	return m.confDataCenter
+}
+
+// organizationResourceAttribute returns the organization argument of
+// resources created within an organization.
+func organizationResourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		MarkdownDescription: "Sub-domain of the organization to create " +
+			"the resource in. Defaults to the provider's `organization`. " +
+			"Changing this replaces the resource; removing it keeps the " +
+			"resource where it is.",
+		Validators: []validator.String{
+			stringValidatorNotEmpty(),
+		},
+		PlanModifiers: []planmodifier.String{
+			ScopeOverridePlanModifier(),
+		},
+	}
+}
+
+// dataCenterResourceAttribute returns the data_center argument of resources
+// created within a data center.
+func dataCenterResourceAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		MarkdownDescription: "Permalink of the data center to create the " +
+			"resource in. Defaults to the provider's `data_center`. " +
+			"Changing this replaces the resource; removing it keeps the " +
+			"resource where it is.",
+		Validators: []validator.String{
+			stringValidatorNotEmpty(),
+		},
+		PlanModifiers: []planmodifier.String{
+			ScopeOverridePlanModifier(),
+		},
+	}
+}
+
+// organizationDataSourceAttribute returns the organization argument of data
+// sources which look up objects within an organization.
+func organizationDataSourceAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Optional: true,
+		MarkdownDescription: "Sub-domain of the organization to look up " +
+			"in. Defaults to the provider's `organization`.",
+		Validators: []validator.String{
+			stringValidatorNotEmpty(),
+		},
+	}
+}
+
+// organizationListAttribute is the list resource counterpart of
+// organizationDataSourceAttribute.
+func organizationListAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional: true,
+		MarkdownDescription: "Sub-domain of the organization to list " +
+			"from. Defaults to the provider's `organization`.",
+		Validators: []validator.String{
+			stringValidatorNotEmpty(),
+		},
+	}
+}
+
+// ScopeOverridePlanModifier plans the organization and data_center
+// arguments of a resource. Unconfigured, they keep the value recorded when
+// the resource was created, so changing the provider's defaults does not
+// move existing resources. Configured, a change from the recorded value
+// replaces the resource. State without a recorded value, written before the
+// argument existed or by an import, adopts the configured value in place.
+func ScopeOverridePlanModifier() planmodifier.String {
+	return scopeOverridePlanModifier{}
+}
+
+type scopeOverridePlanModifier struct{}
+
+func (scopeOverridePlanModifier) Description(_ context.Context) string {
+	return "Keeps the recorded value when unconfigured and requires " +
+		"replacement when a configured value differs from it."
+}
+
+func (m scopeOverridePlanModifier) MarkdownDescription(
+	ctx context.Context,
+) string {
+	return m.Description(ctx)
+}
+
+func (scopeOverridePlanModifier) PlanModifyString(
+	_ context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	// Create fills in the value, and there is nothing to plan on destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	if req.StateValue.IsNull() || req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	resp.RequiresReplace = true
+}
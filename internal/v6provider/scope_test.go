@@ -0,0 +1,111 @@
+package v6provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetaScopeOverrides(t *testing.T) {
+	t.Parallel()
+
+	m := &Meta{confOrganization: "acme", confDataCenter: "uk-lon-01"}
+
+	assert.Equal(t, "acme", m.organization(types.StringNull()))
+	assert.Equal(t, "acme", m.organization(types.StringUnknown()))
+	assert.Equal(t, "other", m.organization(types.StringValue("other")))
+	assert.Equal(t, "uk-lon-01", m.dataCenter(types.StringNull()))
+	assert.Equal(t, "nl-ams-01", m.dataCenter(types.StringValue("nl-ams-01")))
+}
+
+func TestScopeOverridePlanModifier(t *testing.T) {
+	t.Parallel()
+
+	object := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+	null := tftypes.NewValue(tftypes.Object{}, nil)
+
+	tests := []struct {
+		name        string
+		stateRaw    tftypes.Value
+		planRaw     tftypes.Value
+		config      types.String
+		plan        types.String
+		state       types.String
+		wantPlan    types.String
+		wantReplace bool
+	}{
+		{
+			name:     "create",
+			stateRaw: null,
+			planRaw:  object,
+			config:   types.StringNull(),
+			plan:     types.StringUnknown(),
+			state:    types.StringNull(),
+			wantPlan: types.StringUnknown(),
+		},
+		{
+			name:     "unconfigured keeps recorded value",
+			stateRaw: object,
+			planRaw:  object,
+			config:   types.StringNull(),
+			plan:     types.StringUnknown(),
+			state:    types.StringValue("acme"),
+			wantPlan: types.StringValue("acme"),
+		},
+		{
+			name:     "configured matches recorded value",
+			stateRaw: object,
+			planRaw:  object,
+			config:   types.StringValue("acme"),
+			plan:     types.StringValue("acme"),
+			state:    types.StringValue("acme"),
+			wantPlan: types.StringValue("acme"),
+		},
+		{
+			name:     "configured on state without a recorded value",
+			stateRaw: object,
+			planRaw:  object,
+			config:   types.StringValue("acme"),
+			plan:     types.StringValue("acme"),
+			state:    types.StringNull(),
+			wantPlan: types.StringValue("acme"),
+		},
+		{
+			name:        "configured change",
+			stateRaw:    object,
+			planRaw:     object,
+			config:      types.StringValue("other"),
+			plan:        types.StringValue("other"),
+			state:       types.StringValue("acme"),
+			wantPlan:    types.StringValue("other"),
+			wantReplace: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+			ScopeOverridePlanModifier().PlanModifyString(
+				context.Background(),
+				planmodifier.StringRequest{
+					State:       tfsdk.State{Raw: tt.stateRaw},
+					Plan:        tfsdk.Plan{Raw: tt.planRaw},
+					ConfigValue: tt.config,
+					PlanValue:   tt.plan,
+					StateValue:  tt.state,
+				},
+				resp,
+			)
+
+			assert.Equal(t, tt.wantPlan, resp.PlanValue)
+			assert.Equal(t, tt.wantReplace, resp.RequiresReplace)
+		})
+	}
+}
@@ -12,7 +12,7 @@ func TestMain(m *testing.M) {
 
 func sweepMeta() *Meta {
 	meta, err := NewMeta("", "", "", nil, "",
-		testAccResourceNamePrefix, nil, "", "")
+		testAccResourceNamePrefix, nil, ConnectionConfig{}, "", "")
 	if err != nil {
 		return nil
 	}
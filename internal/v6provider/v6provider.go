@@ -1,25 +1,33 @@
 package v6provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
 	"reflect"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-retryablehttp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/krystal/terraform-provider-katapult/internal/httpconfig"
 )
 
 const (
@@ -34,15 +42,32 @@ type (
 		HTTPClient *http.Client
 
 		GeneratedNamePrefix string
-		m                   *Meta
+		// TestMode disables retries and state change delays, for replaying
+		// recorded API interactions.
+		TestMode bool
+		m        *Meta
 	}
 
 	KatapultProviderModel struct {
-		APIKey               types.String `tfsdk:"api_key"`
-		Organization         types.String `tfsdk:"organization"`
-		DataCenter           types.String `tfsdk:"data_center"`
-		SkipTrashObjectPurge types.Bool   `tfsdk:"skip_trash_object_purge"`
-		LogLevel             types.String `tfsdk:"log_level"`
+		APIKey               types.String  `tfsdk:"api_key"`
+		Organization         types.String  `tfsdk:"organization"`
+		DataCenter           types.String  `tfsdk:"data_center"`
+		SkipTrashObjectPurge types.Bool    `tfsdk:"skip_trash_object_purge"`
+		LogLevel             types.String  `tfsdk:"log_level"`
+		APIURL               types.String  `tfsdk:"api_url"`
+		CACertFile           types.String  `tfsdk:"ca_cert_file"`
+		ProxyURL             types.String  `tfsdk:"proxy_url"`
+		InsecureSkipVerify   types.Bool    `tfsdk:"insecure_skip_verify"`
+		MaxRetries           types.Int64   `tfsdk:"max_retries"`
+		RetryWaitMin         types.String  `tfsdk:"retry_wait_min"`
+		RetryWaitMax         types.String  `tfsdk:"retry_wait_max"`
+		RequestsPerSecond    types.Float64 `tfsdk:"requests_per_second"`
+		RetryErrorCodes      types.List    `tfsdk:"retry_error_codes"`
+		DefaultTags          types.List    `tfsdk:"default_tags"`
+	}
+
+	KatapultProviderDefaultTagsModel struct {
+		Tags types.Set `tfsdk:"tags"`
 	}
 )
 
@@ -138,6 +163,105 @@ Skip purging deleted resources from Katapult's trash when they are destroyed by
 					"`KATAPULT_LOG_LEVEL` environment variable. " +
 					"Defaults to `info`.",
 			},
+			"api_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Base URL of the Katapult API, without a " +
+					"path. Can be specified with the `KATAPULT_API_URL` " +
+					"environment variable. Defaults to " +
+					"`https://api.katapult.io`.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Path to a PEM encoded file of certificate " +
+					"authorities trusted, in addition to the system " +
+					"roots, when connecting to the API. Can be specified " +
+					"with the `KATAPULT_CA_CERT_FILE` environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "URL of a proxy to send API requests through. " +
+					"Can be specified with the `KATAPULT_PROXY_URL` " +
+					"environment variable. When not set, the standard " +
+					"`HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` " +
+					"environment variables are used.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Skip verification of the API's TLS " +
+					"certificate. Only intended for development against " +
+					"local APIs. Can be specified with the " +
+					"`KATAPULT_INSECURE_SKIP_VERIFY` environment variable. " +
+					"Defaults to `false`.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Number of times a failed API request is " +
+					"retried. Can be specified with the " +
+					"`KATAPULT_MAX_RETRIES` environment variable. " +
+					"Defaults to `10`.",
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Minimum time to wait between retries of a " +
+					"failed API request, as a duration such as `1s`. Can " +
+					"be specified with the `KATAPULT_RETRY_WAIT_MIN` " +
+					"environment variable. Defaults to `1s`.",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Maximum time to wait between retries of a " +
+					"failed API request, as a duration such as `2m`. Also " +
+					"caps waits requested by the API through " +
+					"`Retry-After` headers. Can be specified with the " +
+					"`KATAPULT_RETRY_WAIT_MAX` environment variable. " +
+					"Defaults to `2m`.",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+				MarkdownDescription: "Maximum number of API requests made per " +
+					"second, shared across all concurrent resource " +
+					"operations. Can be specified with the " +
+					"`KATAPULT_REQUESTS_PER_SECOND` environment variable. " +
+					"Defaults to `0`, which disables the limit.",
+			},
+			"retry_error_codes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "API error codes, such as " +
+					"`temporary_failure`, on which a failed API request is " +
+					"retried, in addition to rate limited requests, server " +
+					"errors and connection failures. Can be specified as a " +
+					"comma-separated list with the " +
+					"`KATAPULT_RETRY_ERROR_CODES` environment variable.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.ListNestedBlock{
+				MarkdownDescription: "Tags applied to every taggable " +
+					"resource managed by this provider, in addition to " +
+					"the tags configured on the resource. Tags which do " +
+					"not exist in the organization are created " +
+					"automatically.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tags": schema.SetAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							MarkdownDescription: "Set of tag names to " +
+								"apply to every taggable resource.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -209,6 +333,8 @@ func (k *KatapultProvider) Configure(
 	if k.m != nil {
 		resp.ResourceData = k.m
 		resp.DataSourceData = k.m
+		resp.EphemeralResourceData = k.m
+		resp.ListResourceData = k.m
 		return
 	}
 
@@ -216,6 +342,15 @@ func (k *KatapultProvider) Configure(
 	diags := req.Config.Get(ctx, &conf)
 	resp.Diagnostics.Append(diags...)
 
+	var retryErrorCodes []string
+	if !conf.RetryErrorCodes.IsNull() && !conf.RetryErrorCodes.IsUnknown() {
+		diags = conf.RetryErrorCodes.ElementsAs(ctx, &retryErrorCodes, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	m, err := NewMeta(
 		conf.APIKey.ValueString(),
 		conf.DataCenter.ValueString(),
@@ -224,6 +359,19 @@ func (k *KatapultProvider) Configure(
 		conf.LogLevel.ValueString(),
 		k.GeneratedNamePrefix,
 		k.HTTPClient,
+		ConnectionConfig{
+			APIURL:             conf.APIURL.ValueString(),
+			CACertFile:         conf.CACertFile.ValueString(),
+			ProxyURL:           conf.ProxyURL.ValueString(),
+			InsecureSkipVerify: conf.InsecureSkipVerify.ValueBoolPointer(),
+			Retry: httpconfig.RetrySettings{
+				MaxRetries:        conf.MaxRetries.ValueInt64Pointer(),
+				RetryWaitMin:      conf.RetryWaitMin.ValueString(),
+				RetryWaitMax:      conf.RetryWaitMax.ValueString(),
+				RequestsPerSecond: conf.RequestsPerSecond.ValueFloat64Pointer(),
+				RetryErrorCodes:   retryErrorCodes,
+			},
+		},
 		k.Version,
 		req.TerraformVersion,
 	)
@@ -235,9 +383,25 @@ func (k *KatapultProvider) Configure(
 		return
 	}
 
+	defaultTags, diags := providerDefaultTags(ctx, conf.DefaultTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	m.DefaultTags = defaultTags
+
+	if k.TestMode {
+		m.testMode = true
+		m.retryClient.RetryMax = 0
+		m.retryClient.RetryWaitMin = 0
+		m.retryClient.RetryWaitMax = 0
+	}
+
 	k.m = m
 	resp.ResourceData = m
 	resp.DataSourceData = m
+	resp.EphemeralResourceData = m
+	resp.ListResourceData = m
 }
 
 func (k *KatapultProvider) Resources(
@@ -246,6 +410,8 @@ func (k *KatapultProvider) Resources(
 	return []func() resource.Resource{
 		func() resource.Resource { return &AddressListEntryResource{} },
 		func() resource.Resource { return &AddressListResource{} },
+		func() resource.Resource { return &DNSRecordResource{} },
+		func() resource.Resource { return &DNSZoneResource{} },
 		func() resource.Resource { return &FileStorageVolumeResource{} },
 		func() resource.Resource { return &IPResource{} },
 		func() resource.Resource { return &LoadBalancerResource{} },
@@ -257,8 +423,47 @@ func (k *KatapultProvider) Resources(
 		func() resource.Resource { return &ObjectStorageAccessKeyResource{} },
 		func() resource.Resource { return &VirtualMachineGroupResource{} },
 		func() resource.Resource { return &DiskResource{} },
+		func() resource.Resource { return &DiskBackupPolicyResource{} },
 		func() resource.Resource { return &DiskAssignmentResource{} },
 		func() resource.Resource { return &VirtualMachineResource{} },
+		func() resource.Resource { return &SecurityGroupResource{} },
+		func() resource.Resource { return &SecurityGroupRuleResource{} },
+		func() resource.Resource { return &SSHKeyResource{} },
+	}
+}
+
+func (k *KatapultProvider) EphemeralResources(
+	_ context.Context,
+) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		func() ephemeral.EphemeralResource {
+			return &ObjectStorageAccessKeyEphemeralResource{}
+		},
+		func() ephemeral.EphemeralResource {
+			return &VirtualMachineInitialPasswordEphemeralResource{}
+		},
+	}
+}
+
+func (k *KatapultProvider) ListResources(
+	_ context.Context,
+) []func() list.ListResource {
+	return []func() list.ListResource{
+		func() list.ListResource { return &VirtualMachineListResource{} },
+		func() list.ListResource { return &DiskListResource{} },
+		func() list.ListResource { return &IPListResource{} },
+		func() list.ListResource { return &LoadBalancerListResource{} },
+	}
+}
+
+func (k *KatapultProvider) Functions(
+	_ context.Context,
+) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return &BuildImportIDFunction{} },
+		func() function.Function { return &CIDRForIPFunction{} },
+		func() function.Function { return &HostnameToFQDNFunction{} },
+		func() function.Function { return &ParseDiskAssignmentIDFunction{} },
 	}
 }
 
@@ -270,10 +475,18 @@ func (k *KatapultProvider) DataSources(
 		func() datasource.DataSource { return &AddressListEntriesDataSource{} },
 		func() datasource.DataSource { return &AddressListEntryDataSource{} },
 		func() datasource.DataSource { return &AddressListsDataSource{} },
+		func() datasource.DataSource { return &CertificateDataSource{} },
+		func() datasource.DataSource { return &CertificatesDataSource{} },
+		func() datasource.DataSource { return &DataCenterDataSource{} },
 		func() datasource.DataSource { return &DiskDataSource{} },
+		func() datasource.DataSource { return &DiskBackupPoliciesDataSource{} },
 		func() datasource.DataSource { return &DiskIOProfileDataSource{} },
 		func() datasource.DataSource { return &DiskIOProfilesDataSource{} },
+		func() datasource.DataSource { return &DiskTemplateDataSource{} },
+		func() datasource.DataSource { return &DiskTemplatesDataSource{} },
 		func() datasource.DataSource { return &DisksDataSource{} },
+		func() datasource.DataSource { return &DNSRecordsDataSource{} },
+		func() datasource.DataSource { return &DNSZonesDataSource{} },
 		func() datasource.DataSource { return &FileStorageVolumeDataSource{} },
 		func() datasource.DataSource { return &GlobalAddressListsDataSource{} },
 		func() datasource.DataSource { return &FileStorageVolumesDataSource{} },
@@ -283,6 +496,10 @@ func (k *KatapultProvider) DataSources(
 		func() datasource.DataSource { return &LoadBalancerRulesDataSource{} },
 		func() datasource.DataSource { return &LoadBalancersDataSource{} },
 		func() datasource.DataSource { return &NetworkDataSource{} },
+		func() datasource.DataSource { return &NetworkSpeedProfileDataSource{} },
+		func() datasource.DataSource {
+			return &NetworkSpeedProfilesDataSource{}
+		},
 		func() datasource.DataSource { return &NetworksDataSource{} },
 		func() datasource.DataSource { return &ObjectStorageAccountDataSource{} },
 		func() datasource.DataSource { return &ObjectStorageBucketDataSource{} },
@@ -290,6 +507,7 @@ func (k *KatapultProvider) DataSources(
 		func() datasource.DataSource { return &VirtualNetworksDataSource{} },
 		func() datasource.DataSource { return &TagDataSource{} },
 		func() datasource.DataSource { return &TagsDataSource{} },
+		func() datasource.DataSource { return &TaggedObjectsDataSource{} },
 		func() datasource.DataSource {
 			return &VirtualMachineGroupDataSource{}
 		},
@@ -298,6 +516,12 @@ func (k *KatapultProvider) DataSources(
 		},
 		func() datasource.DataSource { return &VirtualMachineDataSource{} },
 		func() datasource.DataSource { return &VirtualMachineDisksDataSource{} },
+		func() datasource.DataSource {
+			return &VirtualMachinePackageDataSource{}
+		},
+		func() datasource.DataSource {
+			return &VirtualMachinePackagesDataSource{}
+		},
 		func() datasource.DataSource { return &VirtualMachinesDataSource{} },
 	}
 }
@@ -305,27 +529,42 @@ func (k *KatapultProvider) DataSources(
 func newRetryableHTTPClient(
 	httpClient *http.Client,
 	logger hclog.Logger,
+	retry httpconfig.Retry,
 ) *retryablehttp.Client {
 	client := retryablehttp.NewClient()
 	client.HTTPClient = httpClient
 	client.Logger = logger
 
-	client.RetryWaitMin = 1 * time.Second
-	client.RetryWaitMax = 2 * time.Minute
-	client.RetryMax = 10
-	client.CheckRetry = requestRetryPolicy
+	retry.Apply(client)
+	client.CheckRetry = requestRetryPolicy(retry)
 
 	return client
 }
 
-func requestRetryPolicy(
-	ctx context.Context,
-	resp *http.Response,
-	err error,
-) (bool, error) {
-	if resp == nil || resp.StatusCode == http.StatusTooManyRequests {
-		return true, err
-	}
+func requestRetryPolicy(retry httpconfig.Retry) retryablehttp.CheckRetry {
+	return func(
+		ctx context.Context,
+		resp *http.Response,
+		err error,
+	) (bool, error) {
+		if resp == nil || resp.StatusCode == http.StatusTooManyRequests {
+			return true, err
+		}
 
-	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+		if len(retry.ErrorCodes) > 0 && resp.StatusCode >= 400 {
+			body, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+			if readErr != nil {
+				return true, readErr
+			}
+
+			apiErr := parseGenericAPIError(body)
+			if apiErr != nil && retry.RetryableErrorCode(apiErr.Code) {
+				return true, nil
+			}
+		}
+
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
 }
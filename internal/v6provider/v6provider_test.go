@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -27,6 +28,7 @@ import (
 	"github.com/jimeh/rands/randsmust"
 
 	"github.com/krystal/go-katapult/next/core"
+	"github.com/krystal/terraform-provider-katapult/internal/httpconfig"
 	v5provider "github.com/krystal/terraform-provider-katapult/internal/provider"
 	"github.com/krystal/terraform-provider-katapult/internal/vcrtest"
 	"github.com/stretchr/testify/assert"
@@ -129,7 +131,7 @@ func newTestTools(t *testing.T) *testTools {
 	}
 
 	meta, err := NewMeta("", "", "", nil, "",
-		testAccResourceNamePrefix, httpClient, "", "")
+		testAccResourceNamePrefix, httpClient, ConnectionConfig{}, "", "")
 	require.NoError(t, err)
 
 	if r != nil && r.Mode() == recorder.ModeReplaying {
@@ -339,7 +341,6 @@ func testDataFilePath(t *testing.T, suffix string) string {
 	return filepath.Join(".", "testdata", baseName)
 }
 
-//nolint:unused // will be used eventually
 func exampleResourceConfig(t *testing.T, name string) string {
 	t.Helper()
 
@@ -495,3 +496,104 @@ func TestProvider(t *testing.T) {
 	pf().Schema(context.Background(), provider.SchemaRequest{}, resp)
 	require.False(t, resp.Diagnostics.HasError())
 }
+
+func TestConnectionConfigInsecureSkipVerify(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *bool
+		env    string
+		want   bool
+	}{
+		{
+			name: "unset",
+			want: false,
+		},
+		{
+			name: "unset with env",
+			env:  "true",
+			want: true,
+		},
+		{
+			name:   "true",
+			config: ptr(true),
+			want:   true,
+		},
+		{
+			name:   "false overrides env",
+			config: ptr(false),
+			env:    "true",
+			want:   false,
+		},
+		{
+			name:   "true overrides env",
+			config: ptr(true),
+			env:    "false",
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("KATAPULT_INSECURE_SKIP_VERIFY", tt.env)
+
+			c := ConnectionConfig{InsecureSkipVerify: tt.config}
+
+			assert.Equal(t, tt.want, c.httpConfig().InsecureSkipVerify)
+		})
+	}
+}
+
+func TestRequestRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := requestRetryPolicy(httpconfig.Retry{
+		ErrorCodes: []string{"temporary_failure"},
+	})
+
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   bool
+	}{
+		{
+			name:   "rate limited",
+			status: http.StatusTooManyRequests,
+			want:   true,
+		},
+		{
+			name:   "configured error code",
+			status: http.StatusConflict,
+			body:   `{"error":{"code":"temporary_failure"}}`,
+			want:   true,
+		},
+		{
+			name:   "other error code",
+			status: http.StatusUnprocessableEntity,
+			body:   `{"error":{"code":"validation_error"}}`,
+		},
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{
+				StatusCode: tt.status,
+				Body:       io.NopCloser(strings.NewReader(tt.body)),
+			}
+
+			retry, err := policy(context.Background(), resp, nil)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, retry)
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			assert.Equal(t, tt.body, string(body))
+		})
+	}
+}
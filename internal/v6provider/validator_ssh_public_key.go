@@ -0,0 +1,103 @@
+package v6provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = sshPublicKeyValidator{}
+
+// sshPublicKeyTypes lists the key types accepted in authorized_keys format.
+var sshPublicKeyTypes = map[string]struct{}{
+	"ssh-rsa":                            {},
+	"ssh-dss":                            {},
+	"ssh-ed25519":                        {},
+	"ecdsa-sha2-nistp256":                {},
+	"ecdsa-sha2-nistp384":                {},
+	"ecdsa-sha2-nistp521":                {},
+	"sk-ssh-ed25519@openssh.com":         {},
+	"sk-ecdsa-sha2-nistp256@openssh.com": {},
+}
+
+// sshPublicKeyValidator validates that a string is a single OpenSSH public
+// key in authorized_keys format.
+type sshPublicKeyValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v sshPublicKeyValidator) Description(_ context.Context) string {
+	return "value must be an OpenSSH public key, e.g. \"ssh-ed25519 AAAA...\""
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sshPublicKeyValidator) MarkdownDescription(
+	ctx context.Context,
+) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v sshPublicKeyValidator) ValidateString(
+	ctx context.Context,
+	request validator.StringRequest,
+	response *validator.StringResponse,
+) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if validSSHPublicKey(value) {
+		return
+	}
+
+	response.Diagnostics.Append(
+		validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			v.Description(ctx),
+			value,
+		),
+	)
+}
+
+// validSSHPublicKey checks the key type is known and that the base64 blob
+// decodes and is tagged with the same key type.
+func validSSHPublicKey(value string) bool {
+	value = strings.TrimSpace(value)
+	if strings.ContainsAny(value, "\r\n") {
+		return false
+	}
+
+	fields := strings.Fields(value)
+	if len(fields) < 2 {
+		return false
+	}
+
+	keyType := fields[0]
+	if _, ok := sshPublicKeyTypes[keyType]; !ok {
+		return false
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil || len(blob) < 4 {
+		return false
+	}
+
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(n) {
+		return false
+	}
+
+	return string(blob[4:4+n]) == keyType
+}
+
+// stringValidatorSSHPublicKey returns a validator that ensures a string is an
+// OpenSSH public key.
+func stringValidatorSSHPublicKey() validator.String {
+	return sshPublicKeyValidator{}
+}
@@ -117,7 +117,7 @@ func fetchVirtualMachineBootDiskWithAttachments(
 	res, err := m.Core.GetDiskWithResponse(ctx, &core.GetDiskParams{DiskId: &diskID})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		// A missing sub-resource must not be mistaken for the VM itself being
 		// absent by VirtualMachineResource.Read.
@@ -180,7 +180,7 @@ func fetchDiskTemplateReferences(ctx context.Context, m *Meta, diskID string) ([
 	res, err := m.Core.GetDiskWithResponse(ctx, &core.GetDiskParams{DiskId: &diskID})
 	if err != nil {
 		if res != nil {
-			err = genericAPIError(err, res.Body)
+			err = genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return nil, err
 	}
@@ -214,7 +214,7 @@ func patchDiskName(ctx context.Context, m *Meta, diskID, name string) error {
 	})
 	if err != nil {
 		if res != nil {
-			return genericAPIError(err, res.Body)
+			return genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
@@ -232,7 +232,7 @@ func resizeDiskAndWait(ctx context.Context, m *Meta, diskID string, size int64,
 	})
 	if err != nil {
 		if res != nil {
-			return genericAPIError(err, res.Body)
+			return genericAPIError(err, res.Body, res.HTTPResponse)
 		}
 		return err
 	}
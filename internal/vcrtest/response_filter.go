@@ -13,6 +13,7 @@ const redactedValue = "[REDACTED]"
 var sensitiveResponseFields = map[string]struct{}{
 	"backend_certificate_key": {},
 	"initial_root_password":   {},
+	"private_key":             {},
 }
 
 // RedactSensitiveResponseFields removes secret string values from JSON API
@@ -18,7 +18,7 @@ var (
 		`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----`,
 	)
 	sensitiveStringFieldPattern = regexp.MustCompile(
-		`"(backend_certificate_key|initial_root_password)"\s*:\s*"([^"]*)"`,
+		`"(backend_certificate_key|initial_root_password|private_key)"\s*:\s*"([^"]*)"`,
 	)
 	protectedValueBeforeFlagPattern = regexp.MustCompile(
 		`(?s)"value"\s*:\s*"([^"]*)"[^{}]*"protect"\s*:\s*true`,
@@ -38,6 +38,10 @@ func TestRedactSensitiveResponseFields(t *testing.T) {
 					"backend_certificate_key": "private key",
 					"name": "example"
 				},
+				"certificate": {
+					"certificate": "public certificate",
+					"private_key": "private key"
+				},
 				"virtual_machines": [
 					{"initial_root_password": "password"},
 					{"initial_root_password": null},
@@ -61,6 +65,10 @@ func TestRedactSensitiveResponseFields(t *testing.T) {
 			"backend_certificate_key": "[REDACTED]",
 			"name": "example"
 		},
+		"certificate": {
+			"certificate": "public certificate",
+			"private_key": "[REDACTED]"
+		},
 		"virtual_machines": [
 			{"initial_root_password": "[REDACTED]"},
 			{"initial_root_password": null},
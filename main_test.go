@@ -5,6 +5,7 @@ import (
 	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/krystal/terraform-provider-katapult/internal/provider"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,30 +21,39 @@ func TestProviderImplementationsCanBeMuxed(t *testing.T) {
 	assert.NotNil(t, server)
 }
 
+func TestProviderSchemasMatch(t *testing.T) {
+	t.Setenv("TF_ACC", "")
+	ctx := context.Background()
+
+	server, err := newProviderServer(ctx)
+	require.NoError(t, err)
+
+	resp, err := server().GetProviderSchema(
+		ctx, &tfprotov6.GetProviderSchemaRequest{},
+	)
+	require.NoError(t, err)
+
+	for _, d := range resp.Diagnostics {
+		assert.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity,
+			"legacy and Framework provider schemas must be identical: %s",
+			d.Detail)
+	}
+}
+
 func TestLegacyProviderRegistrations(t *testing.T) {
 	t.Setenv("TF_ACC", "")
 
 	legacyProvider := provider.New(&provider.Config{})()
 
-	assert.Equal(t, []string{
-		"katapult_security_group",
-		"katapult_security_group_rule",
-	}, sortedKeys(legacyProvider.ResourcesMap),
+	assert.Equal(t, []string{}, sortedKeys(legacyProvider.ResourcesMap),
 		"new resources belong in internal/v6provider; "+
 			"only remove entries during migration")
 
 	assert.Equal(t, []string{
-		"katapult_data_center",
-		"katapult_disk_template",
-		"katapult_disk_templates",
-		"katapult_network_speed_profile",
-		"katapult_network_speed_profiles",
 		"katapult_security_group",
 		"katapult_security_group_rule",
 		"katapult_security_group_rules",
 		"katapult_security_groups",
-		"katapult_virtual_machine_package",
-		"katapult_virtual_machine_packages",
 	}, sortedKeys(legacyProvider.DataSourcesMap),
 		"new data sources belong in internal/v6provider; "+
 			"only remove entries during migration")